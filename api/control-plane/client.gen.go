@@ -4,6 +4,7 @@
 package controlplane
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -96,9 +97,17 @@ type ClientInterface interface {
 	// GetHealth request
 	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamAllMetrics request
+	StreamAllMetrics(ctx context.Context, params *StreamAllMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOpenAPISpec request
 	GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// TriggerWorkerWebhookWithBody request with any body
+	TriggerWorkerWebhookWithBody(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TriggerWorkerWebhook(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, body TriggerWorkerWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkers request
 	GetWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -111,9 +120,20 @@ type ClientInterface interface {
 	// GetWorkerFlow request
 	GetWorkerFlow(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CancelWorkerCycle request
+	CancelWorkerCycle(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkerFlowSteps request
 	GetWorkerFlowSteps(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SetWorkerStepEnabledWithBody request with any body
+	SetWorkerStepEnabledWithBody(ctx context.Context, workerId string, stepName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetWorkerStepEnabled(ctx context.Context, workerId string, stepName string, body SetWorkerStepEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TriggerWorkerFlow request
+	TriggerWorkerFlow(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkerHealth request
 	GetWorkerHealth(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -123,9 +143,27 @@ type ClientInterface interface {
 	// GetWorkerLogFile request
 	GetWorkerLogFile(ctx context.Context, workerId string, filename string, params *GetWorkerLogFileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamWorkerLogFile request
+	StreamWorkerLogFile(ctx context.Context, workerId string, filename string, params *StreamWorkerLogFileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkerMetrics request
 	GetWorkerMetrics(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// StreamWorkerMetrics request
+	StreamWorkerMetrics(ctx context.Context, workerId string, params *StreamWorkerMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseWorker request
+	PauseWorker(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeWorker request
+	ResumeWorker(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkerRuns request
+	GetWorkerRuns(ctx context.Context, workerId string, params *GetWorkerRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkerRun request
+	GetWorkerRun(ctx context.Context, workerId string, runId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkerStatus request
 	GetWorkerStatus(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -154,6 +192,18 @@ func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
+func (c *Client) StreamAllMetrics(ctx context.Context, params *StreamAllMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamAllMetricsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetOpenAPISpecRequest(c.Server)
 	if err != nil {
@@ -166,6 +216,30 @@ func (c *Client) GetOpenAPISpec(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) TriggerWorkerWebhookWithBody(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerWorkerWebhookRequestWithBody(c.Server, workerId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TriggerWorkerWebhook(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, body TriggerWorkerWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerWorkerWebhookRequest(c.Server, workerId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkersRequest(c.Server)
 	if err != nil {
@@ -214,6 +288,18 @@ func (c *Client) GetWorkerFlow(ctx context.Context, workerId string, reqEditors
 	return c.Client.Do(req)
 }
 
+func (c *Client) CancelWorkerCycle(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelWorkerCycleRequest(c.Server, workerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkerFlowSteps(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkerFlowStepsRequest(c.Server, workerId)
 	if err != nil {
@@ -226,6 +312,42 @@ func (c *Client) GetWorkerFlowSteps(ctx context.Context, workerId string, reqEdi
 	return c.Client.Do(req)
 }
 
+func (c *Client) SetWorkerStepEnabledWithBody(ctx context.Context, workerId string, stepName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerStepEnabledRequestWithBody(c.Server, workerId, stepName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetWorkerStepEnabled(ctx context.Context, workerId string, stepName string, body SetWorkerStepEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetWorkerStepEnabledRequest(c.Server, workerId, stepName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TriggerWorkerFlow(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTriggerWorkerFlowRequest(c.Server, workerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkerHealth(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkerHealthRequest(c.Server, workerId)
 	if err != nil {
@@ -262,6 +384,18 @@ func (c *Client) GetWorkerLogFile(ctx context.Context, workerId string, filename
 	return c.Client.Do(req)
 }
 
+func (c *Client) StreamWorkerLogFile(ctx context.Context, workerId string, filename string, params *StreamWorkerLogFileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamWorkerLogFileRequest(c.Server, workerId, filename, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkerMetrics(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkerMetricsRequest(c.Server, workerId)
 	if err != nil {
@@ -274,6 +408,66 @@ func (c *Client) GetWorkerMetrics(ctx context.Context, workerId string, reqEdito
 	return c.Client.Do(req)
 }
 
+func (c *Client) StreamWorkerMetrics(ctx context.Context, workerId string, params *StreamWorkerMetricsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamWorkerMetricsRequest(c.Server, workerId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PauseWorker(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseWorkerRequest(c.Server, workerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ResumeWorker(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeWorkerRequest(c.Server, workerId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkerRuns(ctx context.Context, workerId string, params *GetWorkerRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkerRunsRequest(c.Server, workerId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkerRun(ctx context.Context, workerId string, runId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkerRunRequest(c.Server, workerId, runId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetWorkerStatus(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetWorkerStatusRequest(c.Server, workerId)
 	if err != nil {
@@ -340,6 +534,55 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewStreamAllMetricsRequest generates requests for StreamAllMetrics
+func NewStreamAllMetricsRequest(server string, params *StreamAllMetricsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Interval != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetOpenAPISpecRequest generates requests for GetOpenAPISpec
 func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
 	var err error
@@ -367,6 +610,68 @@ func NewGetOpenAPISpecRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewTriggerWorkerWebhookRequest calls the generic TriggerWorkerWebhook builder with application/json body
+func NewTriggerWorkerWebhookRequest(server string, workerId string, params *TriggerWorkerWebhookParams, body TriggerWorkerWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTriggerWorkerWebhookRequestWithBody(server, workerId, params, "application/json", bodyReader)
+}
+
+// NewTriggerWorkerWebhookRequestWithBody generates requests for TriggerWorkerWebhook with any type of body
+func NewTriggerWorkerWebhookRequestWithBody(server string, workerId string, params *TriggerWorkerWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XHubSignature256 != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Hub-Signature-256", runtime.ParamLocationHeader, *params.XHubSignature256)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Hub-Signature-256", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
 // NewGetWorkersRequest generates requests for GetWorkers
 func NewGetWorkersRequest(server string) (*http.Request, error) {
 	var err error
@@ -496,8 +801,8 @@ func NewGetWorkerFlowRequest(server string, workerId string) (*http.Request, err
 	return req, nil
 }
 
-// NewGetWorkerFlowStepsRequest generates requests for GetWorkerFlowSteps
-func NewGetWorkerFlowStepsRequest(server string, workerId string) (*http.Request, error) {
+// NewCancelWorkerCycleRequest generates requests for CancelWorkerCycle
+func NewCancelWorkerCycleRequest(server string, workerId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -512,7 +817,7 @@ func NewGetWorkerFlowStepsRequest(server string, workerId string) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workers/%s/flow/steps", pathParam0)
+	operationPath := fmt.Sprintf("/workers/%s/flow/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -522,7 +827,7 @@ func NewGetWorkerFlowStepsRequest(server string, workerId string) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -530,8 +835,8 @@ func NewGetWorkerFlowStepsRequest(server string, workerId string) (*http.Request
 	return req, nil
 }
 
-// NewGetWorkerHealthRequest generates requests for GetWorkerHealth
-func NewGetWorkerHealthRequest(server string, workerId string) (*http.Request, error) {
+// NewGetWorkerFlowStepsRequest generates requests for GetWorkerFlowSteps
+func NewGetWorkerFlowStepsRequest(server string, workerId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -546,7 +851,7 @@ func NewGetWorkerHealthRequest(server string, workerId string) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workers/%s/health", pathParam0)
+	operationPath := fmt.Sprintf("/workers/%s/flow/steps", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -564,8 +869,130 @@ func NewGetWorkerHealthRequest(server string, workerId string) (*http.Request, e
 	return req, nil
 }
 
-// NewGetWorkerLogsRequest generates requests for GetWorkerLogs
-func NewGetWorkerLogsRequest(server string, workerId string, params *GetWorkerLogsParams) (*http.Request, error) {
+// NewSetWorkerStepEnabledRequest calls the generic SetWorkerStepEnabled builder with application/json body
+func NewSetWorkerStepEnabledRequest(server string, workerId string, stepName string, body SetWorkerStepEnabledJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetWorkerStepEnabledRequestWithBody(server, workerId, stepName, "application/json", bodyReader)
+}
+
+// NewSetWorkerStepEnabledRequestWithBody generates requests for SetWorkerStepEnabled with any type of body
+func NewSetWorkerStepEnabledRequestWithBody(server string, workerId string, stepName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_name", runtime.ParamLocationPath, stepName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/flow/steps/%s/enabled", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTriggerWorkerFlowRequest generates requests for TriggerWorkerFlow
+func NewTriggerWorkerFlowRequest(server string, workerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/flow/trigger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkerHealthRequest generates requests for GetWorkerHealth
+func NewGetWorkerHealthRequest(server string, workerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/health", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkerLogsRequest generates requests for GetWorkerLogs
+func NewGetWorkerLogsRequest(server string, workerId string, params *GetWorkerLogsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -699,8 +1126,8 @@ func NewGetWorkerLogFileRequest(server string, workerId string, filename string,
 	return req, nil
 }
 
-// NewGetWorkerMetricsRequest generates requests for GetWorkerMetrics
-func NewGetWorkerMetricsRequest(server string, workerId string) (*http.Request, error) {
+// NewStreamWorkerLogFileRequest generates requests for StreamWorkerLogFile
+func NewStreamWorkerLogFileRequest(server string, workerId string, filename string, params *StreamWorkerLogFileParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -710,12 +1137,19 @@ func NewGetWorkerMetricsRequest(server string, workerId string) (*http.Request,
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "filename", runtime.ParamLocationPath, filename)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workers/%s/metrics", pathParam0)
+	operationPath := fmt.Sprintf("/workers/%s/logs/%s/stream", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -725,6 +1159,28 @@ func NewGetWorkerMetricsRequest(server string, workerId string) (*http.Request,
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tail != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail", runtime.ParamLocationQuery, *params.Tail); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -733,8 +1189,8 @@ func NewGetWorkerMetricsRequest(server string, workerId string) (*http.Request,
 	return req, nil
 }
 
-// NewGetWorkerStatusRequest generates requests for GetWorkerStatus
-func NewGetWorkerStatusRequest(server string, workerId string) (*http.Request, error) {
+// NewGetWorkerMetricsRequest generates requests for GetWorkerMetrics
+func NewGetWorkerMetricsRequest(server string, workerId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -749,7 +1205,7 @@ func NewGetWorkerStatusRequest(server string, workerId string) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workers/%s/status", pathParam0)
+	operationPath := fmt.Sprintf("/workers/%s/metrics", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -767,96 +1223,641 @@ func NewGetWorkerStatusRequest(server string, workerId string) (*http.Request, e
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewStreamWorkerMetricsRequest generates requests for StreamWorkerMetrics
+func NewStreamWorkerMetricsRequest(server string, workerId string, params *StreamWorkerMetricsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/workers/%s/metrics/stream", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetSwaggerUIWithResponse request
-	GetSwaggerUIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSwaggerUIResponse, error)
+		if params.Interval != nil {
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetOpenAPISpecWithResponse request
-	GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error)
+		}
 
-	// GetWorkersWithResponse request
-	GetWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWorkersResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetWorkerWithResponse request
-	GetWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetWorkerConfigWithResponse request
-	GetWorkerConfigWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerConfigResponse, error)
+	return req, nil
+}
 
-	// GetWorkerFlowWithResponse request
-	GetWorkerFlowWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowResponse, error)
+// NewPauseWorkerRequest generates requests for PauseWorker
+func NewPauseWorkerRequest(server string, workerId string) (*http.Request, error) {
+	var err error
 
-	// GetWorkerFlowStepsWithResponse request
-	GetWorkerFlowStepsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowStepsResponse, error)
+	var pathParam0 string
 
-	// GetWorkerHealthWithResponse request
-	GetWorkerHealthWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerHealthResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetWorkerLogsWithResponse request
-	GetWorkerLogsWithResponse(ctx context.Context, workerId string, params *GetWorkerLogsParams, reqEditors ...RequestEditorFn) (*GetWorkerLogsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetWorkerLogFileWithResponse request
-	GetWorkerLogFileWithResponse(ctx context.Context, workerId string, filename string, params *GetWorkerLogFileParams, reqEditors ...RequestEditorFn) (*GetWorkerLogFileResponse, error)
+	operationPath := fmt.Sprintf("/workers/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetWorkerMetricsWithResponse request
-	GetWorkerMetricsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerMetricsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetWorkerStatusWithResponse request
-	GetWorkerStatusWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerStatusResponse, error)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type GetSwaggerUIResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
+}
+
+// NewResumeWorkerRequest generates requests for ResumeWorker
+func NewResumeWorkerRequest(server string, workerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkerRunsRequest generates requests for GetWorkerRuns
+func NewGetWorkerRunsRequest(server string, workerId string, params *GetWorkerRunsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkerRunRequest generates requests for GetWorkerRun
+func NewGetWorkerRunRequest(server string, workerId string, runId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/runs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkerStatusRequest generates requests for GetWorkerStatus
+func NewGetWorkerStatusRequest(server string, workerId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "worker_id", runtime.ParamLocationPath, workerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workers/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetSwaggerUIWithResponse request
+	GetSwaggerUIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSwaggerUIResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// StreamAllMetricsWithResponse request
+	StreamAllMetricsWithResponse(ctx context.Context, params *StreamAllMetricsParams, reqEditors ...RequestEditorFn) (*StreamAllMetricsResponse, error)
+
+	// GetOpenAPISpecWithResponse request
+	GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error)
+
+	// TriggerWorkerWebhookWithBodyWithResponse request with any body
+	TriggerWorkerWebhookWithBodyWithResponse(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TriggerWorkerWebhookResponse, error)
+
+	TriggerWorkerWebhookWithResponse(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, body TriggerWorkerWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*TriggerWorkerWebhookResponse, error)
+
+	// GetWorkersWithResponse request
+	GetWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWorkersResponse, error)
+
+	// GetWorkerWithResponse request
+	GetWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerResponse, error)
+
+	// GetWorkerConfigWithResponse request
+	GetWorkerConfigWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerConfigResponse, error)
+
+	// GetWorkerFlowWithResponse request
+	GetWorkerFlowWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowResponse, error)
+
+	// CancelWorkerCycleWithResponse request
+	CancelWorkerCycleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*CancelWorkerCycleResponse, error)
+
+	// GetWorkerFlowStepsWithResponse request
+	GetWorkerFlowStepsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowStepsResponse, error)
+
+	// SetWorkerStepEnabledWithBodyWithResponse request with any body
+	SetWorkerStepEnabledWithBodyWithResponse(ctx context.Context, workerId string, stepName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerStepEnabledResponse, error)
+
+	SetWorkerStepEnabledWithResponse(ctx context.Context, workerId string, stepName string, body SetWorkerStepEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerStepEnabledResponse, error)
+
+	// TriggerWorkerFlowWithResponse request
+	TriggerWorkerFlowWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*TriggerWorkerFlowResponse, error)
+
+	// GetWorkerHealthWithResponse request
+	GetWorkerHealthWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerHealthResponse, error)
+
+	// GetWorkerLogsWithResponse request
+	GetWorkerLogsWithResponse(ctx context.Context, workerId string, params *GetWorkerLogsParams, reqEditors ...RequestEditorFn) (*GetWorkerLogsResponse, error)
+
+	// GetWorkerLogFileWithResponse request
+	GetWorkerLogFileWithResponse(ctx context.Context, workerId string, filename string, params *GetWorkerLogFileParams, reqEditors ...RequestEditorFn) (*GetWorkerLogFileResponse, error)
+
+	// StreamWorkerLogFileWithResponse request
+	StreamWorkerLogFileWithResponse(ctx context.Context, workerId string, filename string, params *StreamWorkerLogFileParams, reqEditors ...RequestEditorFn) (*StreamWorkerLogFileResponse, error)
+
+	// GetWorkerMetricsWithResponse request
+	GetWorkerMetricsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerMetricsResponse, error)
+
+	// StreamWorkerMetricsWithResponse request
+	StreamWorkerMetricsWithResponse(ctx context.Context, workerId string, params *StreamWorkerMetricsParams, reqEditors ...RequestEditorFn) (*StreamWorkerMetricsResponse, error)
+
+	// PauseWorkerWithResponse request
+	PauseWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*PauseWorkerResponse, error)
+
+	// ResumeWorkerWithResponse request
+	ResumeWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*ResumeWorkerResponse, error)
+
+	// GetWorkerRunsWithResponse request
+	GetWorkerRunsWithResponse(ctx context.Context, workerId string, params *GetWorkerRunsParams, reqEditors ...RequestEditorFn) (*GetWorkerRunsResponse, error)
+
+	// GetWorkerRunWithResponse request
+	GetWorkerRunWithResponse(ctx context.Context, workerId string, runId string, reqEditors ...RequestEditorFn) (*GetWorkerRunResponse, error)
+
+	// GetWorkerStatusWithResponse request
+	GetWorkerStatusWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerStatusResponse, error)
+}
+
+type GetSwaggerUIResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSwaggerUIResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSwaggerUIResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControlPlaneHealthResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamAllMetricsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamAllMetricsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamAllMetricsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenAPISpecResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenAPISpecResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenAPISpecResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TriggerWorkerWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControlResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *ErrorResponse
+	JSON429      *ErrorResponse
+	JSON502      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TriggerWorkerWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TriggerWorkerWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkersResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkerDetailsResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkerConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfigResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkerConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkerConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkerFlowResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FlowResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkerFlowResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkerFlowResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelWorkerCycleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ControlResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelWorkerCycleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelWorkerCycleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkerFlowStepsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FlowStepsResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSwaggerUIResponse) Status() string {
+func (r GetWorkerFlowStepsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -864,22 +1865,24 @@ func (r GetSwaggerUIResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSwaggerUIResponse) StatusCode() int {
+func (r GetWorkerFlowStepsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetHealthResponse struct {
+type SetWorkerStepEnabledResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ControlPlaneHealthResponse
-	JSON500      *ErrorResponse
+	JSON200      *StepEnabledResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
+func (r SetWorkerStepEnabledResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -887,21 +1890,23 @@ func (r GetHealthResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
+func (r SetWorkerStepEnabledResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetOpenAPISpecResponse struct {
+type TriggerWorkerFlowResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	YAML200      *string
+	JSON200      *ControlResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetOpenAPISpecResponse) Status() string {
+func (r TriggerWorkerFlowResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -909,22 +1914,24 @@ func (r GetOpenAPISpecResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetOpenAPISpecResponse) StatusCode() int {
+func (r TriggerWorkerFlowResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkersResponse struct {
+type GetWorkerHealthResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkersResponse
+	JSON200      *HealthResponse
+	JSON404      *ErrorResponse
 	JSON500      *ErrorResponse
+	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkersResponse) Status() string {
+func (r GetWorkerHealthResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -932,23 +1939,23 @@ func (r GetWorkersResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkersResponse) StatusCode() int {
+func (r GetWorkerHealthResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerResponse struct {
+type GetWorkerLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *WorkerDetailsResponse
+	JSON200      *LogsResponse
 	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerResponse) Status() string {
+func (r GetWorkerLogsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -956,23 +1963,22 @@ func (r GetWorkerResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerResponse) StatusCode() int {
+func (r GetWorkerLogsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerConfigResponse struct {
+type GetWorkerLogFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ConfigResponse
 	JSON404      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerConfigResponse) Status() string {
+func (r GetWorkerLogFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -980,23 +1986,22 @@ func (r GetWorkerConfigResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerConfigResponse) StatusCode() int {
+func (r GetWorkerLogFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerFlowResponse struct {
+type StreamWorkerLogFileResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FlowResponse
 	JSON404      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerFlowResponse) Status() string {
+func (r StreamWorkerLogFileResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1004,23 +2009,23 @@ func (r GetWorkerFlowResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerFlowResponse) StatusCode() int {
+func (r StreamWorkerLogFileResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerFlowStepsResponse struct {
+type GetWorkerMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FlowStepsResponse
+	JSON200      *MetricsResponse
 	JSON404      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerFlowStepsResponse) Status() string {
+func (r GetWorkerMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1028,24 +2033,22 @@ func (r GetWorkerFlowStepsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerFlowStepsResponse) StatusCode() int {
+func (r GetWorkerMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerHealthResponse struct {
+type StreamWorkerMetricsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *HealthResponse
 	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerHealthResponse) Status() string {
+func (r StreamWorkerMetricsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1053,23 +2056,23 @@ func (r GetWorkerHealthResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerHealthResponse) StatusCode() int {
+func (r StreamWorkerMetricsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerLogsResponse struct {
+type PauseWorkerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *LogsResponse
+	JSON200      *ControlResponse
 	JSON404      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerLogsResponse) Status() string {
+func (r PauseWorkerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1077,22 +2080,23 @@ func (r GetWorkerLogsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerLogsResponse) StatusCode() int {
+func (r PauseWorkerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerLogFileResponse struct {
+type ResumeWorkerResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ControlResponse
 	JSON404      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerLogFileResponse) Status() string {
+func (r ResumeWorkerResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1100,23 +2104,23 @@ func (r GetWorkerLogFileResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerLogFileResponse) StatusCode() int {
+func (r ResumeWorkerResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetWorkerMetricsResponse struct {
+type GetWorkerRunsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *MetricsResponse
+	JSON200      *RunsResponse
 	JSON404      *ErrorResponse
 	JSON502      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetWorkerMetricsResponse) Status() string {
+func (r GetWorkerRunsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1124,7 +2128,31 @@ func (r GetWorkerMetricsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkerMetricsResponse) StatusCode() int {
+func (r GetWorkerRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkerRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunResponse
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkerRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkerRunResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -1173,229 +2201,715 @@ func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEdit
 	return ParseGetHealthResponse(rsp)
 }
 
-// GetOpenAPISpecWithResponse request returning *GetOpenAPISpecResponse
-func (c *ClientWithResponses) GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error) {
-	rsp, err := c.GetOpenAPISpec(ctx, reqEditors...)
+// StreamAllMetricsWithResponse request returning *StreamAllMetricsResponse
+func (c *ClientWithResponses) StreamAllMetricsWithResponse(ctx context.Context, params *StreamAllMetricsParams, reqEditors ...RequestEditorFn) (*StreamAllMetricsResponse, error) {
+	rsp, err := c.StreamAllMetrics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamAllMetricsResponse(rsp)
+}
+
+// GetOpenAPISpecWithResponse request returning *GetOpenAPISpecResponse
+func (c *ClientWithResponses) GetOpenAPISpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenAPISpecResponse, error) {
+	rsp, err := c.GetOpenAPISpec(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenAPISpecResponse(rsp)
+}
+
+// TriggerWorkerWebhookWithBodyWithResponse request with arbitrary body returning *TriggerWorkerWebhookResponse
+func (c *ClientWithResponses) TriggerWorkerWebhookWithBodyWithResponse(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TriggerWorkerWebhookResponse, error) {
+	rsp, err := c.TriggerWorkerWebhookWithBody(ctx, workerId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerWorkerWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) TriggerWorkerWebhookWithResponse(ctx context.Context, workerId string, params *TriggerWorkerWebhookParams, body TriggerWorkerWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*TriggerWorkerWebhookResponse, error) {
+	rsp, err := c.TriggerWorkerWebhook(ctx, workerId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerWorkerWebhookResponse(rsp)
+}
+
+// GetWorkersWithResponse request returning *GetWorkersResponse
+func (c *ClientWithResponses) GetWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWorkersResponse, error) {
+	rsp, err := c.GetWorkers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkersResponse(rsp)
+}
+
+// GetWorkerWithResponse request returning *GetWorkerResponse
+func (c *ClientWithResponses) GetWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerResponse, error) {
+	rsp, err := c.GetWorker(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerResponse(rsp)
+}
+
+// GetWorkerConfigWithResponse request returning *GetWorkerConfigResponse
+func (c *ClientWithResponses) GetWorkerConfigWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerConfigResponse, error) {
+	rsp, err := c.GetWorkerConfig(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerConfigResponse(rsp)
+}
+
+// GetWorkerFlowWithResponse request returning *GetWorkerFlowResponse
+func (c *ClientWithResponses) GetWorkerFlowWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowResponse, error) {
+	rsp, err := c.GetWorkerFlow(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerFlowResponse(rsp)
+}
+
+// CancelWorkerCycleWithResponse request returning *CancelWorkerCycleResponse
+func (c *ClientWithResponses) CancelWorkerCycleWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*CancelWorkerCycleResponse, error) {
+	rsp, err := c.CancelWorkerCycle(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelWorkerCycleResponse(rsp)
+}
+
+// GetWorkerFlowStepsWithResponse request returning *GetWorkerFlowStepsResponse
+func (c *ClientWithResponses) GetWorkerFlowStepsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowStepsResponse, error) {
+	rsp, err := c.GetWorkerFlowSteps(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerFlowStepsResponse(rsp)
+}
+
+// SetWorkerStepEnabledWithBodyWithResponse request with arbitrary body returning *SetWorkerStepEnabledResponse
+func (c *ClientWithResponses) SetWorkerStepEnabledWithBodyWithResponse(ctx context.Context, workerId string, stepName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetWorkerStepEnabledResponse, error) {
+	rsp, err := c.SetWorkerStepEnabledWithBody(ctx, workerId, stepName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetWorkerStepEnabledResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetWorkerStepEnabledWithResponse(ctx context.Context, workerId string, stepName string, body SetWorkerStepEnabledJSONRequestBody, reqEditors ...RequestEditorFn) (*SetWorkerStepEnabledResponse, error) {
+	rsp, err := c.SetWorkerStepEnabled(ctx, workerId, stepName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetWorkerStepEnabledResponse(rsp)
+}
+
+// TriggerWorkerFlowWithResponse request returning *TriggerWorkerFlowResponse
+func (c *ClientWithResponses) TriggerWorkerFlowWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*TriggerWorkerFlowResponse, error) {
+	rsp, err := c.TriggerWorkerFlow(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTriggerWorkerFlowResponse(rsp)
+}
+
+// GetWorkerHealthWithResponse request returning *GetWorkerHealthResponse
+func (c *ClientWithResponses) GetWorkerHealthWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerHealthResponse, error) {
+	rsp, err := c.GetWorkerHealth(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerHealthResponse(rsp)
+}
+
+// GetWorkerLogsWithResponse request returning *GetWorkerLogsResponse
+func (c *ClientWithResponses) GetWorkerLogsWithResponse(ctx context.Context, workerId string, params *GetWorkerLogsParams, reqEditors ...RequestEditorFn) (*GetWorkerLogsResponse, error) {
+	rsp, err := c.GetWorkerLogs(ctx, workerId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerLogsResponse(rsp)
+}
+
+// GetWorkerLogFileWithResponse request returning *GetWorkerLogFileResponse
+func (c *ClientWithResponses) GetWorkerLogFileWithResponse(ctx context.Context, workerId string, filename string, params *GetWorkerLogFileParams, reqEditors ...RequestEditorFn) (*GetWorkerLogFileResponse, error) {
+	rsp, err := c.GetWorkerLogFile(ctx, workerId, filename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerLogFileResponse(rsp)
+}
+
+// StreamWorkerLogFileWithResponse request returning *StreamWorkerLogFileResponse
+func (c *ClientWithResponses) StreamWorkerLogFileWithResponse(ctx context.Context, workerId string, filename string, params *StreamWorkerLogFileParams, reqEditors ...RequestEditorFn) (*StreamWorkerLogFileResponse, error) {
+	rsp, err := c.StreamWorkerLogFile(ctx, workerId, filename, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamWorkerLogFileResponse(rsp)
+}
+
+// GetWorkerMetricsWithResponse request returning *GetWorkerMetricsResponse
+func (c *ClientWithResponses) GetWorkerMetricsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerMetricsResponse, error) {
+	rsp, err := c.GetWorkerMetrics(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerMetricsResponse(rsp)
+}
+
+// StreamWorkerMetricsWithResponse request returning *StreamWorkerMetricsResponse
+func (c *ClientWithResponses) StreamWorkerMetricsWithResponse(ctx context.Context, workerId string, params *StreamWorkerMetricsParams, reqEditors ...RequestEditorFn) (*StreamWorkerMetricsResponse, error) {
+	rsp, err := c.StreamWorkerMetrics(ctx, workerId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamWorkerMetricsResponse(rsp)
+}
+
+// PauseWorkerWithResponse request returning *PauseWorkerResponse
+func (c *ClientWithResponses) PauseWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*PauseWorkerResponse, error) {
+	rsp, err := c.PauseWorker(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseWorkerResponse(rsp)
+}
+
+// ResumeWorkerWithResponse request returning *ResumeWorkerResponse
+func (c *ClientWithResponses) ResumeWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*ResumeWorkerResponse, error) {
+	rsp, err := c.ResumeWorker(ctx, workerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeWorkerResponse(rsp)
+}
+
+// GetWorkerRunsWithResponse request returning *GetWorkerRunsResponse
+func (c *ClientWithResponses) GetWorkerRunsWithResponse(ctx context.Context, workerId string, params *GetWorkerRunsParams, reqEditors ...RequestEditorFn) (*GetWorkerRunsResponse, error) {
+	rsp, err := c.GetWorkerRuns(ctx, workerId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerRunsResponse(rsp)
+}
+
+// GetWorkerRunWithResponse request returning *GetWorkerRunResponse
+func (c *ClientWithResponses) GetWorkerRunWithResponse(ctx context.Context, workerId string, runId string, reqEditors ...RequestEditorFn) (*GetWorkerRunResponse, error) {
+	rsp, err := c.GetWorkerRun(ctx, workerId, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkerRunResponse(rsp)
+}
+
+// GetWorkerStatusWithResponse request returning *GetWorkerStatusResponse
+func (c *ClientWithResponses) GetWorkerStatusWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerStatusResponse, error) {
+	rsp, err := c.GetWorkerStatus(ctx, workerId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOpenAPISpecResponse(rsp)
+	return ParseGetWorkerStatusResponse(rsp)
 }
 
-// GetWorkersWithResponse request returning *GetWorkersResponse
-func (c *ClientWithResponses) GetWorkersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWorkersResponse, error) {
-	rsp, err := c.GetWorkers(ctx, reqEditors...)
+// ParseGetSwaggerUIResponse parses an HTTP response from a GetSwaggerUIWithResponse call
+func ParseGetSwaggerUIResponse(rsp *http.Response) (*GetSwaggerUIResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkersResponse(rsp)
+
+	response := &GetSwaggerUIResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// GetWorkerWithResponse request returning *GetWorkerResponse
-func (c *ClientWithResponses) GetWorkerWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerResponse, error) {
-	rsp, err := c.GetWorker(ctx, workerId, reqEditors...)
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerResponse(rsp)
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlPlaneHealthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWorkerConfigWithResponse request returning *GetWorkerConfigResponse
-func (c *ClientWithResponses) GetWorkerConfigWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerConfigResponse, error) {
-	rsp, err := c.GetWorkerConfig(ctx, workerId, reqEditors...)
+// ParseStreamAllMetricsResponse parses an HTTP response from a StreamAllMetricsWithResponse call
+func ParseStreamAllMetricsResponse(rsp *http.Response) (*StreamAllMetricsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerConfigResponse(rsp)
+
+	response := &StreamAllMetricsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// GetWorkerFlowWithResponse request returning *GetWorkerFlowResponse
-func (c *ClientWithResponses) GetWorkerFlowWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowResponse, error) {
-	rsp, err := c.GetWorkerFlow(ctx, workerId, reqEditors...)
+// ParseGetOpenAPISpecResponse parses an HTTP response from a GetOpenAPISpecWithResponse call
+func ParseGetOpenAPISpecResponse(rsp *http.Response) (*GetOpenAPISpecResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerFlowResponse(rsp)
+
+	response := &GetOpenAPISpecResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWorkerFlowStepsWithResponse request returning *GetWorkerFlowStepsResponse
-func (c *ClientWithResponses) GetWorkerFlowStepsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerFlowStepsResponse, error) {
-	rsp, err := c.GetWorkerFlowSteps(ctx, workerId, reqEditors...)
+// ParseTriggerWorkerWebhookResponse parses an HTTP response from a TriggerWorkerWebhookWithResponse call
+func ParseTriggerWorkerWebhookResponse(rsp *http.Response) (*TriggerWorkerWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerFlowStepsResponse(rsp)
+
+	response := &TriggerWorkerWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWorkerHealthWithResponse request returning *GetWorkerHealthResponse
-func (c *ClientWithResponses) GetWorkerHealthWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerHealthResponse, error) {
-	rsp, err := c.GetWorkerHealth(ctx, workerId, reqEditors...)
+// ParseGetWorkersResponse parses an HTTP response from a GetWorkersWithResponse call
+func ParseGetWorkersResponse(rsp *http.Response) (*GetWorkersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerHealthResponse(rsp)
+
+	response := &GetWorkersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWorkerLogsWithResponse request returning *GetWorkerLogsResponse
-func (c *ClientWithResponses) GetWorkerLogsWithResponse(ctx context.Context, workerId string, params *GetWorkerLogsParams, reqEditors ...RequestEditorFn) (*GetWorkerLogsResponse, error) {
-	rsp, err := c.GetWorkerLogs(ctx, workerId, params, reqEditors...)
+// ParseGetWorkerResponse parses an HTTP response from a GetWorkerWithResponse call
+func ParseGetWorkerResponse(rsp *http.Response) (*GetWorkerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerLogsResponse(rsp)
+
+	response := &GetWorkerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkerDetailsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWorkerLogFileWithResponse request returning *GetWorkerLogFileResponse
-func (c *ClientWithResponses) GetWorkerLogFileWithResponse(ctx context.Context, workerId string, filename string, params *GetWorkerLogFileParams, reqEditors ...RequestEditorFn) (*GetWorkerLogFileResponse, error) {
-	rsp, err := c.GetWorkerLogFile(ctx, workerId, filename, params, reqEditors...)
+// ParseGetWorkerConfigResponse parses an HTTP response from a GetWorkerConfigWithResponse call
+func ParseGetWorkerConfigResponse(rsp *http.Response) (*GetWorkerConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerLogFileResponse(rsp)
+
+	response := &GetWorkerConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWorkerMetricsWithResponse request returning *GetWorkerMetricsResponse
-func (c *ClientWithResponses) GetWorkerMetricsWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerMetricsResponse, error) {
-	rsp, err := c.GetWorkerMetrics(ctx, workerId, reqEditors...)
+// ParseGetWorkerFlowResponse parses an HTTP response from a GetWorkerFlowWithResponse call
+func ParseGetWorkerFlowResponse(rsp *http.Response) (*GetWorkerFlowResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkerMetricsResponse(rsp)
-}
 
-// GetWorkerStatusWithResponse request returning *GetWorkerStatusResponse
-func (c *ClientWithResponses) GetWorkerStatusWithResponse(ctx context.Context, workerId string, reqEditors ...RequestEditorFn) (*GetWorkerStatusResponse, error) {
-	rsp, err := c.GetWorkerStatus(ctx, workerId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetWorkerFlowResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetWorkerStatusResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FlowResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetSwaggerUIResponse parses an HTTP response from a GetSwaggerUIWithResponse call
-func ParseGetSwaggerUIResponse(rsp *http.Response) (*GetSwaggerUIResponse, error) {
+// ParseCancelWorkerCycleResponse parses an HTTP response from a CancelWorkerCycleWithResponse call
+func ParseCancelWorkerCycleResponse(rsp *http.Response) (*CancelWorkerCycleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSwaggerUIResponse{
+	response := &CancelWorkerCycleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+// ParseGetWorkerFlowStepsResponse parses an HTTP response from a GetWorkerFlowStepsWithResponse call
+func ParseGetWorkerFlowStepsResponse(rsp *http.Response) (*GetWorkerFlowStepsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthResponse{
+	response := &GetWorkerFlowStepsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ControlPlaneHealthResponse
+		var dest FlowStepsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetOpenAPISpecResponse parses an HTTP response from a GetOpenAPISpecWithResponse call
-func ParseGetOpenAPISpecResponse(rsp *http.Response) (*GetOpenAPISpecResponse, error) {
+// ParseSetWorkerStepEnabledResponse parses an HTTP response from a SetWorkerStepEnabledWithResponse call
+func ParseSetWorkerStepEnabledResponse(rsp *http.Response) (*SetWorkerStepEnabledResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOpenAPISpecResponse{
+	response := &SetWorkerStepEnabledResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StepEnabledResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.YAML200 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetWorkersResponse parses an HTTP response from a GetWorkersWithResponse call
-func ParseGetWorkersResponse(rsp *http.Response) (*GetWorkersResponse, error) {
+// ParseTriggerWorkerFlowResponse parses an HTTP response from a TriggerWorkerFlowWithResponse call
+func ParseTriggerWorkerFlowResponse(rsp *http.Response) (*TriggerWorkerFlowResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkersResponse{
+	response := &TriggerWorkerFlowResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkersResponse
+		var dest ControlResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetWorkerResponse parses an HTTP response from a GetWorkerWithResponse call
-func ParseGetWorkerResponse(rsp *http.Response) (*GetWorkerResponse, error) {
+// ParseGetWorkerHealthResponse parses an HTTP response from a GetWorkerHealthWithResponse call
+func ParseGetWorkerHealthResponse(rsp *http.Response) (*GetWorkerHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerResponse{
+	response := &GetWorkerHealthResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest WorkerDetailsResponse
+		var dest HealthResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1415,27 +2929,34 @@ func ParseGetWorkerResponse(rsp *http.Response) (*GetWorkerResponse, error) {
 		}
 		response.JSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetWorkerConfigResponse parses an HTTP response from a GetWorkerConfigWithResponse call
-func ParseGetWorkerConfigResponse(rsp *http.Response) (*GetWorkerConfigResponse, error) {
+// ParseGetWorkerLogsResponse parses an HTTP response from a GetWorkerLogsWithResponse call
+func ParseGetWorkerLogsResponse(rsp *http.Response) (*GetWorkerLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerConfigResponse{
+	response := &GetWorkerLogsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ConfigResponse
+		var dest LogsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1460,27 +2981,53 @@ func ParseGetWorkerConfigResponse(rsp *http.Response) (*GetWorkerConfigResponse,
 	return response, nil
 }
 
-// ParseGetWorkerFlowResponse parses an HTTP response from a GetWorkerFlowWithResponse call
-func ParseGetWorkerFlowResponse(rsp *http.Response) (*GetWorkerFlowResponse, error) {
+// ParseGetWorkerLogFileResponse parses an HTTP response from a GetWorkerLogFileWithResponse call
+func ParseGetWorkerLogFileResponse(rsp *http.Response) (*GetWorkerLogFileResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerFlowResponse{
+	response := &GetWorkerLogFileResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FlowResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
 
+	}
+
+	return response, nil
+}
+
+// ParseStreamWorkerLogFileResponse parses an HTTP response from a StreamWorkerLogFileWithResponse call
+func ParseStreamWorkerLogFileResponse(rsp *http.Response) (*StreamWorkerLogFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamWorkerLogFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1500,22 +3047,22 @@ func ParseGetWorkerFlowResponse(rsp *http.Response) (*GetWorkerFlowResponse, err
 	return response, nil
 }
 
-// ParseGetWorkerFlowStepsResponse parses an HTTP response from a GetWorkerFlowStepsWithResponse call
-func ParseGetWorkerFlowStepsResponse(rsp *http.Response) (*GetWorkerFlowStepsResponse, error) {
+// ParseGetWorkerMetricsResponse parses an HTTP response from a GetWorkerMetricsWithResponse call
+func ParseGetWorkerMetricsResponse(rsp *http.Response) (*GetWorkerMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerFlowStepsResponse{
+	response := &GetWorkerMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FlowStepsResponse
+		var dest MetricsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1540,40 +3087,66 @@ func ParseGetWorkerFlowStepsResponse(rsp *http.Response) (*GetWorkerFlowStepsRes
 	return response, nil
 }
 
-// ParseGetWorkerHealthResponse parses an HTTP response from a GetWorkerHealthWithResponse call
-func ParseGetWorkerHealthResponse(rsp *http.Response) (*GetWorkerHealthResponse, error) {
+// ParseStreamWorkerMetricsResponse parses an HTTP response from a StreamWorkerMetricsWithResponse call
+func ParseStreamWorkerMetricsResponse(rsp *http.Response) (*StreamWorkerMetricsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerHealthResponse{
+	response := &StreamWorkerMetricsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest HealthResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON502 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	}
+
+	return response, nil
+}
+
+// ParsePauseWorkerResponse parses an HTTP response from a PauseWorkerWithResponse call
+func ParsePauseWorkerResponse(rsp *http.Response) (*PauseWorkerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseWorkerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ControlResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
 		var dest ErrorResponse
@@ -1587,22 +3160,22 @@ func ParseGetWorkerHealthResponse(rsp *http.Response) (*GetWorkerHealthResponse,
 	return response, nil
 }
 
-// ParseGetWorkerLogsResponse parses an HTTP response from a GetWorkerLogsWithResponse call
-func ParseGetWorkerLogsResponse(rsp *http.Response) (*GetWorkerLogsResponse, error) {
+// ParseResumeWorkerResponse parses an HTTP response from a ResumeWorkerWithResponse call
+func ParseResumeWorkerResponse(rsp *http.Response) (*ResumeWorkerResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerLogsResponse{
+	response := &ResumeWorkerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LogsResponse
+		var dest ControlResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1627,20 +3200,27 @@ func ParseGetWorkerLogsResponse(rsp *http.Response) (*GetWorkerLogsResponse, err
 	return response, nil
 }
 
-// ParseGetWorkerLogFileResponse parses an HTTP response from a GetWorkerLogFileWithResponse call
-func ParseGetWorkerLogFileResponse(rsp *http.Response) (*GetWorkerLogFileResponse, error) {
+// ParseGetWorkerRunsResponse parses an HTTP response from a GetWorkerRunsWithResponse call
+func ParseGetWorkerRunsResponse(rsp *http.Response) (*GetWorkerRunsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerLogFileResponse{
+	response := &GetWorkerRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1660,22 +3240,22 @@ func ParseGetWorkerLogFileResponse(rsp *http.Response) (*GetWorkerLogFileRespons
 	return response, nil
 }
 
-// ParseGetWorkerMetricsResponse parses an HTTP response from a GetWorkerMetricsWithResponse call
-func ParseGetWorkerMetricsResponse(rsp *http.Response) (*GetWorkerMetricsResponse, error) {
+// ParseGetWorkerRunResponse parses an HTTP response from a GetWorkerRunWithResponse call
+func ParseGetWorkerRunResponse(rsp *http.Response) (*GetWorkerRunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWorkerMetricsResponse{
+	response := &GetWorkerRunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetricsResponse
+		var dest RunResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -84,6 +84,9 @@ type ControlPlaneHealthResponseStatus string
 // ControlPlaneHealthResponseWorkersHealth defines model for ControlPlaneHealthResponse.WorkersHealth.
 type ControlPlaneHealthResponseWorkersHealth string
 
+// ControlResponse defines model for ControlResponse.
+type ControlResponse = types.ControlResponse
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse = types.ErrorResponse
 
@@ -105,6 +108,9 @@ type HealthCheck = types.HealthCheck
 // HealthResponse defines model for HealthResponse.
 type HealthResponse = types.HealthResponse
 
+// IterationRecord defines model for IterationRecord.
+type IterationRecord = types.IterationRecord
+
 // LogFile defines model for LogFile.
 type LogFile = types.LogFile
 
@@ -132,9 +138,33 @@ type RetryConfig struct {
 // RetryConfigBackoff Backoff strategy for retry delays
 type RetryConfigBackoff string
 
+// RunRecord defines model for RunRecord.
+type RunRecord = types.RunRecord
+
+// RunResponse defines model for RunResponse.
+type RunResponse = types.RunResponse
+
+// RunSummary defines model for RunSummary.
+type RunSummary = types.RunSummary
+
+// RunsResponse defines model for RunsResponse.
+type RunsResponse = types.RunsResponse
+
 // StatusResponse defines model for StatusResponse.
 type StatusResponse = types.StatusResponse
 
+// StepEnabledRequest defines model for StepEnabledRequest.
+type StepEnabledRequest = types.StepEnabledRequest
+
+// StepEnabledResponse defines model for StepEnabledResponse.
+type StepEnabledResponse = types.StepEnabledResponse
+
+// StepMetrics defines model for StepMetrics.
+type StepMetrics = types.StepMetrics
+
+// StepRecord defines model for StepRecord.
+type StepRecord = types.StepRecord
+
 // WorkerConfig Sanitized worker configuration
 type WorkerConfig = types.WorkerConfig
 
@@ -180,6 +210,21 @@ type WorkersResponse struct {
 	Workers []WorkerDetails `json:"workers"`
 }
 
+// StreamAllMetricsParams defines parameters for StreamAllMetrics.
+type StreamAllMetricsParams struct {
+	// Interval Update interval in seconds (default 5)
+	Interval *int `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// TriggerWorkerWebhookJSONBody defines parameters for TriggerWorkerWebhook.
+type TriggerWorkerWebhookJSONBody map[string]interface{}
+
+// TriggerWorkerWebhookParams defines parameters for TriggerWorkerWebhook.
+type TriggerWorkerWebhookParams struct {
+	// XHubSignature256 HMAC-SHA256 of the request body with the webhook secret, as `sha256=<hex>`
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
+}
+
 // GetWorkerLogsParams defines parameters for GetWorkerLogs.
 type GetWorkerLogsParams struct {
 	// Role Filter logs by role (collector, executor, both)
@@ -198,6 +243,33 @@ type GetWorkerLogFileParams struct {
 	Tail *int `form:"tail,omitempty" json:"tail,omitempty"`
 }
 
+// StreamWorkerLogFileParams defines parameters for StreamWorkerLogFile.
+type StreamWorkerLogFileParams struct {
+	// Tail Number of last lines to send before following (default 100)
+	Tail *int `form:"tail,omitempty" json:"tail,omitempty"`
+}
+
+// StreamWorkerMetricsParams defines parameters for StreamWorkerMetrics.
+type StreamWorkerMetricsParams struct {
+	// Interval Update interval in seconds (default 5)
+	Interval *int `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// GetWorkerRunsParams defines parameters for GetWorkerRuns.
+type GetWorkerRunsParams struct {
+	// Limit Maximum number of runs to return (default 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of newest runs to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// TriggerWorkerWebhookJSONRequestBody defines body for TriggerWorkerWebhook for application/json ContentType.
+type TriggerWorkerWebhookJSONRequestBody TriggerWorkerWebhookJSONBody
+
+// SetWorkerStepEnabledJSONRequestBody defines body for SetWorkerStepEnabled for application/json ContentType.
+type SetWorkerStepEnabledJSONRequestBody = StepEnabledRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// API documentation
@@ -206,9 +278,15 @@ type ServerInterface interface {
 	// Control plane health check
 	// (GET /health)
 	GetHealth(ctx echo.Context) error
+	// Stream metrics from all workers
+	// (GET /metrics/stream)
+	StreamAllMetrics(ctx echo.Context, params StreamAllMetricsParams) error
 	// OpenAPI specification
 	// (GET /openapi.yaml)
 	GetOpenAPISpec(ctx echo.Context) error
+	// Trigger flow cycle from webhook
+	// (POST /webhooks/{worker_id})
+	TriggerWorkerWebhook(ctx echo.Context, workerId string, params TriggerWorkerWebhookParams) error
 	// List workers
 	// (GET /workers)
 	GetWorkers(ctx echo.Context) error
@@ -221,9 +299,18 @@ type ServerInterface interface {
 	// Worker flow configuration
 	// (GET /workers/{worker_id}/flow)
 	GetWorkerFlow(ctx echo.Context, workerId string) error
+	// Cancel current cycle
+	// (POST /workers/{worker_id}/flow/cancel)
+	CancelWorkerCycle(ctx echo.Context, workerId string) error
 	// Worker flow steps
 	// (GET /workers/{worker_id}/flow/steps)
 	GetWorkerFlowSteps(ctx echo.Context, workerId string) error
+	// Enable or disable flow step
+	// (PUT /workers/{worker_id}/flow/steps/{step_name}/enabled)
+	SetWorkerStepEnabled(ctx echo.Context, workerId string, stepName string) error
+	// Trigger flow cycle
+	// (POST /workers/{worker_id}/flow/trigger)
+	TriggerWorkerFlow(ctx echo.Context, workerId string) error
 	// Worker health check
 	// (GET /workers/{worker_id}/health)
 	GetWorkerHealth(ctx echo.Context, workerId string) error
@@ -233,9 +320,27 @@ type ServerInterface interface {
 	// Worker log file
 	// (GET /workers/{worker_id}/logs/{filename})
 	GetWorkerLogFile(ctx echo.Context, workerId string, filename string, params GetWorkerLogFileParams) error
+	// Stream worker log file
+	// (GET /workers/{worker_id}/logs/{filename}/stream)
+	StreamWorkerLogFile(ctx echo.Context, workerId string, filename string, params StreamWorkerLogFileParams) error
 	// Worker metrics
 	// (GET /workers/{worker_id}/metrics)
 	GetWorkerMetrics(ctx echo.Context, workerId string) error
+	// Stream worker metrics
+	// (GET /workers/{worker_id}/metrics/stream)
+	StreamWorkerMetrics(ctx echo.Context, workerId string, params StreamWorkerMetricsParams) error
+	// Pause worker
+	// (POST /workers/{worker_id}/pause)
+	PauseWorker(ctx echo.Context, workerId string) error
+	// Resume worker
+	// (POST /workers/{worker_id}/resume)
+	ResumeWorker(ctx echo.Context, workerId string) error
+	// Worker run history
+	// (GET /workers/{worker_id}/runs)
+	GetWorkerRuns(ctx echo.Context, workerId string, params GetWorkerRunsParams) error
+	// Worker run
+	// (GET /workers/{worker_id}/runs/{run_id})
+	GetWorkerRun(ctx echo.Context, workerId string, runId string) error
 	// Worker status
 	// (GET /workers/{worker_id}/status)
 	GetWorkerStatus(ctx echo.Context, workerId string) error
@@ -268,6 +373,26 @@ func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
 	return err
 }
 
+// StreamAllMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamAllMetrics(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamAllMetricsParams
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamAllMetrics(ctx, params)
+	return err
+}
+
 // GetOpenAPISpec converts echo context to params.
 func (w *ServerInterfaceWrapper) GetOpenAPISpec(ctx echo.Context) error {
 	var err error
@@ -279,6 +404,44 @@ func (w *ServerInterfaceWrapper) GetOpenAPISpec(ctx echo.Context) error {
 	return err
 }
 
+// TriggerWorkerWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) TriggerWorkerWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TriggerWorkerWebhookParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Hub-Signature-256, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Hub-Signature-256: %s", err))
+		}
+
+		params.XHubSignature256 = &XHubSignature256
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TriggerWorkerWebhook(ctx, workerId, params)
+	return err
+}
+
 // GetWorkers converts echo context to params.
 func (w *ServerInterfaceWrapper) GetWorkers(ctx echo.Context) error {
 	var err error
@@ -344,6 +507,24 @@ func (w *ServerInterfaceWrapper) GetWorkerFlow(ctx echo.Context) error {
 	return err
 }
 
+// CancelWorkerCycle converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelWorkerCycle(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelWorkerCycle(ctx, workerId)
+	return err
+}
+
 // GetWorkerFlowSteps converts echo context to params.
 func (w *ServerInterfaceWrapper) GetWorkerFlowSteps(ctx echo.Context) error {
 	var err error
@@ -362,6 +543,50 @@ func (w *ServerInterfaceWrapper) GetWorkerFlowSteps(ctx echo.Context) error {
 	return err
 }
 
+// SetWorkerStepEnabled converts echo context to params.
+func (w *ServerInterfaceWrapper) SetWorkerStepEnabled(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	// ------------- Path parameter "step_name" -------------
+	var stepName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "step_name", ctx.Param("step_name"), &stepName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter step_name: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetWorkerStepEnabled(ctx, workerId, stepName)
+	return err
+}
+
+// TriggerWorkerFlow converts echo context to params.
+func (w *ServerInterfaceWrapper) TriggerWorkerFlow(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TriggerWorkerFlow(ctx, workerId)
+	return err
+}
+
 // GetWorkerHealth converts echo context to params.
 func (w *ServerInterfaceWrapper) GetWorkerHealth(ctx echo.Context) error {
 	var err error
@@ -449,6 +674,41 @@ func (w *ServerInterfaceWrapper) GetWorkerLogFile(ctx echo.Context) error {
 	return err
 }
 
+// StreamWorkerLogFile converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamWorkerLogFile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	// ------------- Path parameter "filename" -------------
+	var filename string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "filename", ctx.Param("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filename: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamWorkerLogFileParams
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", ctx.QueryParams(), &params.Tail)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tail: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamWorkerLogFile(ctx, workerId, filename, params)
+	return err
+}
+
 // GetWorkerMetrics converts echo context to params.
 func (w *ServerInterfaceWrapper) GetWorkerMetrics(ctx echo.Context) error {
 	var err error
@@ -467,6 +727,129 @@ func (w *ServerInterfaceWrapper) GetWorkerMetrics(ctx echo.Context) error {
 	return err
 }
 
+// StreamWorkerMetrics converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamWorkerMetrics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamWorkerMetricsParams
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamWorkerMetrics(ctx, workerId, params)
+	return err
+}
+
+// PauseWorker converts echo context to params.
+func (w *ServerInterfaceWrapper) PauseWorker(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PauseWorker(ctx, workerId)
+	return err
+}
+
+// ResumeWorker converts echo context to params.
+func (w *ServerInterfaceWrapper) ResumeWorker(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResumeWorker(ctx, workerId)
+	return err
+}
+
+// GetWorkerRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkerRuns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkerRunsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkerRuns(ctx, workerId, params)
+	return err
+}
+
+// GetWorkerRun converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkerRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "worker_id" -------------
+	var workerId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "worker_id", ctx.Param("worker_id"), &workerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter worker_id: %s", err))
+	}
+
+	// ------------- Path parameter "run_id" -------------
+	var runId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", ctx.Param("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter run_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkerRun(ctx, workerId, runId)
+	return err
+}
+
 // GetWorkerStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GetWorkerStatus(ctx echo.Context) error {
 	var err error
@@ -515,16 +898,27 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/docs/", wrapper.GetSwaggerUI)
 	router.GET(baseURL+"/health", wrapper.GetHealth)
+	router.GET(baseURL+"/metrics/stream", wrapper.StreamAllMetrics)
 	router.GET(baseURL+"/openapi.yaml", wrapper.GetOpenAPISpec)
+	router.POST(baseURL+"/webhooks/:worker_id", wrapper.TriggerWorkerWebhook)
 	router.GET(baseURL+"/workers", wrapper.GetWorkers)
 	router.GET(baseURL+"/workers/:worker_id", wrapper.GetWorker)
 	router.GET(baseURL+"/workers/:worker_id/config", wrapper.GetWorkerConfig)
 	router.GET(baseURL+"/workers/:worker_id/flow", wrapper.GetWorkerFlow)
+	router.POST(baseURL+"/workers/:worker_id/flow/cancel", wrapper.CancelWorkerCycle)
 	router.GET(baseURL+"/workers/:worker_id/flow/steps", wrapper.GetWorkerFlowSteps)
+	router.PUT(baseURL+"/workers/:worker_id/flow/steps/:step_name/enabled", wrapper.SetWorkerStepEnabled)
+	router.POST(baseURL+"/workers/:worker_id/flow/trigger", wrapper.TriggerWorkerFlow)
 	router.GET(baseURL+"/workers/:worker_id/health", wrapper.GetWorkerHealth)
 	router.GET(baseURL+"/workers/:worker_id/logs", wrapper.GetWorkerLogs)
 	router.GET(baseURL+"/workers/:worker_id/logs/:filename", wrapper.GetWorkerLogFile)
+	router.GET(baseURL+"/workers/:worker_id/logs/:filename/stream", wrapper.StreamWorkerLogFile)
 	router.GET(baseURL+"/workers/:worker_id/metrics", wrapper.GetWorkerMetrics)
+	router.GET(baseURL+"/workers/:worker_id/metrics/stream", wrapper.StreamWorkerMetrics)
+	router.POST(baseURL+"/workers/:worker_id/pause", wrapper.PauseWorker)
+	router.POST(baseURL+"/workers/:worker_id/resume", wrapper.ResumeWorker)
+	router.GET(baseURL+"/workers/:worker_id/runs", wrapper.GetWorkerRuns)
+	router.GET(baseURL+"/workers/:worker_id/runs/:run_id", wrapper.GetWorkerRun)
 	router.GET(baseURL+"/workers/:worker_id/status", wrapper.GetWorkerStatus)
 
 }
@@ -532,60 +926,100 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xc+28bN/L/V4j9Fvi2OMlS+gIq4H7wpS+jztWIHeRwiU+gdke7rLnkluTKVgP97we+",
-	"9smVVnbj+oz8FHtJDoczn+E8OM6HKOZ5wRkwJaPFh0jGGeTY/PiSszVJX4MsOJOgvxSCFyAUATMem3H9",
-	"02cC1tEi+r9ZTWvmCM3ecnEDwtKKdpNIkRykwnmhFyYgY0EKRTiLFpHfCtVzJtGaixyraBElWMFUj0ST",
-	"SG0LiBaRVIKwNNrtJpGA30siIIkW7zxjzb2uqzV89RvEKppEd9OUT91H/Y886Ry4MWVK8oILZWSAVRYt",
-	"IlwqrgDnM8IUCIbpzNAwvLzkTAlOLyhm8DNgqrJhIeYgJU6hL4zTJCH6R0xRZmggwqws9HhPBJNIKqxK",
-	"2Sf06wYEphTFlitUaLY8TbdoEgErcy07+30bTaIEUoETSKJJVDL/+Tqw8R6N2tOjOIP45mitTqJbgx25",
-	"tLtr8riSykVLjH32a571zwJwnOEVheAJgkxb0SC+RnotsqAqBSTIshV1IdWFYSXb5sE7R+rhcjeJfhCC",
-	"i31mlwTgYhYhMxY4IOjRoUUegsep1q59oKVavo421LaE7m2nP1J+e8bWvC9jYBoryVIqKAIm9c8yX4Ew",
-	"0LATZzhWZAPIzq+OoPdMQRgN3EFc6uXLmJdM9WlecYUpYhXlNeW3qFoVJkqxVMtqToCmF6smqCd3qI42",
-	"RFnGMUi5FFgFsHdpR5EeDbNe7bKmHKt6B3tcgzR9/CF5d2VjpiHCkMrA7BaQTgdpTfqTjn5HYa4Cy4Pg",
-	"NmzW5hgHfGnFw+P4US/Z44yzdcwHCetSQRG2T2tu/ZO/zUBlIAwutG4RkSguhQCm6NZDkqX1yVecU8BM",
-	"CxSLNAC90xSYmsoCYrImMcIiLXMTK00ioiA3K/rXpv2AhcBb618KYAmweLssOCXxtr/PhfmO1lygDLOE",
-	"EpaiehlaY0JLAU1PrT8t11hqLWBKl85G3W8aPRSU1gBm22ow7P30NnIZukHOiVTe4hDDOUikMiLt724h",
-	"MhfJeGk44xunPT85pDFgm30xwQE3/wPbEMGZ1ibaYEH0PtIowO8fBZzzwXu89g3GbhriyrBEKwDmcNg8",
-	"VONOJ6woA2TP9GdUCJ4XaoDJ+qDWL+zz+WvBc+sRms5ggNAxDuZ432L24KUKHvtX8/0odgUosV2a3Ubw",
-	"a2YjrBTkhRrNs7aEPvU3jPxeQm0rwZVwdwSLejbSt39SUkgss6OZPCBTJTCTVUaBtAAoVkFKdt8Dzum1",
-	"nlTnefaMXrA9Jl7aW7ktf/T5HP0drYmQmr3tF0EDkTekWN5mEIDj5Q0pdKBu74O9R/IRzUErdhPXJT0U",
-	"jdkQQx/IXUChIKZ1Xoly3AzYm8TMl6A3QmbskPNuAnC036487oP9thyOdKogr3IZh2Keiq2AN/kYcZDT",
-	"5cggfSjo76WDbpohfa+wqi3Ye+vIJrgvdVJ+REGilcvvyRmH6hCt9QJkSVUjmimwCV10UBMIUsKZ9Si5",
-	"NQ/7QIkNQxpruxxXCfM4NnKQ++KXfbSap+pFNmcsIRuSlHXpqCl0GQprDhWPDpeL/poa0ZiSi1XOEWB5",
-	"uIWd8/RHQkNZHqEQDh7OeYr06GDkkPOErEkobj7XgYwfvsdlJzgN5VIGrkgPIiwljwlWkKBbojJEedoA",
-	"QcwphViZSo71kebHFW8VuBo3BPkjsJ8WGNJDOrFfbRW0CgeEqW+/PnzJVvJ1uzTENgoAXnEP0fwe10d5",
-	"Ot7zeV6emtOjDqkjfJ45771dXkuW99bIKx2QxXJfCd5MGHd9O2qPVIHxrB0tuu6h7y29ZlTfD+JdTdxm",
-	"EDorteHtCjK8IeYSaIt6heMbvl5bSmusQ4BFtCZ3Jhdu0/6HnYqkElhBum2QT4DibasU4ijAnVUaMWij",
-	"hAEWAyUPirctLuZ9J0o0HbsZWoG61am7i+71FSVBZxqajRzfkVxz8tV8Polywuxv81Bcn+M7nxTJFgMv",
-	"ugy8slQbhtfOHZobv2ju+2Jo3/6pDcPhbY8697fzAwzsAjHHpXHWf2pQlQffRnyuqYlj96aW25cSj6Bh",
-	"XzUQGIVo9qIjkhhXIkrGbNXR1mSODJCsnO5xn5dFuMTgPLsdRomz3/HBlZPdPWOsjtrvfTO1Hpb7QsPa",
-	"fv+oXuuq9zt/1uCDz6Cs3Lh/FMQSOSEFxK6TwsMvR3Xq2HhaDJYCwvGiY2woWtRSW4ZXXgHOzTpXRySy",
-	"96LZLd4ObB4uREyiDQgZrBe6dX48hLjDAGop/oHw+R4UJlT2Lx8yjIWz7209souocDky9ml2IGbPHvY8",
-	"PnQ3va0QzyBWZEPUtn831S/i7fdx/dsN47cseE2Vgg7ud3pxht68Ph9+yF8S95gz9kLvXEDE9CII7duH",
-	"KgBdxQ57l48TQjtDGnVGj73uMWtjHI75qnMOvI9tMKFGnUPKcjMIbYGj/8Zyv8vnsS+NocJnTfV6/M3y",
-	"sAJoO00IaCatH1QGngBONyBwCp13dLT3AQU7Qx/z5lHNfWggYcvRY+KIsbJ/VSU8DxL/o9v9yNS510QU",
-	"fknwY2MrBZ37pFsvCN4vI/NyvVhCXAqitpd6OyvF04L8AtvT0nZldWqGhYuHtUO4AZu54VJlOjOLPUaI",
-	"npoBTsxVZ6+Z6F/T04uz6S+wrcWCzU7RbmfeRu1lF3OmcGwg4RaelorruMY5iEWUKVXIxWyWEpWVq5OY",
-	"57MtL8WUi3Sm8TPVAAr0fl1dXRi+Nc85ZjglLEWYJSjnjCiu9Y3ykipSUEB+V6/Mk/fsPbvSAZUmgWNl",
-	"IkWMYmBKYIq4iDMwCa0JvLB50L3TeZXWD0glkeKI1OXb28qzatqnlCJgScEJ05QFIAE4mXJGtxNNaUMS",
-	"w17NqWbchayN7kGkV5y8ZyZHjsGZiZPkq7OrnhB5AUzyUsRwouXnFsmZnmtyF0WbSkCuARKZDkjNfeMi",
-	"XkQvTuYnc/NAWQDDBYkW0Vcn85OvdFCOVWbwNUt4LGf6pxQCz3OXtzhNQaA3VlHmHnAtWFryCY9Nl4bH",
-	"WpWknSXRIvoJlFv/RjMmnNWbfb+czz3AXPKp4E7NMpXTujs20Fyw6yGpweLPV6/OUYFTsNZU5jkWWy2v",
-	"AKsKp1IbqT5/dK3nz+rux6AsXoMqBZOmISDrti7qj+3GTw0JnKYCUlvOtQhzewRE9bMfOSAnXBTUWffs",
-	"N2n9eC2ufbfXnnbZgFxf7mlj3U2ib/5ExtpthgFezpz/QRLERmeJJr1vKznIb+yeo7y2fTeo0bczi5Mt",
-	"tqA7qPVfC2AaS75LqS7HKXcXhRTrVl0WEB+n3bup5+wIcwjy2BFVeE7QJhoecq94qOtfcg3QHedrjEFl",
-	"QEQdAvfk9LZylx/NArpBS0B+vhHLH/wpQt3w2IgunNr8l5bmZh98TpjsDmoxsZENwiteKoQrgNR1iwGt",
-	"GZcicA7KgOXdYEbvAxITYVbhSMVi1IyglChhsgf71x8dKd3kNqAvd7Kkjgm/nn/9eHDxCSJXaM1LljxJ",
-	"vP4EHq6VnI5A7az++5cgeC8Ev9vqeM4u+X9f56tCuGHYVrWt5wXezt/XDMOmXVl7Gtj98tEZaFbl2sB9",
-	"Gy5pe+jqpGK7B7i+1XwkbG0Z4iBof7Td4s8Lsq1m9mFVGRF9Qu0o1AZkdRx0Z9XbyjEAtk8t42B86Vr1",
-	"nh+W2x2EBwBt5fwJyPuAXHV1jgTwgWS+D16XMR4GbpWoPy/UHq4IvG2WMBqlgE8Br3iytjNQBzlkPb5/",
-	"bqTt6OkjLOfcdqk9vt1MAj2QCoTle7W1vZefV+2VE+S7KydoxVX2hefl9xLM34U4Zkw/Z3Pf+zVq9tnr",
-	"tyRVvYBa7sKk6wNMUZIT1eKq6kL6Zt5qZTrUSvQxb5tWy+Ewvg0OPznGAeP2XZ9HGPXsg+/c3R1n37Zn",
-	"epSNu8beJ2DmvtfbPVUHNmqMDu9TYKXv/GgR/ecdnv5xOv33fPrd8mR6/bf3708oTz+LRth03ZFk3oYp",
-	"YSOMWWFCW7bcNN8/wYDNo0tBMWFHlpmrLnpP7C8yUi5qcP5PGKxh9QijbTRNjzRWt2KErdZNAM8rlO02",
-	"ZQ9rLK+7zD+5mBBiG43xIwFbN8uNxKt7RD0M10v/dPS80Nrp0x1W1pPKuJ4gVuvuui5UG701BjHNrpp3",
-	"17vJB61gm8+FIHXOY9z9f53s7FYHx2I2o3pmxqVafDf/bh5pso6ZAZia1hcw/zcCZglKiIz5BsS27j/p",
-	"QlcDYO+f9gVWumJIf+GFaYtJGv0voeVWjrvr3X8DAAD//8DApITFTAAA",
+	"H4sIAAAAAAAC/+xde2/cOJL/KoRugZvg2t2dzGSA8WL/8GYyE98mm1ycQRY3ztm0VN3NjURqSMp2b+Dv",
+	"fig+9KRaUjtxvIH/iiOJZLH4qweLxepPUSyyXHDgWkWHnyIVbyCj5s9ngq/Y+i2oXHAF+CSXIgepGZj3",
+	"sXmPf/1Jwio6jP5jUfW1cB0t3gv5EaTtK7qZRZploDTNcmyYgIolyzUTPDqM/FCk+mYWrYTMqI4Oo4Rq",
+	"OMA30SzS2xyiw0hpyfg6urmZRRL+KJiEJDr83RNWH+tD2UZc/BNiHc2i64O1OHAP8R81b0249skBy3Ih",
+	"teEB1ZvoMKKFFhpotmBcg+Q0XZg+DC3PBNdSpG9SyuEF0FRv+pmYgVJ0DV1mHCUJwz9pSjamD8K45QW+",
+	"77BgFilNdaG6Hb2+BEnTlMSWKpIjWb5P12gWAS8y5J19vo1mUQJrSRNIollUcP/4Q2DgHStqZ0/iDcQf",
+	"J6/qLLoy2FFndnTsnpZcedNgY5f8gtf/lkDjDb1IITiDINGWNUSsCLYlFlSFhIRYsqI2pNowLHlbn3hr",
+	"Sh1cVvDpxwyNY8g1JF1+/0JTBeRqA5zoDRAa43OyoQnhgsBqBbEm38F8PSc5LRTja0LNX+WkHlWzuhAi",
+	"BcqRol6QvigyyokEmiBviQRVpHoKOK128LymKw2yRnkNlyxJIZpFsuAcu5xFluypgPxcKqZcgoo5s9CC",
+	"j9U7jQXfW/E8l1LIXQo7CayhaUTMuwAvAd/2NaqmPmUNbNtbLoClazKrmxzam9G/pOLqmK9El8fAURKS",
+	"M6UhD+D970V2AdIoFfvhApF+CcR+X04Bx1yDNCtwDXGBzc9iUXDd7fOd0DQlvOx5lYorUrYKd5pSpc/K",
+	"bwJ9erZih/hxq9fRKlwVcQxKnUmqA9g7sW8Jvg2TXo6ySgWtqRY7XYM0nH4fv9u8MZ8RZtUjjhbgTgtp",
+	"9f5nrfUdhbkSLLeCW79Ym2kMeGElDXejHj1npwlnY5q3YtaJhjwsn1bcAoZoA3rjjA+uLWGKxIWUwHW6",
+	"9ZA0hqdrHqlcB6B3tAauD1QOMVuxmFC5LjJck2gWMQ2ZadFVm/YBlZJurWeSA0+Ax9uzXKQs3nbHeWOe",
+	"k5WQZEN5kqJVr5qRFWVpIaHu4+GjsxVVuAo0Tc+cjLr/IXpS0LgClG/Ll2G/CYdRZyEN8pIp7SWOcJqB",
+	"InrDlP2/a0gEn8QNJ3zjVs9/HFox4Je7vMkBB/E5v2RScFxNckklw3GUWQA/fhRw6wb1eGUbNGuya0MV",
+	"uQDgDof1SdV0OuN5Eej2GB+TXIos1z1EVhO1dmGXzV9JkVmLUDcGPR1NMTDTbYsZQxQ6OO3X5vkkciVo",
+	"uT0zo42g13xNqNaQ5Xo0zZyGev+Nsz8KqGQl2BKuJ5CIXxPU/kmRQmKJHU3kAE+1pFyVe1GCDEipDvZk",
+	"xx0wTm/xoypCYOfoGdsh4pnVyk3+k++W5C9kxaRC8raPggKiPrL8DDdHAUfkI8txi5ewwSl5j2ZQit2H",
+	"qyId8sasi4ETcgoo5MQ05qtIRhMId2aeBK0RMe+GjHcdgKPtdmlxb223Vb+nUzp5pckY8nlKsgLW5Ev4",
+	"QW4tRzrpfU5/J5DgPjNd7+VWNRm79xrZ0MgzDOdMCGU1okA79ox9QYJG+zLG4L2ZnBrXBZ2agJMSjsmM",
+	"4lt9srfk2I5YDsrluBiqx7Hhg9rlv+zqqz6rjmdzzBN2yZKiCjrWma5Cbs1Q2HE40Ph1ootjgnV2cSaA",
+	"5fYSdqxBGrP6FmIhkwBgnAHYZXkM3UQWXM0I43FaJLgp8PYlZDSSwo4acB89QaT8ZnyY6P1ma9xNVnaC",
+	"YgrJn83TVAhjdDXjBUYgmd6Y58Z3KZsEh7tm+qwnnHXNtIlmGUd6A9ZZM56VLLjf+gd6r3vS5dsd7LC6",
+	"fIbIltqEUjV5HOzO+t4hK8MTwICy/YAoY6KFIdBCL6gkE5AyuEtROhGF7nkVDI8YsxDki4GRXSHDO7M4",
+	"3hCNMr/YtwNxx/i2hK++GiW+a6AcJYFtydlbBF+K9S8sDQVaWAph//2lWBN82+u8ZyJhKxbaur5EePrX",
+	"e/gbUqTQG1fHl4QqJWJGtRexVKxrejgWaQqxNsFU66aaPy9E43SiGk+xfwXGQ4YRfIVAuthqaMTuGNc/",
+	"/jDs55T8daPU2DYKAX7hbrPyO7zPVKzHO5+elvvmd6YOqSPcTjPfvb3OBi/3XpFXoCWL1a7zU/PBOA/K",
+	"9XZHQVBP2mTWtSe9N/fqG+vuPtodaDq7LKTbYV7Ahl4yowSarL6g8UexWtmeVhS98MNoxa5NOKrZ91/t",
+	"p0RpSTWst7XuE0jpthGNdD3AtV00ZtCWMg5U9kQdU7ptULHs+rEM+7GDkQvQVwDcO0CoohTgZh/JyOg1",
+	"y5CS75fLWZQxbv+3DBnyjF6fNf0vR8DjNgGvbK81wWtu3+sDP66P+7hv3O6sDcHhYSfN+8flAAE3Abf/",
+	"bdHrofb7km+LAS+Sm+7GKbydUUq9oZqge5UYt0YWwfFYEqbx+Oee7anU4+mrNkYe6VWc3XrBTjMkGMOM",
+	"ZlFMeQxpz5n2tNDHFN8rifzU7ALU/K7akfaE864KGvsrroKX2q+DL1zMoYhiwWvT//Kq3uFrmpqvT/I2",
+	"nDopsozKbZdRD4L4hQRxOPYrDfogGRvimyCFbvCxEPPouA3C1E5hHK+WauTcN7+4XDAzocH18l/t5xs3",
+	"WLr3wpwYVHzW0GIWDKn4ExfsnLqcRPNl5cT1bhftqVXBe86s3vtUtdZ5lQmPx9sYd5UoFYp8JzKmzR52",
+	"g3tNl7UmJHEpYY9GI6IvYhmaZids2clDs9pvYuTSLt0eaC7yHj7a/b59vUO190U93XLuGfxsIfEWiIb8",
+	"uT22fwt/FKB0b4LViBwAtRFFmjRNTpkD0GKE73XkdDtkfp4p90ny+Dk3tVct48FYjnDw6hd/FNUbvboD",
+	"D6qir0rzmKxXQ8y81cq8qqILd5BGdLk+63fZjvA8ZQ3VUfJuB258ssnA2bTLHxrux7pSQ92Vod7hDk3w",
+	"ufxeNQW5ncvYz7ifiyqijcuSCZO8EaOq/3wpLLt6vk1myMkuubRjlXNX/ZNXO2lUMyLSBJS2GRSTcrJs",
+	"tsbI1NROEKS7mlKkmMJ2Nk4akjGLq+4qjYNlIAo93KVnAPnIUpSZC4jRncEZoJKIAfx+yegQ1++wV2pw",
+	"MlpTvirjk7fQkPflmNLIyS6NWCmSHYd6qnXsNfasq332FJCTPcT7854bYvKTBKoE7z+ntUd9VBH8Oock",
+	"2NHU7XTVFxf6TNOPwOubbIRw71WOfU47w+lMpau79wFjDe57i0zjNlwXDZQzzf5V3sYpLx15VI91C21r",
+	"9768XaOIY1GA0bjhGr60UGUt1e5DBSU1DHdHWK+fCTTrcVLfAc1MO5fCylTnGlbbRvUMHs6Bm0WXIFVQ",
+	"tbh2/n3Ikx0GT2Phbwmfn0FTlga8UtaPheOfbSpsG1Fhryf2GV6Bs+rN7e70DdwEiwXngM4109vu7ru6",
+	"xte81If/+8jFFQ8qkUKmveMdvTkmv7192X/78Iy5ewRjoyihyB5SMOtNPmsvbP9G8MuEyJwgjZqjx157",
+	"mpUw9m/Zynn2XM24pCw1y9m3WO4LljbA0d1J7ad87lpp9OXcVr1+GK9Zbpd72zwe79nt9poHc7mjvFwV",
+	"3u6O31Cg419tX8PhLu/+N++LkT6AG+M2YUvsr8Ako+7Smd732U6MvqhHnUIccy2h/HbSLYohhu/aRHdu",
+	"BvbtU5uDTN2ttrh1my1rbYs5FmnJaAaEd5th0XkD8gBfVa1JlTUy+qS3ntbSmmpfvNheBxgTLh6rgG69",
+	"m7Td3LnxG3k+1Ln+HxZX/27siVjLqA6c1ddGHj54wsYK4kIyvT3B4SwXj3L2N9geFXrTnfTr3B17oFf0",
+	"EWzaDi30BrhmsccIw083QBNj762tjf5xcPTm+OBvsK3YQs1I0c2NuZtmLX4suKaxgYRreFRogc6985IO",
+	"o43WuTpcLNZMb4qLeSyyxVYU8kDI9QLxc4AAClRtePfujaEbac4op2uTk8sTkgnOtMD1JlmRapanQPyo",
+	"fjHnp/yUv8NdBXZBY222S5SgfEuaEiHjDZhsJn+mnUtxzUARaU8AFG7AWZU+f1W6l9j3r8/f4SF4LhjX",
+	"CptesgTqhCGdbptWK/PxZ/Lm9Um9ZYEbf9Q/p/zc1fM4J5qu0fSC9IOWymR+yk0qVQxOoBzPXx2/67Bb",
+	"5MCVKGQMc+S0a6QW+K2Jbem0vlzEFS0gpsoJzrPmtxxGj+fL+RLbYbc0Z9Fh9P18Of/eFG7QG4PERSJi",
+	"tcC/1hAwmSdXdL0GSX6zS2o0hrssj2uUiNjcp/WoLE/tjpPoMPoVtGv/27GJMFj9YMZ9slx6KLoDUg3X",
+	"erHRWVpVwAnFFTqYq5H44t2rlySna7By51MyohCpmq4VijPOP/qA3y+qCidBXrwFXUiujOXZtMuT4MNm",
+	"cRdEE12vJaxt1q+FhRsjwKoX/s0An2iep04PLP7pAkkVu3bpuR0lcQJ8fbajVM3NLHr6GQlrFoQI0HLs",
+	"LBVRIC9RuLBBa5GD9Mbu4pBfbV/xxay3s/ILpSVqs751/4XyslqBa0JsE7PycAlySySsmdJQmSZUXSeG",
+	"2oMTNPPPL3HKc/Ic69icu37OTzngcxJTabIDKfnvk9d/J9Z62Ezxc78FTs5nVUMDr/PS8pyTFYM0UXPi",
+	"LLjRj6c8ppwLTS6AmH26DVcmRQyElh0bZp4TS4hBrQQXAE6s8mpi9cTM/ShNK28jp5JmoI3R/b1zrzZH",
+	"2291xyVNaxmQ5DuXR0mePvI27Y8CTKKM05K+VTSrYWlK2uSHcYrHzP6gQsIUBWQaIRQ8OgozY9UCqPvO",
+	"f2QiQXg5q+ZQOJT6JxamTnvPtzRLe0FaV06vc+Co8nzZgyq5WDvjGtI/rtVJDvE0JXR94CmbwLQgjS2G",
+	"hb8Jqu4ruNgI8VEtPpXScmOcV6ECzPqfAgqwvDLi5twHciGSrXE5eE0aXOoLrafDGFuo58Sc2vt7yuqU",
+	"KzDnRySn21TQBPs6n5uuzuekTLNpFQHDAcm5m8GZgliCPp9VPk1WKCPJEk/tyK9MvyguDpTepkDO/3GA",
+	"/zlha051IeHgydMfz8mLV0fPiPLPCONKA03MqQr3XmVIsN9JhpbUapD3lp4h4S4jm15+zSajFN9yNaK6",
+	"E61lAbMdaJl1Lhm+Onp2cPLi6MnTH72xbaxZeWvNcZFYLs7MAqgNffL0x7+cFsvl9/EGrs0fcN7vRHdY",
+	"Gu0i9oOdGSj9V5FsJ9nE8NVRy532PuKmzcCbL+8pjHEPXHkzdyP4Zhb9cJeOwds6CpgiaOwuacoSI9iW",
+	"nMd3R84rpkxBN+MqWzpKObTE/HB3xPhQq9BkJQpuDkR/ePz9V1ocLQRJqVxbPjz56e7IeId6wfDiP5XT",
+	"63+gAUC8rIo0nbmMCNTh0rq2T+58leoHKU0r6LRyw/yg63BVKmhvD51d8SaxioLs9BhSVyPIladsBViM",
+	"CdQbYLKK9Xdch/elB/PFNFI7MBXgpC925Cd+HzcphsYhh8/9r+3L7FzFxEavCL0QhSa09JmqA9qeVfsq",
+	"Bv7DF0dK+xSvX/KSKu731dXzvcPrr+DhWvJpAmoXVXXiIHjfSHGNlqFSz7ZBGWzrh215iP9tgbdV/bgf",
+	"Ns0UgvuB3ftkNd+Hc3c8dDFwvN0BXF/OcSRs7eHTIGhxu/jNQbZRMLJ/qaz78oDaMagN8GoadBc2sa8/",
+	"AvLMvFdlBVbnWJpDlXC2/Jy8tddtbHajMlFCpQVmFRonUUJGmfnApiDge59fOO/IhCXA6XIc+1tU5bfY",
+	"Qz+IRT20b8DiUWmxOrTv6cpEefw/RalbLI9T7Sfumum3p9+blesGlLzl8wOKdyn38kbyFKVuGi0+lbe0",
+	"bha15ONgrVB7D0sRIUnClP2bVhQQajL/NctgTn62HyQ19e2SxmdECZKIK26PR+qFjU09ZAaKKM3SlCB7",
+	"t3OCERcF2hTkykEqptBexFIonzCMus7E1buW4cSLVO0i2f2IQnfu6AWGql+hmyi/+8WQh/KSWnck7ziQ",
+	"HLoMGJAde0RooWfiTHDnkeRjF6+tnyt8LSUmpIPZ/VVmdlFriqXSKtMts7Yhzn539cSewZV31GsuK8sy",
+	"SBjVkG7rJ11XlBn1U9baTgHy8gx6To5SJcytJ4yVMb5OwfVnb7brMmaM7rC95z7ffWD2TW7uHpzYLxjH",
+	"nyQoA8lJXffVNhjhupaJR98WdIcznN7XU7JqqU0PYWB5b73nnryuIf/Zl40cKTv4+QjJwfKK98Q1ZakG",
+	"aem+2NqSo9+VVUVnxBcVnREsEtOXbIXNGrkO+9Un7ZLXrcRXlsBEvktziNVDVMoyphtUlcX3ni4bFfxu",
+	"nwq2P8AblTb78W1w+GAbe4TbFzudINSLT75g7c00+TboGyfjrp7tPRBzX+K4f/9Ze9s/Tk61BomN/+93",
+	"evCvo4P/XR78dDY/+PBfp6fzVKz/FI2Q6VqNEKo0SRkfIcx4hBhO5Xy8XH6uXM48pYxPzEf0nCW+s6+3",
+	"9SvB+W8hsIbU/YV2MPlapKm4wl3aVXPIvvzqd77a/Dli7dzh0kSzgLuLarNTvjL9YtmPLeFwlW4xdmVL",
+	"JDoW+1TtVKx9cnR/lra7CoKJnn2Z2accMSaFvYFgvtKy4LH7rwT7y2GQ2J/2yak7gbnY1naj/fnYD9pq",
+	"L22lgGPpl5WQQCwmMGpQ5qU/Xi4f3VqVLb9yWjqKTLwp+Ef1oNV2ajXHsqu9lVutEPpIT8S1GOGIjLxt",
+	"8W+3T28XWu9fuKy6YvvgP4fMca3Y/TTADllhKxjl4Y0H7agrTrts5ynf44rTgAn8mnIye7j7VN59ehDS",
+	"ndZluqyaU4hdZyQiV/WDC5MlXv4wEoerWuhZzclRK+eHphJosvWlfMmKcaY2oAgXMqNpuu2ef7xBir7R",
+	"NOKHk4/PBnwDk1qlsNFnHsjRbAfkX4K5mOfKUDvYG8TXoU7omjLeRe9b0/sDfB/guxu+Fid74dfV5Q/6",
+	"VC9NVkxZ8t4A1pbh5HBVr6nTsx/A+vX3w8kJ/M5OwWuxwJqrs3w0Osq/+8dw+vf3jnueBMxh6hlTrFYK",
+	"egZd3vH5QePXCPpRahD1IKI9+x9ZcLJhSgu5rcnpkF+FPF18wp9HGHPRinZFtrp+bGvBzoj97e5ZVWMY",
+	"tzL16lU4D58s0y/f90O8y9+ACQxhuXZvrF7913x2CtFXDIQhYP4tRGmCCFX1REeGvWyDEVGvE3/p9Nty",
+	"z1o/1tG/UPcqK+Ue4rQqQNqGaq3ymkFMveba7x9uZp9wgW3OSwhSL0VM01apDvt1o2rX4WKR4pcbofTh",
+	"T8ufltHNh5KYHpiawmiQ+QIjCVOxMEWEvDioNnQRADt/eDvQ0iWM3cxC0siq/RIWBAk0t3y8mfXMoaqV",
+	"6FkU6MO9im4+3Pz/AKYc+W/jkgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
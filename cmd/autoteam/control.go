@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	controlplaneapi "autoteam/api/control-plane"
+	"autoteam/internal/config"
+	"autoteam/internal/logger"
+	"autoteam/internal/types"
+
+	"github.com/urfave/cli/v3"
+	"go.uber.org/zap"
+)
+
+// controlAction sends a control request for a worker through the control-plane client
+type controlAction func(ctx context.Context, client *controlplaneapi.ClientWithResponses, workerID string) (*types.ControlResponse, int, error)
+
+// controlPlaneFlags returns flags shared by commands that talk to the control plane
+func controlPlaneFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "control-plane-url",
+			Usage:   "Control plane API URL (default: http://localhost:<control_plane.port>)",
+			Sources: cli.EnvVars("AUTOTEAM_CONTROL_PLANE_URL"),
+		},
+		&cli.StringFlag{
+			Name:    "api-key",
+			Usage:   "Control plane API key (default: control_plane.api_key)",
+			Sources: cli.EnvVars("AUTOTEAM_CONTROL_PLANE_API_KEY"),
+		},
+	}
+}
+
+// triggerCommand runs a worker's next flow cycle immediately
+var triggerCommand = newControlCommand("trigger", func(ctx context.Context, client *controlplaneapi.ClientWithResponses, workerID string) (*types.ControlResponse, int, error) {
+	resp, err := client.TriggerWorkerFlowWithResponse(ctx, workerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.JSON200, resp.StatusCode(), nil
+})
+
+// pauseCommand stops a worker from starting new flow cycles
+var pauseCommand = newControlCommand("pause", func(ctx context.Context, client *controlplaneapi.ClientWithResponses, workerID string) (*types.ControlResponse, int, error) {
+	resp, err := client.PauseWorkerWithResponse(ctx, workerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.JSON200, resp.StatusCode(), nil
+})
+
+// resumeCommand lets a paused worker start new flow cycles again
+var resumeCommand = newControlCommand("resume", func(ctx context.Context, client *controlplaneapi.ClientWithResponses, workerID string) (*types.ControlResponse, int, error) {
+	resp, err := client.ResumeWorkerWithResponse(ctx, workerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.JSON200, resp.StatusCode(), nil
+})
+
+// cancelCommand cancels the flow cycle a worker is currently running
+var cancelCommand = newControlCommand("cancel", func(ctx context.Context, client *controlplaneapi.ClientWithResponses, workerID string) (*types.ControlResponse, int, error) {
+	resp, err := client.CancelWorkerCycleWithResponse(ctx, workerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp.JSON200, resp.StatusCode(), nil
+})
+
+// newControlCommand builds the action for a worker control subcommand
+func newControlCommand(name string, action controlAction) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		log := logger.FromContext(ctx)
+
+		workerName := cmd.Args().First()
+		if workerName == "" {
+			return fmt.Errorf("usage: autoteam %s <worker>", name)
+		}
+
+		// Load config
+		configFile := cmd.String("config-file")
+		cfg, err := config.LoadConfig(configFile)
+		if err != nil {
+			log.Error("Failed to load config", zap.Error(err), zap.String("config_file", configFile))
+			return fmt.Errorf("failed to load config from %s: %w", configFile, err)
+		}
+
+		workerID, err := resolveControlPlaneWorkerID(cfg, workerName)
+		if err != nil {
+			return err
+		}
+
+		client, err := newControlPlaneClient(cfg, cmd)
+		if err != nil {
+			return err
+		}
+
+		result, statusCode, err := action(ctx, client, workerID)
+		if err != nil {
+			return fmt.Errorf("failed to reach control plane: %w", err)
+		}
+		if result == nil {
+			return fmt.Errorf("control plane returned %d %s for worker %s", statusCode, http.StatusText(statusCode), workerName)
+		}
+
+		log.Debug("Control action completed",
+			zap.String("action", name),
+			zap.String("worker_id", workerID),
+			zap.Bool("accepted", result.Accepted))
+
+		fmt.Printf("%s: %s (status: %s)\n", workerName, result.Message, result.Status)
+		return nil
+	}
+}
+
+// resolveControlPlaneWorkerID accepts either a worker name or a control-plane ID (worker-N)
+func resolveControlPlaneWorkerID(cfg *config.Config, workerName string) (string, error) {
+	if strings.HasPrefix(workerName, "worker-") {
+		return workerName, nil
+	}
+	return cfg.GetControlPlaneWorkerID(workerName)
+}
+
+// newControlPlaneClient creates a control-plane API client from flags and configuration
+func newControlPlaneClient(cfg *config.Config, cmd *cli.Command) (*controlplaneapi.ClientWithResponses, error) {
+	url := cmd.String("control-plane-url")
+	if url == "" {
+		url = cfg.GetControlPlaneURL()
+	}
+
+	apiKey := cmd.String("api-key")
+	if apiKey == "" && cfg.ControlPlane != nil {
+		apiKey = cfg.ControlPlane.APIKey
+	}
+
+	var opts []controlplaneapi.ClientOption
+	if apiKey != "" {
+		opts = append(opts, controlplaneapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("X-API-Key", apiKey)
+			return nil
+		}))
+	}
+
+	return controlplaneapi.NewClientWithResponses(url, opts...)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"autoteam/internal/testutil"
+	"autoteam/internal/types"
+
+	"github.com/urfave/cli/v3"
+)
+
+const controlTestConfig = `repositories:
+  include:
+    - "owner/test-repo"
+workers:
+  - name: "dev1"
+    prompt: "Test agent"
+  - name: "disabled"
+    prompt: "Test agent"
+    enabled: false
+  - name: "Review Bot"
+    prompt: "Test agent"
+control_plane:
+  enabled: true
+  api_key: "config-key"
+settings:
+  flow:
+    - name: executor
+      type: claude
+      prompt: "Execute"`
+
+// newControlTestApp builds the control subcommands the way main wires them
+func newControlTestApp() *cli.Command {
+	command := func(name string, action cli.ActionFunc) *cli.Command {
+		return &cli.Command{Name: name, Action: action, Flags: controlPlaneFlags()}
+	}
+	return &cli.Command{
+		Name: "autoteam",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "config-file", Aliases: []string{"c"}, Value: "autoteam.yaml"},
+		},
+		Commands: []*cli.Command{
+			command("trigger", triggerCommand),
+			command("pause", pauseCommand),
+			command("resume", resumeCommand),
+			command("cancel", cancelCommand),
+		},
+	}
+}
+
+// captureStdout returns what fn prints to stdout
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() { os.Stdout = stdout }()
+
+	output := make(chan string)
+	go func() {
+		data, _ := io.ReadAll(reader)
+		output <- string(data)
+	}()
+
+	fn()
+	writer.Close()
+	return <-output
+}
+
+func TestControlCommands(t *testing.T) {
+	tempDir := testutil.CreateTempDir(t)
+	configFile := testutil.CreateTempFile(t, tempDir, "autoteam.yaml", controlTestConfig)
+
+	type request struct {
+		method string
+		path   string
+		apiKey string
+	}
+	var received []request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, request{method: r.Method, path: r.URL.Path, apiKey: r.Header.Get("X-API-Key")})
+
+		if strings.HasPrefix(r.URL.Path, "/workers/worker-9/") {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"Worker not found: worker-9"}`))
+			return
+		}
+
+		accepted := !strings.HasSuffix(r.URL.Path, "/resume")
+		message := "done"
+		if !accepted {
+			message = "worker is not paused"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(types.ControlResponse{
+			Accepted:  accepted,
+			Message:   message,
+			Status:    "idle",
+			Timestamp: time.Now(),
+		})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		args           []string
+		expectedPath   string
+		expectedAPIKey string
+		expectedOutput string
+		expectedError  string
+	}{
+		{
+			name:           "trigger",
+			args:           []string{"trigger", "dev1"},
+			expectedPath:   "/workers/worker-1/flow/trigger",
+			expectedAPIKey: "config-key",
+			expectedOutput: "dev1: done (status: idle)\n",
+		},
+		{
+			name:           "pause_by_normalized_name",
+			args:           []string{"pause", "review_bot"},
+			expectedPath:   "/workers/worker-2/pause",
+			expectedAPIKey: "config-key",
+			expectedOutput: "review_bot: done (status: idle)\n",
+		},
+		{
+			name:           "resume_not_accepted",
+			args:           []string{"resume", "--api-key", "flag-key", "worker-2"},
+			expectedPath:   "/workers/worker-2/resume",
+			expectedAPIKey: "flag-key",
+			expectedOutput: "worker-2: worker is not paused (status: idle)\n",
+		},
+		{
+			name:           "cancel",
+			args:           []string{"cancel", "dev1"},
+			expectedPath:   "/workers/worker-1/flow/cancel",
+			expectedAPIKey: "config-key",
+			expectedOutput: "dev1: done (status: idle)\n",
+		},
+		{
+			name:           "worker_not_found",
+			args:           []string{"trigger", "worker-9"},
+			expectedPath:   "/workers/worker-9/flow/trigger",
+			expectedAPIKey: "config-key",
+			expectedError:  "control plane returned 404 Not Found for worker worker-9",
+		},
+		{
+			name:          "unknown_worker_name",
+			args:          []string{"trigger", "disabled"},
+			expectedError: `no enabled worker named "disabled"`,
+		},
+		{
+			name:          "missing_worker",
+			args:          []string{"pause"},
+			expectedError: "usage: autoteam pause <worker>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			args := []string{"autoteam", "-c", configFile, tt.args[0], "--control-plane-url", server.URL}
+			args = append(args, tt.args[1:]...)
+
+			var err error
+			output := captureStdout(t, func() {
+				err = newControlTestApp().Run(context.Background(), args)
+			})
+
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("expected error containing %q, got %v", tt.expectedError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != tt.expectedOutput {
+				t.Errorf("expected output %q, got %q", tt.expectedOutput, output)
+			}
+
+			if tt.expectedPath == "" {
+				if len(received) != 0 {
+					t.Errorf("expected no request, got %+v", received)
+				}
+				return
+			}
+			if len(received) != 1 {
+				t.Fatalf("expected one request, got %+v", received)
+			}
+			want := request{method: http.MethodPost, path: tt.expectedPath, apiKey: tt.expectedAPIKey}
+			if received[0] != want {
+				t.Errorf("expected request %+v, got %+v", want, received[0])
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -80,11 +81,56 @@ func main() {
 				Usage:  "Create sample autoteam.yaml",
 				Action: initCommand,
 			},
+			{
+				Name:   "validate",
+				Usage:  "Check autoteam.yaml for errors and report their line and column",
+				Action: validateCommand,
+			},
+			{
+				Name:   "schema",
+				Usage:  "Print the JSON Schema of autoteam.yaml",
+				Action: schemaCommand,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "worker",
+						Usage: "Print the schema of the generated worker config files instead",
+					},
+				},
+			},
+			secretsCommand(),
 			{
 				Name:   "workers",
 				Usage:  "List all workers and their states",
 				Action: workersCommand,
 			},
+			{
+				Name:      "trigger",
+				Usage:     "Run a worker's next flow cycle immediately",
+				ArgsUsage: "<worker>",
+				Action:    triggerCommand,
+				Flags:     controlPlaneFlags(),
+			},
+			{
+				Name:      "pause",
+				Usage:     "Stop a worker from starting new flow cycles",
+				ArgsUsage: "<worker>",
+				Action:    pauseCommand,
+				Flags:     controlPlaneFlags(),
+			},
+			{
+				Name:      "resume",
+				Usage:     "Resume a paused worker",
+				ArgsUsage: "<worker>",
+				Action:    resumeCommand,
+				Flags:     controlPlaneFlags(),
+			},
+			{
+				Name:      "cancel",
+				Usage:     "Cancel the flow cycle a worker is currently running",
+				ArgsUsage: "<worker>",
+				Action:    cancelCommand,
+				Flags:     controlPlaneFlags(),
+			},
 		},
 	}
 
@@ -196,6 +242,42 @@ func initCommand(ctx context.Context, cmd *cli.Command) error {
 	return nil
 }
 
+func validateCommand(ctx context.Context, cmd *cli.Command) error {
+	configFile := cmd.String("config-file")
+	errs, err := config.ValidateFile(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to validate %s: %w", configFile, err)
+	}
+
+	// One file:line:column: message line per problem, for editors and CI logs
+	for _, validationErr := range errs {
+		fmt.Fprintf(os.Stderr, "%s:%d:%d: %v\n", configFile, validationErr.Line, validationErr.Column, validationErr.Err)
+	}
+	if len(errs) == 1 {
+		return fmt.Errorf("%s has 1 configuration error", configFile)
+	}
+	if len(errs) > 1 {
+		return fmt.Errorf("%s has %d configuration errors", configFile, len(errs))
+	}
+
+	fmt.Printf("%s is valid\n", configFile)
+	return nil
+}
+
+func schemaCommand(ctx context.Context, cmd *cli.Command) error {
+	schema := config.JSONSchema()
+	if cmd.Bool("worker") {
+		schema = config.WorkerJSONSchema()
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
 func workersCommand(ctx context.Context, cmd *cli.Command) error {
 	log := logger.FromContext(ctx)
 
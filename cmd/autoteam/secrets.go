@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"autoteam/internal/secrets"
+
+	"github.com/urfave/cli/v3"
+)
+
+// secretsCommand groups the commands managing encrypted secret files
+func secretsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "secrets",
+		Usage: "Manage keys and files of the encrypted_file secret provider",
+		Commands: []*cli.Command{
+			{
+				Name:   "keygen",
+				Usage:  "Generate a key for encrypted secret files",
+				Action: secretsKeygenCommand,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Write the key to a new file instead of stdout",
+					},
+				},
+			},
+			{
+				Name:      "encrypt",
+				Usage:     "Encrypt a secret for the encrypted_file provider",
+				ArgsUsage: "<file|->",
+				Action:    secretsEncryptCommand,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "key-file",
+						Aliases: []string{"k"},
+						Usage:   "Key file (default: $" + secrets.KeyFileEnv + " or $" + secrets.KeyEnv + ")",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Usage:   "Write the encrypted secret to a file instead of stdout",
+					},
+				},
+			},
+		},
+	}
+}
+
+func secretsKeygenCommand(ctx context.Context, cmd *cli.Command) error {
+	key, err := secrets.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	output := cmd.String("output")
+	if output == "" {
+		fmt.Print(key)
+		return nil
+	}
+
+	// Never overwrite an existing key, secrets encrypted with it would be lost
+	file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create key file: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(key); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Key written to %s\n", output)
+	return nil
+}
+
+func secretsEncryptCommand(ctx context.Context, cmd *cli.Command) error {
+	if cmd.Args().Len() != 1 {
+		return fmt.Errorf("usage: autoteam secrets encrypt <file|->")
+	}
+
+	key, err := secrets.LoadKey(cmd.String("key-file"), ".")
+	if err != nil {
+		return err
+	}
+
+	var plaintext []byte
+	if input := cmd.Args().First(); input == "-" {
+		plaintext, err = io.ReadAll(os.Stdin)
+	} else {
+		plaintext, err = os.ReadFile(input)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read secret: %w", err)
+	}
+
+	encrypted, err := secrets.Encrypt(key, plaintext)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	if output := cmd.String("output"); output != "" {
+		if err := os.WriteFile(output, encrypted, 0644); err != nil {
+			return fmt.Errorf("failed to write encrypted secret: %w", err)
+		}
+		return nil
+	}
+	_, err = os.Stdout.Write(encrypted)
+	return err
+}
@@ -123,8 +123,9 @@ func runControlPlane(ctx context.Context, cmd *cli.Command) error {
 
 	// Override configuration with CLI flags if provided
 	serverConfig := controlplane.ServerConfig{
-		Port:   controlPlaneConfig.Port,
-		APIKey: controlPlaneConfig.APIKey,
+		Port:          controlPlaneConfig.Port,
+		APIKey:        controlPlaneConfig.APIKey,
+		WebhookSecret: controlPlaneConfig.WebhookSecret,
 	}
 
 	if cmd.Int("port") != 0 {
@@ -11,6 +11,8 @@ import (
 
 	"autoteam/internal/logger"
 	"autoteam/internal/monitor"
+	"autoteam/internal/schedule"
+	"autoteam/internal/secrets"
 	"autoteam/internal/worker"
 	grpcworker "autoteam/internal/worker/grpc"
 
@@ -106,6 +108,9 @@ func runWorker(ctx context.Context, cmd *cli.Command) error {
 		zap.String("log_level", string(logLevel)),
 	)
 
+	// Register the secrets passed to the container by the generator so they are redacted
+	secrets.RegisterEnviron()
+
 	// Load worker configuration from file
 	configPath := cmd.String("config-file")
 	workerConfig, err := worker.LoadWorkerFromFile(configPath)
@@ -137,6 +142,11 @@ func runWorker(ctx context.Context, cmd *cli.Command) error {
 	// Create Worker instance for HTTP server
 	workerRuntime := worker.NewWorkerRuntime(workerConfig, effectiveSettings)
 
+	// Restore steps disabled at runtime before the previous restart
+	if stateErr := workerRuntime.LoadStepState(); stateErr != nil {
+		log.Warn("Failed to load step state, all steps enabled", zap.Error(stateErr))
+	}
+
 	// Start gRPC server if not disabled
 	var grpcServer *grpcworker.Server
 	if !cmd.Bool("disable-grpc") {
@@ -197,9 +207,36 @@ func runWorker(ctx context.Context, cmd *cli.Command) error {
 
 	// Note: Git operations now handled via MCP servers
 
+	// Build the cycle schedule (cron, windows and jitter fall back to sleep_duration)
+	sleepDuration := time.Duration(effectiveSettings.GetSleepDuration()) * time.Second
+	cycleSchedule, err := schedule.New(effectiveSettings.Schedule, sleepDuration)
+	if err != nil {
+		log.Error("Invalid schedule configuration", zap.Error(err))
+		return fmt.Errorf("invalid schedule configuration: %w", err)
+	}
+	if effectiveSettings.Schedule != nil {
+		log.Info("Using flow schedule",
+			zap.String("cron", effectiveSettings.Schedule.Cron),
+			zap.String("timezone", effectiveSettings.Schedule.Timezone),
+			zap.Int("windows", len(effectiveSettings.Schedule.Windows)),
+			zap.Int("jitter_seconds", effectiveSettings.Schedule.Jitter))
+	}
+
+	flowTimeout, err := effectiveSettings.GetFlowTimeout()
+	if err != nil {
+		log.Error("Invalid flow timeout", zap.Error(err))
+		return fmt.Errorf("invalid flow timeout: %w", err)
+	}
+	if _, err := effectiveSettings.GetHistoryRetention(); err != nil {
+		log.Error("Invalid history retention", zap.Error(err))
+		return fmt.Errorf("invalid history retention: %w", err)
+	}
+
 	// Initialize flow-based monitor with worker and effective settings
 	monitorConfig := monitor.Config{
-		SleepDuration: time.Duration(effectiveSettings.GetSleepDuration()) * time.Second,
+		SleepDuration: sleepDuration,
+		Schedule:      cycleSchedule,
+		FlowTimeout:   flowTimeout,
 		TeamName:      effectiveSettings.GetTeamName(),
 	}
 
@@ -218,7 +255,7 @@ func runWorker(ctx context.Context, cmd *cli.Command) error {
 	}
 
 	log.Info("Starting flow-based agent monitoring loop",
-		zap.Duration("sleep_duration", time.Duration(effectiveSettings.GetSleepDuration())*time.Second),
+		zap.Duration("sleep_duration", sleepDuration),
 		zap.Int("flow_steps", len(effectiveSettings.Flow)))
 
 	// Start monitoring with error handling for on_error hooks
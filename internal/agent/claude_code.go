@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"autoteam/internal/logger"
+	"autoteam/internal/secrets"
 	"autoteam/internal/worker"
 
 	"go.uber.org/zap"
@@ -70,6 +71,7 @@ func (c *ClaudeCode) Run(ctx context.Context, prompt string, options RunOptions)
 
 	// Execute Claude
 	cmd := exec.CommandContext(ctx, c.binaryPath, args...)
+	prepareCommand(cmd)
 	cmd.Dir = options.WorkingDirectory
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
@@ -77,6 +79,7 @@ func (c *ClaudeCode) Run(ctx context.Context, prompt string, options RunOptions)
 
 	// Set environment variables
 	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, secrets.Environ()...) // Resolves the secret references of the MCP config
 
 	// Add Claude-specific environment
 	cmd.Env = append(cmd.Env, "IS_SANDBOX=1")
@@ -216,7 +219,8 @@ func (c *ClaudeCode) createMCPConfigFile(ctx context.Context) error {
 		}
 
 		if len(server.Env) > 0 {
-			serverConfig["env"] = server.Env
+			// Secret values are referenced from the agent environment, not written to the file
+			serverConfig["env"] = secrets.EnvReferences(server.Env)
 		}
 
 		mcpServersMap[name] = serverConfig
@@ -12,8 +12,22 @@ const (
 	AgentTypeClaudeCode = "claude"
 	AgentTypeQwenCode   = "qwen"
 	AgentTypeGeminiCli  = "gemini"
+	AgentTypeShell      = "shell"
+	AgentTypeHTTP       = worker.StepTypeHTTP
+	AgentTypeMCPCall    = worker.StepTypeMCPCall
 )
 
+// SupportedTypes lists the agent types CreateAgent accepts
+var SupportedTypes = []string{
+	AgentTypeClaudeCode,
+	AgentTypeGeminiCli,
+	AgentTypeQwenCode,
+	AgentTypeDebug,
+	AgentTypeShell,
+	AgentTypeHTTP,
+	AgentTypeMCPCall,
+}
+
 // CreateAgent creates an agent based on configuration
 func CreateAgent(agentConfig AgentConfig, name string, mcpServers map[string]worker.MCPServer) (Agent, error) {
 	switch agentConfig.Type {
@@ -29,6 +43,15 @@ func CreateAgent(agentConfig AgentConfig, name string, mcpServers map[string]wor
 	case AgentTypeGeminiCli:
 		agent := NewGeminiCli(name, agentConfig.Args, agentConfig.Env, mcpServers)
 		return agent, nil
+	case AgentTypeShell:
+		agent := NewShellAgent(name, agentConfig.Args, agentConfig.Env)
+		return agent, nil
+	case AgentTypeHTTP:
+		agent := NewHTTPAgent(name, agentConfig.HTTP)
+		return agent, nil
+	case AgentTypeMCPCall:
+		agent := NewMCPCallAgent(name, agentConfig.MCP, mcpServers)
+		return agent, nil
 	default:
 		return nil, fmt.Errorf("unsupported agent type: %s", agentConfig.Type)
 	}
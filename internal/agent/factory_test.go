@@ -67,6 +67,37 @@ func TestCreateAgent(t *testing.T) {
 			expectError: false,
 			expectType:  AgentTypeGeminiCli,
 		},
+		{
+			name: "create shell agent",
+			config: AgentConfig{
+				Type: AgentTypeShell,
+				Args: []string{"go", "test", "./..."},
+				Env:  map[string]string{"GOFLAGS": "-count=1"},
+			},
+			agentName:   "test-shell",
+			expectError: false,
+			expectType:  AgentTypeShell,
+		},
+		{
+			name: "create http agent",
+			config: AgentConfig{
+				Type: AgentTypeHTTP,
+				HTTP: &worker.HTTPRequest{URL: "https://example.com/hook"},
+			},
+			agentName:   "test-http",
+			expectError: false,
+			expectType:  AgentTypeHTTP,
+		},
+		{
+			name: "create mcp_call agent",
+			config: AgentConfig{
+				Type: AgentTypeMCPCall,
+				MCP:  &worker.MCPCall{Server: "test-server", Tool: "mark_read"},
+			},
+			agentName:   "test-mcp-call",
+			expectError: false,
+			expectType:  AgentTypeMCPCall,
+		},
 		{
 			name: "unknown agent type",
 			config: AgentConfig{
@@ -142,6 +173,9 @@ func TestAgentConstants(t *testing.T) {
 		"AgentTypeClaudeCode": AgentTypeClaudeCode,
 		"AgentTypeQwenCode":   AgentTypeQwenCode,
 		"AgentTypeGeminiCli":  AgentTypeGeminiCli,
+		"AgentTypeShell":      AgentTypeShell,
+		"AgentTypeHTTP":       AgentTypeHTTP,
+		"AgentTypeMCPCall":    AgentTypeMCPCall,
 	}
 
 	actualConstants := map[string]string{
@@ -149,6 +183,9 @@ func TestAgentConstants(t *testing.T) {
 		"AgentTypeClaudeCode": "claude",
 		"AgentTypeQwenCode":   "qwen",
 		"AgentTypeGeminiCli":  "gemini",
+		"AgentTypeShell":      "shell",
+		"AgentTypeHTTP":       "http",
+		"AgentTypeMCPCall":    "mcp_call",
 	}
 
 	for name, expected := range actualConstants {
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"autoteam/internal/logger"
+	"autoteam/internal/secrets"
 	"autoteam/internal/worker"
 	"bytes"
 	"context"
@@ -66,6 +67,7 @@ func (q *GeminiCli) Run(ctx context.Context, prompt string, options RunOptions)
 
 	// Execute Gemini
 	cmd := exec.CommandContext(ctx, q.binaryPath, args...)
+	prepareCommand(cmd)
 
 	// Set working directory
 	if options.WorkingDirectory != "" {
@@ -87,6 +89,7 @@ func (q *GeminiCli) Run(ctx context.Context, prompt string, options RunOptions)
 
 	// Set environment variables
 	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, secrets.Environ()...) // Resolves the secret references of the MCP config
 	// Add custom environment variables from agent config
 	for k, v := range q.agentEnv {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
@@ -216,7 +219,8 @@ func (q *GeminiCli) createMCPConfigFile(ctx context.Context) error {
 		}
 
 		if len(server.Env) > 0 {
-			serverConfig["env"] = server.Env
+			// Secret values are referenced from the agent environment, not written to the file
+			serverConfig["env"] = secrets.EnvReferences(server.Env)
 		}
 
 		mcpServersMap[name] = serverConfig
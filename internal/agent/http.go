@@ -0,0 +1,142 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"autoteam/internal/logger"
+	"autoteam/internal/worker"
+
+	"go.uber.org/zap"
+)
+
+// maxHTTPResponseBody limits how much of a response body is read
+const maxHTTPResponseBody = 10 << 20
+
+// HTTPResponse is the response of an http step
+type HTTPResponse struct {
+	Status  int
+	Headers map[string]string
+	Body    string
+}
+
+// HTTPAgent performs an HTTP request instead of running an LLM. The response body is
+// returned as stdout; a status outside the success criteria fails the run.
+type HTTPAgent struct {
+	name    string
+	request *worker.HTTPRequest
+	client  *http.Client
+}
+
+// NewHTTPAgent creates a new HTTP agent instance
+func NewHTTPAgent(name string, request *worker.HTTPRequest) Agent {
+	return &HTTPAgent{
+		name:    name,
+		request: request,
+		client:  &http.Client{},
+	}
+}
+
+// Name returns the agent name
+func (h *HTTPAgent) Name() string {
+	return h.name
+}
+
+// Type returns the agent type
+func (h *HTTPAgent) Type() string {
+	return AgentTypeHTTP
+}
+
+// Run performs the request. The rendered request of the step takes precedence over the
+// configured one; the prompt is not used.
+func (h *HTTPAgent) Run(ctx context.Context, prompt string, options RunOptions) (*AgentOutput, error) {
+	lgr := logger.FromContext(ctx)
+
+	request := h.request
+	if options.HTTP != nil {
+		request = options.HTTP
+	}
+	if request == nil || request.URL == "" {
+		return nil, fmt.Errorf("http step requires http.url")
+	}
+
+	method := strings.ToUpper(request.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader
+	if request.Body != "" {
+		body = strings.NewReader(request.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, request.URL, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, value := range request.Headers {
+		req.Header.Set(key, value)
+	}
+
+	lgr.Debug("Performing HTTP request",
+		zap.String("agent", h.name),
+		zap.String("method", method),
+		zap.String("url", request.URL))
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPResponseBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	headers := make(map[string]string, len(resp.Header))
+	for key := range resp.Header {
+		headers[key] = strings.Join(resp.Header.Values(key), ", ")
+	}
+
+	response := &HTTPResponse{
+		Status:  resp.StatusCode,
+		Headers: headers,
+		Body:    string(data),
+	}
+	output := &AgentOutput{
+		Stdout:   response.Body,
+		Response: response,
+	}
+
+	if !isSuccessStatus(request.SuccessStatus, resp.StatusCode) {
+		return output, fmt.Errorf("unexpected status %d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	return output, nil
+}
+
+// isSuccessStatus reports whether a status meets the success criteria (default: any 2xx)
+func isSuccessStatus(successStatus []int, status int) bool {
+	if len(successStatus) == 0 {
+		return status >= 200 && status < 300
+	}
+	return slices.Contains(successStatus, status)
+}
+
+// IsAvailable checks if the HTTP agent is available (always true)
+func (h *HTTPAgent) IsAvailable(ctx context.Context) bool {
+	return true
+}
+
+// CheckAvailability checks if the HTTP agent is available (always true)
+func (h *HTTPAgent) CheckAvailability(ctx context.Context) error {
+	return nil
+}
+
+// Version returns the HTTP agent version
+func (h *HTTPAgent) Version(ctx context.Context) (string, error) {
+	return "http-1.0.0", nil
+}
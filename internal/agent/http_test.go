@@ -0,0 +1,89 @@
+package agent
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"autoteam/internal/worker"
+)
+
+func TestHTTPAgent_Run(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/created":
+			w.WriteHeader(http.StatusCreated)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	t.Run("request and response", func(t *testing.T) {
+		agent := NewHTTPAgent("test", &worker.HTTPRequest{
+			Method:  "post",
+			URL:     server.URL + "/created",
+			Headers: map[string]string{"Authorization": "Bearer token"},
+			Body:    `{"text": "hello"}`,
+		})
+
+		output, err := agent.Run(ctx, "", RunOptions{})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if output.Stdout != `{"text": "hello"}` || output.Response == nil {
+			t.Fatalf("Unexpected output: %+v", output)
+		}
+		if output.Response.Status != http.StatusCreated {
+			t.Errorf("Expected status 201, got %d", output.Response.Status)
+		}
+		if output.Response.Headers["X-Method"] != "POST" || output.Response.Headers["X-Token"] != "Bearer token" {
+			t.Errorf("Unexpected response headers: %v", output.Response.Headers)
+		}
+	})
+
+	t.Run("rendered request replaces configured request", func(t *testing.T) {
+		agent := NewHTTPAgent("test", &worker.HTTPRequest{URL: server.URL + "/missing"})
+
+		output, err := agent.Run(ctx, "", RunOptions{HTTP: &worker.HTTPRequest{URL: server.URL + "/ok", Body: "rendered"}})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if output.Stdout != "rendered" || output.Response.Headers["X-Method"] != "GET" {
+			t.Errorf("Unexpected output: %+v", output)
+		}
+	})
+
+	t.Run("status outside success criteria", func(t *testing.T) {
+		agent := NewHTTPAgent("test", &worker.HTTPRequest{URL: server.URL + "/missing"})
+
+		output, err := agent.Run(ctx, "", RunOptions{})
+		if err == nil || !strings.Contains(err.Error(), "unexpected status 404") {
+			t.Errorf("Expected unexpected status error, got %v", err)
+		}
+		if output == nil || output.Response.Status != http.StatusNotFound {
+			t.Errorf("Expected the response with the error, got %+v", output)
+		}
+	})
+
+	t.Run("custom success status", func(t *testing.T) {
+		agent := NewHTTPAgent("test", &worker.HTTPRequest{URL: server.URL + "/missing", SuccessStatus: []int{404}})
+		if _, err := agent.Run(ctx, "", RunOptions{}); err != nil {
+			t.Errorf("Expected 404 to count as success, got %v", err)
+		}
+
+		agent = NewHTTPAgent("test", &worker.HTTPRequest{URL: server.URL + "/created", SuccessStatus: []int{200}})
+		if _, err := agent.Run(ctx, "", RunOptions{}); err == nil {
+			t.Error("Expected 201 to fail when only 200 counts as success")
+		}
+	})
+}
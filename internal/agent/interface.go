@@ -2,12 +2,21 @@ package agent
 
 import (
 	"context"
+	"time"
+
+	"autoteam/internal/worker"
 )
 
 // AgentOutput contains the output from an agent execution
 type AgentOutput struct {
 	Stdout string
 	Stderr string
+
+	// ExitCode is the exit code of agents that run a command (0 for LLM agents)
+	ExitCode int
+
+	// Response is the response of an http agent (nil for other agents)
+	Response *HTTPResponse
 }
 
 // Agent represents an AI agent that can process prompts and generate responses
@@ -46,6 +55,9 @@ type AgentConfig struct {
 	Args   []string          `yaml:"args,omitempty"`
 	Env    map[string]string `yaml:"env,omitempty"`
 	Prompt *string           `yaml:"prompt,omitempty"`
+
+	HTTP *worker.HTTPRequest `yaml:"http,omitempty"` // Request of an http agent
+	MCP  *worker.MCPCall     `yaml:"mcp,omitempty"`  // Tool call of an mcp_call agent
 }
 
 // RunOptions contains options for running an agent
@@ -58,4 +70,21 @@ type RunOptions struct {
 
 	// WorkingDirectory is the directory to run the agent in
 	WorkingDirectory string
+
+	// Timeout limits a single run (0 = no limit). The caller enforces it through
+	// the deadline of ctx; agents may use it to size their own timeouts.
+	Timeout time.Duration
+
+	// Args and Env replace the configured args and env of a shell agent for this run,
+	// after their templates were rendered with the step data
+	Args []string
+	Env  map[string]string
+
+	// HTTP replaces the configured request of an http agent for this run, after its
+	// templates were rendered with the step data
+	HTTP *worker.HTTPRequest
+
+	// MCPCall replaces the configured tool call of an mcp_call agent for this run, after
+	// its arguments template was rendered with the step data
+	MCPCall *worker.MCPCall
 }
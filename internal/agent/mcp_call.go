@@ -0,0 +1,293 @@
+package agent
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"autoteam/internal/logger"
+	"autoteam/internal/worker"
+
+	"go.uber.org/zap"
+)
+
+// mcpProtocolVersion is the MCP protocol version requested during initialize
+const mcpProtocolVersion = "2025-06-18"
+
+// maxMCPMessageSize limits the size of a single JSON-RPC message from an MCP server
+const maxMCPMessageSize = 10 << 20
+
+// MCPCallAgent calls a tool of a stdio MCP server directly instead of through an LLM.
+// Every run starts the server, performs initialize and calls the tool once.
+type MCPCallAgent struct {
+	name    string
+	call    *worker.MCPCall
+	servers map[string]worker.MCPServer
+}
+
+// NewMCPCallAgent creates a new MCP call agent instance
+func NewMCPCallAgent(name string, call *worker.MCPCall, mcpServers map[string]worker.MCPServer) Agent {
+	return &MCPCallAgent{
+		name:    name,
+		call:    call,
+		servers: mcpServers,
+	}
+}
+
+// Name returns the agent name
+func (m *MCPCallAgent) Name() string {
+	return m.name
+}
+
+// Type returns the agent type
+func (m *MCPCallAgent) Type() string {
+	return AgentTypeMCPCall
+}
+
+// jsonRPCMessage is a JSON-RPC 2.0 request, notification or response
+type jsonRPCMessage struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *int            `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  interface{}     `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+// jsonRPCError is the error of a JSON-RPC response
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// toolCallResult is the result of an MCP tools/call request
+type toolCallResult struct {
+	Content []json.RawMessage `json:"content"`
+	IsError bool              `json:"isError"`
+}
+
+// Run starts the MCP server and calls the tool. The text content of the tool result is
+// returned as stdout; non-text content items are returned as JSON.
+func (m *MCPCallAgent) Run(ctx context.Context, prompt string, options RunOptions) (*AgentOutput, error) {
+	lgr := logger.FromContext(ctx)
+
+	call := m.call
+	if options.MCPCall != nil {
+		call = options.MCPCall
+	}
+	if call == nil || call.Server == "" || call.Tool == "" {
+		return nil, fmt.Errorf("mcp_call step requires mcp.server and mcp.tool")
+	}
+	server, ok := m.servers[call.Server]
+	if !ok {
+		return nil, fmt.Errorf("mcp server %s is not configured", call.Server)
+	}
+
+	arguments := map[string]interface{}{}
+	if strings.TrimSpace(call.Arguments) != "" {
+		if err := json.Unmarshal([]byte(call.Arguments), &arguments); err != nil {
+			return nil, fmt.Errorf("mcp.arguments must be a JSON object: %w", err)
+		}
+	}
+
+	// The server is stopped once the call is done
+	serverCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(serverCtx, server.Command, server.Args...)
+	prepareCommand(cmd)
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+	for k, v := range server.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open server stdin: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open server stdout: %w", err)
+	}
+
+	lgr.Debug("Starting MCP server for tool call",
+		zap.String("agent", m.name),
+		zap.String("server", call.Server),
+		zap.String("tool", call.Tool))
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start mcp server %s: %w", call.Server, err)
+	}
+	defer func() {
+		_ = stdin.Close()
+		cancel()
+		_ = cmd.Wait()
+	}()
+
+	session := &mcpSession{encoder: json.NewEncoder(stdin), reader: bufio.NewReaderSize(stdout, 64*1024)}
+
+	result, err := session.callTool(call.Tool, arguments)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return &AgentOutput{Stderr: stderr.String()}, fmt.Errorf("mcp server %s: %w", call.Server, err)
+	}
+
+	output := &AgentOutput{Stdout: result.text, Stderr: stderr.String()}
+	if result.isError {
+		return output, fmt.Errorf("tool %s returned an error: %s", call.Tool, strings.TrimSpace(result.text))
+	}
+	return output, nil
+}
+
+// mcpSession is a JSON-RPC session with an MCP server over stdio
+type mcpSession struct {
+	encoder *json.Encoder
+	reader  *bufio.Reader
+	nextID  int
+}
+
+// toolOutput is the converted result of a tool call
+type toolOutput struct {
+	text    string
+	isError bool
+}
+
+// callTool performs initialize and calls the tool
+func (s *mcpSession) callTool(tool string, arguments map[string]interface{}) (*toolOutput, error) {
+	if _, err := s.request("initialize", map[string]interface{}{
+		"protocolVersion": mcpProtocolVersion,
+		"capabilities":    map[string]interface{}{},
+		"clientInfo":      map[string]string{"name": "autoteam", "version": "1.0.0"},
+	}); err != nil {
+		return nil, fmt.Errorf("initialize failed: %w", err)
+	}
+	if err := s.encoder.Encode(jsonRPCMessage{JSONRPC: "2.0", Method: "notifications/initialized"}); err != nil {
+		return nil, fmt.Errorf("failed to send initialized notification: %w", err)
+	}
+
+	raw, err := s.request("tools/call", map[string]interface{}{
+		"name":      tool,
+		"arguments": arguments,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tools/call %s failed: %w", tool, err)
+	}
+
+	var result toolCallResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("invalid tools/call result: %w", err)
+	}
+
+	var parts []string
+	for _, item := range result.Content {
+		var content struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(item, &content); err == nil && content.Type == "text" {
+			parts = append(parts, content.Text)
+			continue
+		}
+		parts = append(parts, string(item))
+	}
+	return &toolOutput{text: strings.Join(parts, "\n"), isError: result.IsError}, nil
+}
+
+// request sends a request and waits for its response. Notifications from the server are
+// ignored and requests from the server are answered with an error.
+func (s *mcpSession) request(method string, params interface{}) (json.RawMessage, error) {
+	s.nextID++
+	id := s.nextID
+	if err := s.encoder.Encode(jsonRPCMessage{JSONRPC: "2.0", ID: &id, Method: method, Params: params}); err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		var message jsonRPCMessage
+		if err := json.Unmarshal(line, &message); err != nil {
+			continue // Not a JSON-RPC message, e.g. a log line
+		}
+		if message.Method != "" {
+			if message.ID != nil {
+				reply := jsonRPCMessage{JSONRPC: "2.0", ID: message.ID}
+				if message.Method == "ping" {
+					reply.Result = json.RawMessage("{}")
+				} else {
+					reply.Error = &jsonRPCError{Code: -32601, Message: "method not supported by client"}
+				}
+				if err := s.encoder.Encode(reply); err != nil {
+					return nil, fmt.Errorf("failed to answer server request: %w", err)
+				}
+			}
+			continue
+		}
+		if message.ID == nil || *message.ID != id {
+			continue
+		}
+		if message.Error != nil {
+			return nil, fmt.Errorf("error %d: %s", message.Error.Code, message.Error.Message)
+		}
+		return message.Result, nil
+	}
+}
+
+// readLine reads a newline-delimited message from the server
+func (s *mcpSession) readLine() ([]byte, error) {
+	var line []byte
+	for {
+		chunk, isPrefix, err := s.reader.ReadLine()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("server closed the connection")
+			}
+			return nil, fmt.Errorf("failed to read from server: %w", err)
+		}
+		line = append(line, chunk...)
+		if len(line) > maxMCPMessageSize {
+			return nil, fmt.Errorf("message from server exceeds %d bytes", maxMCPMessageSize)
+		}
+		if !isPrefix {
+			return line, nil
+		}
+	}
+}
+
+// IsAvailable checks if the MCP server command can be found
+func (m *MCPCallAgent) IsAvailable(ctx context.Context) bool {
+	return m.CheckAvailability(ctx) == nil
+}
+
+// CheckAvailability checks that the MCP server of the call is configured and its command exists
+func (m *MCPCallAgent) CheckAvailability(ctx context.Context) error {
+	if m.call == nil {
+		return fmt.Errorf("mcp_call step requires mcp.server and mcp.tool")
+	}
+	server, ok := m.servers[m.call.Server]
+	if !ok {
+		return fmt.Errorf("mcp server %s is not configured", m.call.Server)
+	}
+	if _, err := exec.LookPath(server.Command); err != nil {
+		return fmt.Errorf("mcp server command %s not found: %w", server.Command, err)
+	}
+	return nil
+}
+
+// Version returns the MCP call agent version
+func (m *MCPCallAgent) Version(ctx context.Context) (string, error) {
+	return "mcp-call-1.0.0", nil
+}
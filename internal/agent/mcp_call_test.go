@@ -0,0 +1,123 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"autoteam/internal/worker"
+)
+
+// TestMCPServerHelper is not a real test: it acts as a stdio MCP server when the test
+// binary is started by TestMCPCallAgent_Run
+func TestMCPServerHelper(t *testing.T) {
+	if os.Getenv("AUTOTEAM_MCP_HELPER") != "1" {
+		t.Skip("helper process")
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+	for scanner.Scan() {
+		var request struct {
+			ID     *int   `json:"id"`
+			Method string `json:"method"`
+			Params struct {
+				Name      string                 `json:"name"`
+				Arguments map[string]interface{} `json:"arguments"`
+			} `json:"params"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &request); err != nil || request.ID == nil {
+			continue
+		}
+
+		response := map[string]interface{}{"jsonrpc": "2.0", "id": *request.ID}
+		switch {
+		case request.Method == "initialize":
+			// Servers may log and send notifications before responding
+			fmt.Println("starting fake server")
+			_ = encoder.Encode(map[string]interface{}{"jsonrpc": "2.0", "method": "notifications/message"})
+			response["result"] = map[string]interface{}{"protocolVersion": mcpProtocolVersion, "capabilities": map[string]interface{}{}}
+		case request.Params.Name == "echo":
+			arguments, _ := json.Marshal(request.Params.Arguments)
+			response["result"] = map[string]interface{}{
+				"content": []map[string]interface{}{
+					{"type": "text", "text": "echo " + string(arguments)},
+					{"type": "image", "data": "aGk=", "mimeType": "image/png"},
+				},
+			}
+		case request.Params.Name == "fail":
+			response["result"] = map[string]interface{}{
+				"content": []map[string]interface{}{{"type": "text", "text": "notification not found"}},
+				"isError": true,
+			}
+		default:
+			response["error"] = map[string]interface{}{"code": -32602, "message": "unknown tool"}
+		}
+		_ = encoder.Encode(response)
+	}
+	os.Exit(0)
+}
+
+func TestMCPCallAgent_Run(t *testing.T) {
+	servers := map[string]worker.MCPServer{
+		"fake": {
+			Command: os.Args[0],
+			Args:    []string{"-test.run=TestMCPServerHelper"},
+			Env:     map[string]string{"AUTOTEAM_MCP_HELPER": "1"},
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("tool result", func(t *testing.T) {
+		agent := NewMCPCallAgent("test", &worker.MCPCall{Server: "fake", Tool: "missing"}, servers)
+
+		output, err := agent.Run(ctx, "", RunOptions{MCPCall: &worker.MCPCall{Server: "fake", Tool: "echo", Arguments: `{"id": 42}`}})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		lines := strings.Split(output.Stdout, "\n")
+		if len(lines) != 2 || lines[0] != `echo {"id":42}` || !strings.Contains(lines[1], `"mimeType":"image/png"`) {
+			t.Errorf("Unexpected stdout: %q", output.Stdout)
+		}
+	})
+
+	t.Run("tool error", func(t *testing.T) {
+		agent := NewMCPCallAgent("test", &worker.MCPCall{Server: "fake", Tool: "fail"}, servers)
+
+		output, err := agent.Run(ctx, "", RunOptions{})
+		if err == nil || err.Error() != "tool fail returned an error: notification not found" {
+			t.Errorf("Expected tool error, got %v", err)
+		}
+		if output == nil || output.Stdout != "notification not found" {
+			t.Errorf("Expected the tool result with the error, got %+v", output)
+		}
+	})
+
+	t.Run("protocol error", func(t *testing.T) {
+		agent := NewMCPCallAgent("test", &worker.MCPCall{Server: "fake", Tool: "missing"}, servers)
+
+		if _, err := agent.Run(ctx, "", RunOptions{}); err == nil || !strings.Contains(err.Error(), "unknown tool") {
+			t.Errorf("Expected JSON-RPC error, got %v", err)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		agent := NewMCPCallAgent("test", &worker.MCPCall{Server: "fake", Tool: "echo", Arguments: "[1, 2]"}, servers)
+
+		if _, err := agent.Run(ctx, "", RunOptions{}); err == nil || !strings.Contains(err.Error(), "must be a JSON object") {
+			t.Errorf("Expected arguments error, got %v", err)
+		}
+	})
+
+	t.Run("unknown server", func(t *testing.T) {
+		agent := NewMCPCallAgent("test", &worker.MCPCall{Server: "github", Tool: "echo"}, servers)
+
+		if err := agent.CheckAvailability(ctx); err == nil {
+			t.Error("Expected availability error for unknown server")
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package agent
+
+import (
+	"os/exec"
+	"time"
+)
+
+// processWaitDelay bounds how long Run waits for output pipes after the agent
+// process was killed, in case an orphaned descendant still holds them open
+const processWaitDelay = 5 * time.Second
+
+// prepareCommand makes a canceled context (e.g. a step timeout) terminate the
+// agent together with every process it started, not just the direct child
+func prepareCommand(cmd *exec.Cmd) {
+	setProcessGroup(cmd)
+	cmd.WaitDelay = processWaitDelay
+}
@@ -0,0 +1,27 @@
+//go:build unix
+
+package agent
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestPrepareCommand_KillsProcessGroup(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// The grandchild keeps stdout open, so Output only returns once the whole group is gone
+	cmd := exec.CommandContext(ctx, "sh", "-c", "sleep 30 & wait")
+	prepareCommand(cmd)
+
+	start := time.Now()
+	if _, err := cmd.Output(); err == nil {
+		t.Fatal("expected an error from the canceled command")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("command took %v to stop, want the process group killed on cancel", elapsed)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"autoteam/internal/logger"
+	"autoteam/internal/secrets"
 	"autoteam/internal/worker"
 
 	"go.uber.org/zap"
@@ -63,6 +64,7 @@ func (q *QwenCode) Run(ctx context.Context, prompt string, options RunOptions) (
 
 	// Execute Qwen
 	cmd := exec.CommandContext(ctx, q.binaryPath, args...)
+	prepareCommand(cmd)
 
 	// Set working directory
 	if options.WorkingDirectory != "" {
@@ -78,6 +80,7 @@ func (q *QwenCode) Run(ctx context.Context, prompt string, options RunOptions) (
 
 	// Set environment variables
 	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, secrets.Environ()...) // Resolves the secret references of the MCP config
 	// Add custom environment variables from agent config
 	for k, v := range q.agentEnv {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
@@ -212,7 +215,8 @@ func (q *QwenCode) createMCPConfigFile(ctx context.Context) error {
 		}
 
 		if len(server.Env) > 0 {
-			serverConfig["env"] = server.Env
+			// Secret values are referenced from the agent environment, not written to the file
+			serverConfig["env"] = secrets.EnvReferences(server.Env)
 		}
 
 		mcpServersMap[name] = serverConfig
@@ -0,0 +1,145 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"autoteam/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// maxExitErrorOutput limits how much stderr is included in the error of a failed command
+const maxExitErrorOutput = 500
+
+// ShellAgent runs a command instead of an LLM. With args, args[0] is executed directly and
+// the prompt is passed on stdin; without args, the prompt is run as a script by sh -c, so
+// upstream data rendered into it must be quoted with the shellquote template function.
+type ShellAgent struct {
+	name string
+	args []string
+	env  map[string]string
+}
+
+// NewShellAgent creates a new shell agent instance
+func NewShellAgent(name string, args []string, env map[string]string) Agent {
+	return &ShellAgent{
+		name: name,
+		args: args,
+		env:  env,
+	}
+}
+
+// Name returns the agent name
+func (s *ShellAgent) Name() string {
+	return s.name
+}
+
+// Type returns the agent type
+func (s *ShellAgent) Type() string {
+	return AgentTypeShell
+}
+
+// Run executes the command. A non-zero exit code is returned as an error together with
+// the output, so that the step fails and can be retried.
+func (s *ShellAgent) Run(ctx context.Context, prompt string, options RunOptions) (*AgentOutput, error) {
+	lgr := logger.FromContext(ctx)
+
+	// Rendered args and env of the step take precedence over the configured ones
+	args := s.args
+	if options.Args != nil {
+		args = options.Args
+	}
+	env := s.env
+	if options.Env != nil {
+		env = options.Env
+	}
+
+	var cmd *exec.Cmd
+	if len(args) > 0 {
+		cmd = exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Stdin = strings.NewReader(prompt)
+	} else {
+		if strings.TrimSpace(prompt) == "" {
+			return nil, fmt.Errorf("shell step requires args or an input script")
+		}
+		cmd = exec.CommandContext(ctx, "sh", "-c", prompt)
+	}
+	prepareCommand(cmd)
+
+	if options.WorkingDirectory != "" {
+		if err := os.MkdirAll(options.WorkingDirectory, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create working directory: %w", err)
+		}
+		cmd.Dir = options.WorkingDirectory
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	cmd.Env = os.Environ()
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	lgr.Debug("Executing shell command",
+		zap.String("agent", s.name),
+		zap.Strings("args", args),
+		zap.String("working_dir", options.WorkingDirectory))
+
+	err := cmd.Run()
+	output := &AgentOutput{
+		Stdout:   stdout.String(),
+		Stderr:   stderr.String(),
+		ExitCode: cmd.ProcessState.ExitCode(),
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && ctx.Err() == nil {
+		return output, fmt.Errorf("command exited with code %d%s", output.ExitCode, stderrSuffix(output.Stderr))
+	}
+	if err != nil {
+		return output, fmt.Errorf("command execution failed: %w", err)
+	}
+	return output, nil
+}
+
+// stderrSuffix formats the tail of the stderr of a failed command for its error message
+func stderrSuffix(stderr string) string {
+	stderr = strings.TrimSpace(stderr)
+	if stderr == "" {
+		return ""
+	}
+	if len(stderr) > maxExitErrorOutput {
+		stderr = "..." + stderr[len(stderr)-maxExitErrorOutput:]
+	}
+	return ": " + stderr
+}
+
+// IsAvailable checks if the shell agent is available
+func (s *ShellAgent) IsAvailable(ctx context.Context) bool {
+	return s.CheckAvailability(ctx) == nil
+}
+
+// CheckAvailability checks that the command (or sh for scripts) can be found
+func (s *ShellAgent) CheckAvailability(ctx context.Context) error {
+	binary := "sh"
+	if len(s.args) > 0 && !strings.Contains(s.args[0], "{{") {
+		binary = s.args[0]
+	}
+	if _, err := exec.LookPath(binary); err != nil {
+		return fmt.Errorf("command %s not found: %w", binary, err)
+	}
+	return nil
+}
+
+// Version returns the shell agent version
+func (s *ShellAgent) Version(ctx context.Context) (string, error) {
+	return "shell-1.0.0", nil
+}
@@ -0,0 +1,72 @@
+//go:build unix
+
+package agent
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"autoteam/internal/worker"
+)
+
+func TestShellAgent_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("script from prompt", func(t *testing.T) {
+		agent := NewShellAgent("test", nil, map[string]string{"GREETING": "hello"})
+
+		output, err := agent.Run(ctx, `echo "$GREETING world"; echo warn >&2`, RunOptions{WorkingDirectory: t.TempDir()})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if output.Stdout != "hello world\n" || output.Stderr != "warn\n" || output.ExitCode != 0 {
+			t.Errorf("Unexpected output: %+v", output)
+		}
+	})
+
+	t.Run("script with quoted upstream data", func(t *testing.T) {
+		agent := NewShellAgent("test", nil, nil)
+		upstream := `$(echo injected); echo 'single' "double" ` + "`id`\n"
+
+		output, err := agent.Run(ctx, "printf '%s' "+worker.ShellQuote(upstream), RunOptions{})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if output.Stdout != upstream {
+			t.Errorf("Expected the data to reach the command unchanged, got stdout %q", output.Stdout)
+		}
+	})
+
+	t.Run("args with prompt on stdin", func(t *testing.T) {
+		agent := NewShellAgent("test", []string{"cat"}, nil)
+
+		output, err := agent.Run(ctx, "from stdin", RunOptions{Args: []string{"tr", "a-z", "A-Z"}})
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		if output.Stdout != "FROM STDIN" {
+			t.Errorf("Expected rendered args to replace configured args, got stdout %q", output.Stdout)
+		}
+	})
+
+	t.Run("non-zero exit code", func(t *testing.T) {
+		agent := NewShellAgent("test", nil, nil)
+
+		output, err := agent.Run(ctx, "echo partial; echo broken >&2; exit 3", RunOptions{})
+		if err == nil || !strings.Contains(err.Error(), "command exited with code 3: broken") {
+			t.Errorf("Expected exit code error, got %v", err)
+		}
+		if output == nil || output.ExitCode != 3 || output.Stdout != "partial\n" {
+			t.Errorf("Expected output with exit code 3, got %+v", output)
+		}
+	})
+
+	t.Run("missing script", func(t *testing.T) {
+		agent := NewShellAgent("test", nil, nil)
+
+		if _, err := agent.Run(ctx, "  ", RunOptions{}); err == nil {
+			t.Error("Expected error for empty script")
+		}
+	})
+}
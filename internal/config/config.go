@@ -1,9 +1,13 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 
+	"autoteam/internal/secrets"
 	"autoteam/internal/util"
 	"autoteam/internal/worker"
 
@@ -22,14 +26,19 @@ type Config struct {
 	MCPServers   map[string]worker.MCPServer       `yaml:"mcp_servers,omitempty"`
 	ControlPlane *ControlPlaneConfig               `yaml:"control_plane,omitempty"`
 	Dashboard    *DashboardConfig                  `yaml:"dashboard,omitempty"`
+	Secrets      map[string]secrets.Secret         `yaml:"secrets,omitempty"`
+
+	resolvedSecrets  map[string]string   // Values of the secrets referenced in the config by name
+	secretReferences []secrets.Reference // Values resolved from secret references, by path in the config
 }
 
 // ControlPlaneConfig represents the control plane configuration
 type ControlPlaneConfig struct {
-	Enabled     bool     `yaml:"enabled"`
-	Port        int      `yaml:"port"`
-	APIKey      string   `yaml:"api_key,omitempty"`
-	WorkersAPIs []string `yaml:"workers_apis,omitempty"` // Direct worker API URLs
+	Enabled       bool     `yaml:"enabled"`
+	Port          int      `yaml:"port"`
+	APIKey        string   `yaml:"api_key,omitempty"`
+	WebhookSecret string   `yaml:"webhook_secret,omitempty"` // HMAC secret verifying X-Hub-Signature-256 on /webhooks
+	WorkersAPIs   []string `yaml:"workers_apis,omitempty"`   // Direct worker API URLs
 }
 
 // DashboardConfig represents the dashboard configuration
@@ -46,10 +55,27 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
 
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	// Resolve ${VAR}, ${file:...} and ${secret:...} references before decoding
+	interpolator := newInterpolator(filename)
+	if errs := interpolator.interpolateConfig(&root); len(errs) > 0 {
+		joined := make([]error, len(errs))
+		for i, err := range errs {
+			joined[i] = err
+		}
+		return nil, fmt.Errorf("failed to interpolate config: %w", errors.Join(joined...))
+	}
+
 	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
+	if err := root.Decode(&config); err != nil {
 		return nil, fmt.Errorf("failed to parse YAML: %w", err)
 	}
+	config.resolvedSecrets = interpolator.resolved
+	config.secretReferences = interpolator.references
 
 	// Validate required fields
 	if err := validateConfig(&config); err != nil {
@@ -62,83 +88,11 @@ func LoadConfig(filename string) (*Config, error) {
 	return &config, nil
 }
 
+// validateConfig returns the first problem found by the static analysis of the config
 func validateConfig(config *Config) error {
-	if len(config.Workers) == 0 {
-		return fmt.Errorf("at least one worker must be configured")
-	}
-
-	// Count enabled workers
-	enabledCount := 0
-	for _, worker := range config.Workers {
-		if worker.IsEnabled() {
-			enabledCount++
-		}
-	}
-
-	if enabledCount == 0 {
-		return fmt.Errorf("at least one worker must be enabled")
+	if errs := analyzeConfig(config); len(errs) > 0 {
+		return errs[0].Err
 	}
-
-	for i, worker := range config.Workers {
-		if worker.Name == "" {
-			return fmt.Errorf("worker[%d].name is required", i)
-		}
-		// Only validate required fields for enabled workers
-		if worker.IsEnabled() {
-			if worker.Prompt == "" {
-				return fmt.Errorf("worker[%d].prompt is required for enabled workers", i)
-			}
-
-			// Get effective settings to check flow configuration
-			settings := worker.GetEffectiveSettings(config.Settings)
-			if len(settings.Flow) == 0 {
-				return fmt.Errorf("worker[%d].flow is required for enabled workers", i)
-			}
-
-			// Validate flow steps
-			if err := validateFlow(settings.Flow); err != nil {
-				return fmt.Errorf("worker[%d].flow validation failed: %w", i, err)
-			}
-		}
-	}
-
-	return nil
-}
-
-// validateFlow validates flow configuration
-func validateFlow(flow []worker.FlowStep) error {
-	if len(flow) == 0 {
-		return fmt.Errorf("flow must contain at least one step")
-	}
-
-	stepNames := make(map[string]bool)
-	for i, step := range flow {
-		if step.Name == "" {
-			return fmt.Errorf("step[%d].name is required", i)
-		}
-		if step.Type == "" {
-			return fmt.Errorf("step[%d].type is required", i)
-		}
-		if stepNames[step.Name] {
-			return fmt.Errorf("duplicate step name: %s", step.Name)
-		}
-		stepNames[step.Name] = true
-
-		// Validate dependencies exist
-		for _, dep := range step.DependsOn {
-			found := false
-			for _, otherStep := range flow {
-				if otherStep.Name == dep {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return fmt.Errorf("step %s depends on non-existent step: %s", step.Name, dep)
-			}
-		}
-	}
-
 	return nil
 }
 
@@ -245,11 +199,12 @@ func CreateSampleConfig(filename string) error {
 			},
 		},
 		Settings: worker.WorkerSettings{
-			SleepDuration: util.IntPtr(60),
-			TeamName:      util.StringPtr(DefaultTeamName),
-			InstallDeps:   util.BoolPtr(true),
-			CommonPrompt:  util.StringPtr("Always follow coding best practices and write comprehensive tests."),
-			MaxAttempts:   util.IntPtr(3),
+			SleepDuration:   util.IntPtr(60),
+			TeamName:        util.StringPtr(DefaultTeamName),
+			InstallDeps:     util.BoolPtr(true),
+			CommonPrompt:    util.StringPtr("Always follow coding best practices and write comprehensive tests."),
+			MaxAttempts:     util.IntPtr(3),
+			StrictTemplates: util.BoolPtr(true),
 			Service: map[string]interface{}{
 				"image": "node:18.17.1",
 				"user":  "developer",
@@ -328,11 +283,50 @@ func (c *Config) GetTeamName() string {
 	return DefaultTeamName
 }
 
+// GetResolvedSecrets returns the values of the secrets referenced in the config by name
+func (c *Config) GetResolvedSecrets() map[string]string {
+	return c.resolvedSecrets
+}
+
+// GetWorkerSecretReferences returns the values resolved from secret references that end
+// up in the generated config of the worker at index, with their paths in that config.
+// The common prompt is also part of the prompt of the worker config.
+func (c *Config) GetWorkerSecretReferences(index int) []secrets.Reference {
+	var references []secrets.Reference
+	for _, reference := range c.secretReferences {
+		path := reference.Path
+		switch {
+		case len(path) > 2 && path[0] == "workers" && path[1] == strconv.Itoa(index):
+			path = path[2:]
+		case len(path) > 1 && path[0] == "settings":
+		default:
+			continue
+		}
+		if path[0] != "prompt" && path[0] != "settings" {
+			continue
+		}
+		if slices.Equal(path, []string{"settings", "common_prompt"}) {
+			prompt := reference
+			prompt.Path = []string{"prompt"}
+			references = append(references, prompt)
+		}
+		reference.Path = path
+		references = append(references, reference)
+	}
+	return references
+}
+
 // GetWorkersDir returns the team-specific workers directory path
 func (c *Config) GetWorkersDir() string {
 	return fmt.Sprintf(WorkersBaseDir, c.GetTeamName())
 }
 
+// GetSecretsDir returns the team-specific directory of the env files holding the secrets
+// of workers
+func (c *Config) GetSecretsDir() string {
+	return fmt.Sprintf(SecretsBaseDir, c.GetTeamName())
+}
+
 // GetControlPlaneDir returns the team-specific control-plane directory path
 func (c *Config) GetControlPlaneDir() string {
 	return fmt.Sprintf(ControlPlaneBaseDir, c.GetTeamName())
@@ -342,3 +336,28 @@ func (c *Config) GetControlPlaneDir() string {
 func (c *Config) GetControlPlaneConfigPath() string {
 	return fmt.Sprintf("%s/config.yaml", c.GetControlPlaneDir())
 }
+
+// GetControlPlaneWorkerID returns the control-plane ID of an enabled worker. The control
+// plane numbers workers in the order their API URLs are generated (enabled workers only).
+func (c *Config) GetControlPlaneWorkerID(name string) (string, error) {
+	index := 0
+	for _, w := range c.Workers {
+		if !w.IsEnabled() {
+			continue
+		}
+		index++
+		if w.Name == name || w.GetNormalizedName() == name {
+			return fmt.Sprintf("worker-%d", index), nil
+		}
+	}
+	return "", fmt.Errorf("no enabled worker named %q", name)
+}
+
+// GetControlPlaneURL returns the local control-plane API URL
+func (c *Config) GetControlPlaneURL() string {
+	port := 9090
+	if c.ControlPlane != nil && c.ControlPlane.Port != 0 {
+		port = c.ControlPlane.Port
+	}
+	return fmt.Sprintf("http://localhost:%d", port)
+}
@@ -11,6 +11,9 @@ import (
 )
 
 func TestLoadConfig_Valid(t *testing.T) {
+	t.Setenv("DEV1_GITHUB_TOKEN", "dev1-token")
+	t.Setenv("ARCH1_GITHUB_TOKEN", "arch1-token")
+
 	tests := []struct {
 		name     string
 		filename string
@@ -188,6 +191,11 @@ func TestCreateSampleConfig(t *testing.T) {
 	if cfg.Workers[2].IsEnabled() {
 		t.Errorf("Sample config Agents[2] should be disabled")
 	}
+
+	// New configs fail steps on template errors
+	if !cfg.Settings.GetStrictTemplates() {
+		t.Errorf("Sample config Settings.StrictTemplates should be true")
+	}
 }
 
 func TestValidateConfig(t *testing.T) {
@@ -235,6 +243,80 @@ func TestValidateConfig(t *testing.T) {
 			},
 			wantErr: "worker[0].prompt is required for enabled workers",
 		},
+		{
+			name: "invalid schedule",
+			config: Config{
+				Workers: []worker.Worker{
+					{Name: "dev1", Prompt: "prompt"},
+				},
+				Settings: worker.WorkerSettings{
+					Schedule: &worker.ScheduleConfig{Cron: "0 25 * * *"},
+					Flow: []worker.FlowStep{
+						{Name: "step1", Type: "claude", Input: "test"},
+					},
+				},
+			},
+			wantErr: "worker[0].settings.schedule validation failed: invalid schedule cron: value 25 out of range [0-23] in hour field",
+		},
+		{
+			name: "invalid flow timeout",
+			config: Config{
+				Workers: []worker.Worker{
+					{Name: "dev1", Prompt: "prompt"},
+				},
+				Settings: worker.WorkerSettings{
+					FlowTimeout: util.StringPtr("-5m"),
+					Flow: []worker.FlowStep{
+						{Name: "step1", Type: "claude", Input: "test"},
+					},
+				},
+			},
+			wantErr: "worker[0].settings validation failed: flow_timeout must be positive",
+		},
+		{
+			name: "negative max parallel",
+			config: Config{
+				Workers: []worker.Worker{
+					{Name: "dev1", Prompt: "prompt"},
+				},
+				Settings: worker.WorkerSettings{
+					MaxParallel: util.IntPtr(-1),
+					Flow: []worker.FlowStep{
+						{Name: "step1", Type: "claude", Input: "test"},
+					},
+				},
+			},
+			wantErr: "worker[0].settings validation failed: max_parallel must not be negative",
+		},
+		{
+			name: "dependency cycle",
+			config: Config{
+				Workers: []worker.Worker{
+					{Name: "dev1", Prompt: "prompt"},
+				},
+				Settings: worker.WorkerSettings{
+					Flow: []worker.FlowStep{
+						{Name: "step1", Type: "claude", DependsOn: []string{"step2"}},
+						{Name: "step2", Type: "claude", DependsOn: []string{"step1"}},
+					},
+				},
+			},
+			wantErr: "worker[0].flow validation failed: dependency cycle: step1 -> step2 -> step1",
+		},
+		{
+			name: "invalid input template",
+			config: Config{
+				Workers: []worker.Worker{
+					{Name: "dev1", Prompt: "prompt"},
+				},
+				Settings: worker.WorkerSettings{
+					Flow: []worker.FlowStep{
+						{Name: "step1", Type: "claude", Input: "{{ index .inputs 0 }"},
+					},
+				},
+			},
+			wantErr: `worker dev1: step step1: invalid input template: template: input:1: unexpected "}" in operand`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -456,3 +538,44 @@ func TestValidateConfigWithDisabledAgents(t *testing.T) {
 		})
 	}
 }
+
+func TestGetControlPlaneWorkerID(t *testing.T) {
+	config := &Config{
+		Workers: []worker.Worker{
+			{Name: "Senior Developer"},
+			{Name: "Disabled Reviewer", Enabled: util.BoolPtr(false)},
+			{Name: "Architect"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		workerName  string
+		expectedID  string
+		expectError bool
+	}{
+		{name: "first_worker", workerName: "Senior Developer", expectedID: "worker-1"},
+		{name: "normalized_name", workerName: "senior_developer", expectedID: "worker-1"},
+		{name: "skips_disabled_workers", workerName: "Architect", expectedID: "worker-2"},
+		{name: "disabled_worker", workerName: "Disabled Reviewer", expectError: true},
+		{name: "unknown_worker", workerName: "nobody", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := config.GetControlPlaneWorkerID(tt.workerName)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("GetControlPlaneWorkerID(%q) expected error, got %q", tt.workerName, id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetControlPlaneWorkerID(%q) unexpected error: %v", tt.workerName, err)
+			}
+			if id != tt.expectedID {
+				t.Errorf("GetControlPlaneWorkerID(%q) = %q, want %q", tt.workerName, id, tt.expectedID)
+			}
+		})
+	}
+}
@@ -15,6 +15,11 @@ const (
 	// Actual path will be: .autoteam/{team_name}/workers
 	WorkersBaseDir = AutoTeamDir + "/%s/workers"
 
+	// SecretsBaseDir is the base directory pattern for the secrets of workers, which is
+	// not mounted into containers
+	// Actual path will be: .autoteam/{team_name}/secrets
+	SecretsBaseDir = AutoTeamDir + "/%s/secrets"
+
 	// ControlPlaneBaseDir is the base directory pattern for control-plane config
 	// Actual path will be: .autoteam/{team_name}/control-plane
 	ControlPlaneBaseDir = AutoTeamDir + "/%s/control-plane"
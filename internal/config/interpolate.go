@@ -0,0 +1,334 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"autoteam/internal/agent"
+	"autoteam/internal/secrets"
+	"autoteam/internal/worker"
+
+	"gopkg.in/yaml.v3"
+)
+
+// workerPlaceholderPrefix marks the ${AUTOTEAM_WORKER_*} placeholders the generator replaces
+// per worker; interpolation leaves them untouched
+const workerPlaceholderPrefix = "AUTOTEAM_WORKER_"
+
+// stepTemplateFields are the keys of flow steps that hold templates or scripts rendered
+// when the step runs. Only their ${secret:NAME} references are resolved, so that other
+// ${...} reach the shell; templates read the environment with {{ .env.NAME }}.
+var stepTemplateFields = []string{"input", "output", "skip_when", "when", "for_each", "until"}
+
+// stepTypeTemplateFields are the template fields of specific step types, as key paths
+// relative to the step
+var stepTypeTemplateFields = map[string][]string{
+	agent.AgentTypeShell:   {"args", "env"},
+	worker.StepTypeHTTP:    {"http.method", "http.url", "http.headers", "http.body"},
+	worker.StepTypeMCPCall: {"mcp.arguments"},
+}
+
+// variableNamePattern matches the names of environment variables in ${...} references
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// interpolator expands ${VAR}, ${VAR:-default}, ${file:/path} and ${secret:NAME} references
+type interpolator struct {
+	lookup  func(string) (string, bool) // Looks up environment variables
+	baseDir string                      // Directory relative ${file:...} paths are resolved against
+
+	secretsNode *yaml.Node                // The secrets section, expanded before the rest of the config
+	secrets     map[string]secrets.Secret // Secret definitions; nil while the secrets section is expanded
+	resolved    map[string]string         // Values of the secrets referenced so far by name
+	references  []secrets.Reference       // Values resolved from secret references, by path
+}
+
+// newInterpolator creates an interpolator reading the process environment, with file
+// references relative to the directory of the config file
+func newInterpolator(configFile string) *interpolator {
+	return &interpolator{lookup: os.LookupEnv, baseDir: filepath.Dir(configFile)}
+}
+
+// interpolateConfig expands the references of a config document. The secrets section is
+// expanded and decoded first, so that ${secret:NAME} can be resolved everywhere else.
+func (in *interpolator) interpolateConfig(root *yaml.Node) []ValidationError {
+	content := root
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		content = root.Content[0]
+	}
+
+	var errs []ValidationError
+	if in.secretsNode = mappingValue(content, "secrets"); in.secretsNode != nil {
+		errs = in.interpolateNode(in.secretsNode, []string{"secrets"})
+		// Decoding errors are reported when the whole config is decoded
+		_ = in.secretsNode.Decode(&in.secrets)
+	}
+	if in.secrets == nil {
+		in.secrets = make(map[string]secrets.Secret)
+	}
+	return append(errs, in.interpolateNode(root, nil)...)
+}
+
+// interpolateNode expands the references in every scalar value of a YAML document and
+// returns a problem per reference that cannot be resolved. Mapping keys and the template
+// fields of flow steps are not expanded. path leads to node from the document root.
+func (in *interpolator) interpolateNode(node *yaml.Node, path []string) []ValidationError {
+	var errs []ValidationError
+	if node == in.secretsNode && in.secrets != nil {
+		return nil // Already expanded
+	}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return in.interpolateScalar(node, path, false)
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			key := node.Content[i-1].Value
+			if key == "flow" {
+				errs = append(errs, in.interpolateSteps(node.Content[i], childPath(path, key))...)
+				continue
+			}
+			errs = append(errs, in.interpolateNode(node.Content[i], childPath(path, key))...)
+		}
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			errs = append(errs, in.interpolateNode(child, path)...)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			errs = append(errs, in.interpolateNode(child, childPath(path, strconv.Itoa(i)))...)
+		}
+	}
+	return errs
+}
+
+// interpolateTemplate resolves only the ${secret:NAME} references in the scalars of a
+// template field
+func (in *interpolator) interpolateTemplate(node *yaml.Node, path []string) []ValidationError {
+	var errs []ValidationError
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return in.interpolateScalar(node, path, true)
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			errs = append(errs, in.interpolateTemplate(node.Content[i], childPath(path, node.Content[i-1].Value))...)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			errs = append(errs, in.interpolateTemplate(child, childPath(path, strconv.Itoa(i)))...)
+		}
+	}
+	return errs
+}
+
+// interpolateScalar replaces the value of a scalar with its expansion, or only resolves
+// its secret references if secretsOnly is set. Values resolved from secrets are recorded
+// with their path, so that the generator can write references instead.
+func (in *interpolator) interpolateScalar(node *yaml.Node, path []string, secretsOnly bool) []ValidationError {
+	if !strings.Contains(node.Value, "${") {
+		return nil
+	}
+	value, reference, names, err := in.expandReferences(node.Value, secretsOnly)
+	if err != nil {
+		return []ValidationError{{Line: node.Line, Column: node.Column, Err: err}}
+	}
+	if len(names) > 0 {
+		in.references = append(in.references, secrets.Reference{
+			Path: slices.Clone(path), Value: value, Reference: reference, Secrets: names,
+		})
+	}
+	if value != node.Value {
+		node.Value = value
+		// Let plain scalars resolve to the type of the expanded value, e.g. port: ${PORT}
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+	}
+	return nil
+}
+
+// interpolateSteps expands the references in a list of flow steps, except in their
+// template fields. The steps of loop steps are handled the same way.
+func (in *interpolator) interpolateSteps(node *yaml.Node, path []string) []ValidationError {
+	if node.Kind != yaml.SequenceNode {
+		return in.interpolateNode(node, path)
+	}
+
+	var errs []ValidationError
+	for i, step := range node.Content {
+		stepPath := childPath(path, strconv.Itoa(i))
+		if step.Kind != yaml.MappingNode {
+			errs = append(errs, in.interpolateNode(step, stepPath)...)
+			continue
+		}
+		templateFields := stepTemplateFields
+		if stepType := mappingValue(step, "type"); stepType != nil {
+			templateFields = slices.Concat(templateFields, stepTypeTemplateFields[stepType.Value])
+		}
+		errs = append(errs, in.interpolateStepFields(step, stepPath, "", templateFields)...)
+	}
+	return errs
+}
+
+// interpolateStepFields expands the references in the fields of a step, or of a mapping
+// within a step whose key path is prefix. templateFields are key paths relative to the step.
+func (in *interpolator) interpolateStepFields(node *yaml.Node, path []string, prefix string, templateFields []string) []ValidationError {
+	var errs []ValidationError
+	for i := 1; i < len(node.Content); i += 2 {
+		key, value := prefix+node.Content[i-1].Value, node.Content[i]
+		valuePath := childPath(path, node.Content[i-1].Value)
+		nested := func(field string) bool { return strings.HasPrefix(field, key+".") }
+		switch {
+		case slices.Contains(templateFields, key):
+			errs = append(errs, in.interpolateTemplate(value, valuePath)...)
+		case key == "steps":
+			errs = append(errs, in.interpolateSteps(value, valuePath)...)
+		case value.Kind == yaml.MappingNode && slices.ContainsFunc(templateFields, nested):
+			errs = append(errs, in.interpolateStepFields(value, valuePath, key+".", templateFields)...)
+		default:
+			errs = append(errs, in.interpolateNode(value, valuePath)...)
+		}
+	}
+	return errs
+}
+
+// childPath returns the path of a child of the node at path
+func childPath(path []string, key string) []string {
+	return append(slices.Clip(path), key)
+}
+
+// expand replaces the references in a string. $${ escapes a literal ${.
+func (in *interpolator) expand(value string) (string, error) {
+	expanded, _, _, err := in.expandReferences(value, false)
+	return expanded, err
+}
+
+// expandSecrets replaces only the ${secret:NAME} references in a string and leaves
+// everything else, $${ included, untouched
+func (in *interpolator) expandSecrets(value string) (string, error) {
+	expanded, _, _, err := in.expandReferences(value, true)
+	return expanded, err
+}
+
+// expandReferences replaces the references in a string, or only the secret references if
+// secretsOnly is set. Besides the expanded string, it returns the string with references
+// to the environment variables of secrets (see secrets.EnvName) in place of their values,
+// and the names of the secrets.
+func (in *interpolator) expandReferences(value string, secretsOnly bool) (string, string, []string, error) {
+	var result, reference strings.Builder
+	var names []string
+	write := func(s string) {
+		result.WriteString(s)
+		reference.WriteString(s)
+	}
+	for {
+		start := strings.Index(value, "${")
+		if start < 0 {
+			write(value)
+			return result.String(), reference.String(), names, nil
+		}
+		if start > 0 && value[start-1] == '$' {
+			if secretsOnly {
+				write(value[:start+2])
+			} else {
+				write(value[:start-1])
+				write("${")
+			}
+			value = value[start+2:]
+			continue
+		}
+
+		end := strings.IndexByte(value[start:], '}')
+		if end < 0 {
+			if secretsOnly {
+				write(value)
+				return result.String(), reference.String(), names, nil
+			}
+			return "", "", nil, fmt.Errorf("unterminated reference in %q", value)
+		}
+		end += start
+
+		write(value[:start])
+		ref := value[start+2 : end]
+		name, isSecret := strings.CutPrefix(ref, "secret:")
+		switch {
+		case strings.HasPrefix(ref, workerPlaceholderPrefix) || (secretsOnly && !isSecret):
+			write(value[start : end+1])
+		case isSecret:
+			resolved, err := in.resolveSecret(name)
+			if err != nil {
+				return "", "", nil, err
+			}
+			result.WriteString(resolved)
+			reference.WriteString("${" + secrets.EnvName(name) + "}")
+			names = append(names, name)
+		default:
+			resolved, err := in.resolve(ref)
+			if err != nil {
+				return "", "", nil, err
+			}
+			write(resolved)
+		}
+		value = value[end+1:]
+	}
+}
+
+// resolve returns the value of a single ${VAR} or ${file:...} reference without the
+// surrounding ${ }
+func (in *interpolator) resolve(reference string) (string, error) {
+	if path, ok := strings.CutPrefix(reference, "file:"); ok {
+		if path == "" {
+			return "", fmt.Errorf("${file:} requires a path")
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(in.baseDir, path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read ${%s}: %w", reference, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	name, defaultValue, hasDefault := strings.Cut(reference, ":-")
+	if !variableNamePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid reference ${%s} (expected ${VAR}, ${VAR:-default}, ${file:/path} or ${secret:NAME})", reference)
+	}
+	value, set := in.lookup(name)
+	if hasDefault && value == "" {
+		return defaultValue, nil
+	}
+	if !set {
+		return "", fmt.Errorf("environment variable %s is not set (use ${%s:-default} for optional values)", name, name)
+	}
+	return value, nil
+}
+
+// resolveSecret returns the value of a secret defined in the secrets section and registers
+// it for redaction
+func (in *interpolator) resolveSecret(name string) (string, error) {
+	if value, ok := in.resolved[name]; ok {
+		return value, nil
+	}
+	if in.secrets == nil {
+		return "", fmt.Errorf("secrets cannot reference other secrets (${secret:%s})", name)
+	}
+	secret, ok := in.secrets[name]
+	if !ok {
+		return "", fmt.Errorf("secret %s is not defined in the secrets section", name)
+	}
+
+	value, err := secret.Resolve(name, in.baseDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve secret %s: %w", name, err)
+	}
+	if in.resolved == nil {
+		in.resolved = make(map[string]string)
+	}
+	in.resolved[name] = value
+	secrets.Register(name, value)
+	return value, nil
+}
@@ -0,0 +1,174 @@
+package config
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"autoteam/internal/agent"
+	"autoteam/internal/secrets"
+	"autoteam/internal/worker"
+)
+
+// jsonSchemaDialect is the JSON Schema version of the generated schemas
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// referencePattern matches strings with a ${...} reference. Integer, boolean and enum fields
+// also accept such strings, since they only get their value when the config is loaded.
+const referencePattern = `\$\{[^}]+\}`
+
+// schemaField identifies a field of a config type by its Go name
+type schemaField struct {
+	typ   reflect.Type
+	field string
+}
+
+// schemaEnums lists the allowed values of string fields, built from the constants the
+// validation uses
+var schemaEnums = map[schemaField][]string{
+	{reflect.TypeOf(worker.FlowStep{}), "Type"}: append(slices.Clone(agent.SupportedTypes), worker.StepTypeRouter, worker.StepTypeLoop),
+	{reflect.TypeOf(worker.FlowStep{}), "DependencyPolicy"}: {
+		worker.DependencyPolicyFailFast, worker.DependencyPolicyAllSuccess,
+		worker.DependencyPolicyAllComplete, worker.DependencyPolicyAnySuccess,
+	},
+	{reflect.TypeOf(worker.FlowStep{}), "NotDuePolicy"}:  {worker.NotDuePolicyReuse, worker.NotDuePolicySkip},
+	{reflect.TypeOf(worker.RetryConfig{}), "Backoff"}:    {worker.BackoffFixed, worker.BackoffExponential, worker.BackoffLinear},
+	{reflect.TypeOf(worker.HookCommand{}), "ContinueOn"}: {worker.ContinueOnSuccess, worker.ContinueOnError, worker.ContinueOnAlways},
+	{reflect.TypeOf(secrets.Secret{}), "Provider"}:       secrets.Providers,
+}
+
+// schemaRequired lists the fields a config type cannot be used without
+var schemaRequired = map[reflect.Type][]string{
+	reflect.TypeOf(Config{}):                {"Workers"},
+	reflect.TypeOf(worker.Worker{}):         {"Name"},
+	reflect.TypeOf(worker.FlowStep{}):       {"Name", "Type"},
+	reflect.TypeOf(worker.MCPServer{}):      {"Command"},
+	reflect.TypeOf(worker.HookCommand{}):    {"Command"},
+	reflect.TypeOf(worker.HTTPRequest{}):    {"URL"},
+	reflect.TypeOf(worker.MCPCall{}):        {"Server", "Tool"},
+	reflect.TypeOf(worker.ScheduleWindow{}): {"Start", "End"},
+	reflect.TypeOf(secrets.Secret{}):        {"Provider"},
+}
+
+// JSONSchema generates the JSON Schema of autoteam.yaml from the Config type
+func JSONSchema() map[string]interface{} {
+	return generateSchema(reflect.TypeOf(Config{}), "autoteam.yaml")
+}
+
+// WorkerJSONSchema generates the JSON Schema of the config files generated for each worker
+func WorkerJSONSchema() map[string]interface{} {
+	return generateSchema(reflect.TypeOf(worker.Worker{}), "AutoTeam worker config")
+}
+
+// generateSchema generates a schema with the given root type. Struct types are described
+// once in $defs and referenced from every field of that type.
+func generateSchema(root reflect.Type, title string) map[string]interface{} {
+	g := &schemaGenerator{defs: make(map[string]interface{})}
+	schema := g.structSchema(root)
+	delete(g.defs, root.Name())
+
+	schema["$schema"] = jsonSchemaDialect
+	schema["title"] = title
+	if len(g.defs) > 0 {
+		schema["$defs"] = g.defs
+	}
+	return schema
+}
+
+// schemaGenerator converts Go types to JSON Schema
+type schemaGenerator struct {
+	defs map[string]interface{}
+}
+
+// typeSchema returns the schema of a Go type
+func (g *schemaGenerator) typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if _, exists := g.defs[t.Name()]; !exists {
+			g.defs[t.Name()] = nil // Placeholder for recursive types
+			g.defs[t.Name()] = g.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.typeSchema(t.Elem())}
+	case reflect.Map:
+		schema := map[string]interface{}{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			schema["additionalProperties"] = g.typeSchema(t.Elem())
+		}
+		return schema
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return referableSchema("boolean")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return referableSchema("integer")
+	case reflect.Float32, reflect.Float64:
+		return referableSchema("number")
+	default:
+		return map[string]interface{}{} // Any value, e.g. interface{}
+	}
+}
+
+// referableSchema returns the schema of a scalar type that may also be given as a string
+// with a ${...} reference. The pattern only applies to strings.
+func referableSchema(typ string) map[string]interface{} {
+	return map[string]interface{}{"type": []string{typ, "string"}, "pattern": referencePattern}
+}
+
+// structSchema returns the object schema of a struct type with a property per YAML field
+func (g *schemaGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := yamlFieldName(field)
+		if !ok {
+			continue
+		}
+
+		property := g.typeSchema(field.Type)
+		if enum := schemaEnums[schemaField{t, field.Name}]; len(enum) > 0 {
+			property["anyOf"] = []interface{}{
+				map[string]interface{}{"enum": enum},
+				map[string]interface{}{"pattern": referencePattern},
+			}
+		}
+		properties[name] = property
+
+		if slices.Contains(schemaRequired[t], field.Name) {
+			required = append(required, name)
+		}
+	}
+
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// yamlFieldName returns the key of a struct field in YAML, like yaml.v3 does
+func yamlFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return strings.ToLower(field.Name), true
+	default:
+		return name, true
+	}
+}
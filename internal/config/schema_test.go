@@ -0,0 +1,150 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"autoteam/internal/testutil"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"gopkg.in/yaml.v3"
+)
+
+func TestJSONSchema(t *testing.T) {
+	schema := JSONSchema()
+	defs := schema["$defs"].(map[string]interface{})
+
+	if schema["$schema"] != jsonSchemaDialect {
+		t.Errorf("$schema = %v, want %s", schema["$schema"], jsonSchemaDialect)
+	}
+	if got := schema["required"]; !reflect.DeepEqual(got, []string{"workers"}) {
+		t.Errorf("required = %v, want [workers]", got)
+	}
+
+	step := defs["FlowStep"].(map[string]interface{})["properties"].(map[string]interface{})
+	wantEnums := map[string][]string{
+		"type":              {"claude", "gemini", "qwen", "debug", "shell", "http", "mcp_call", "router", "loop"},
+		"dependency_policy": {"fail_fast", "all_success", "all_complete", "any_success"},
+		"not_due_policy":    {"reuse", "skip"},
+	}
+	for field, want := range wantEnums {
+		if got := schemaEnum(step[field]); !reflect.DeepEqual(got, want) {
+			t.Errorf("FlowStep.%s enum = %v, want %v", field, got, want)
+		}
+	}
+
+	backoff := defs["RetryConfig"].(map[string]interface{})["properties"].(map[string]interface{})["backoff"]
+	if got := schemaEnum(backoff); !reflect.DeepEqual(got, []string{"fixed", "exponential", "linear"}) {
+		t.Errorf("RetryConfig.backoff enum = %v", got)
+	}
+	continueOn := defs["HookCommand"].(map[string]interface{})["properties"].(map[string]interface{})["continue_on"]
+	if got := schemaEnum(continueOn); !reflect.DeepEqual(got, []string{"success", "error", "always"}) {
+		t.Errorf("HookCommand.continue_on enum = %v", got)
+	}
+
+	// Enums and required fields must refer to existing fields
+	for key := range schemaEnums {
+		if _, ok := key.typ.FieldByName(key.field); !ok {
+			t.Errorf("schemaEnums refers to unknown field %s.%s", key.typ.Name(), key.field)
+		}
+	}
+	for typ, fields := range schemaRequired {
+		for _, field := range fields {
+			if _, ok := typ.FieldByName(field); !ok {
+				t.Errorf("schemaRequired refers to unknown field %s.%s", typ.Name(), field)
+			}
+		}
+	}
+}
+
+// schemaEnum returns the allowed values of an enum property besides ${...} references
+func schemaEnum(property interface{}) interface{} {
+	alternatives, _ := property.(map[string]interface{})["anyOf"].([]interface{})
+	if len(alternatives) == 0 {
+		return nil
+	}
+	return alternatives[0].(map[string]interface{})["enum"]
+}
+
+func TestJSONSchema_MatchesConfigs(t *testing.T) {
+	samplePath := filepath.Join(testutil.CreateTempDir(t), "autoteam.yaml")
+	if err := CreateSampleConfig(samplePath); err != nil {
+		t.Fatalf("CreateSampleConfig() error = %v", err)
+	}
+
+	for _, path := range []string{samplePath, "testdata/valid.yaml"} {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", path, err)
+			}
+			if err := validateAgainstSchema(t, JSONSchema(), data); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	t.Run("references in scalar fields", func(t *testing.T) {
+		data := []byte(`
+workers:
+  - name: dev1
+    settings:
+      max_parallel: ${MAX_PARALLEL}
+      fail_fast: ${FAIL_FAST:-true}
+      flow:
+        - name: build
+          type: ${BUILD_AGENT}
+          retry:
+            max_attempts: ${BUILD_ATTEMPTS}
+`)
+		if err := validateAgainstSchema(t, JSONSchema(), data); err != nil {
+			t.Error(err)
+		}
+	})
+
+	invalid := map[string]string{
+		"unknown field":            "workers:\n  - name: dev1\n    promt: typo\n",
+		"string without reference": "workers:\n  - name: dev1\n    settings:\n      max_parallel: many\n",
+		"unknown enum value":       "workers:\n  - name: dev1\n    settings:\n      flow:\n        - name: build\n          type: gemnii\n",
+	}
+	for name, data := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if err := validateAgainstSchema(t, JSONSchema(), []byte(data)); err == nil {
+				t.Error("Expected the config to be rejected")
+			}
+		})
+	}
+}
+
+// validateAgainstSchema validates a YAML document against a generated schema with a
+// JSON Schema validator
+func validateAgainstSchema(t *testing.T, schema map[string]interface{}, data []byte) error {
+	t.Helper()
+
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("failed to encode schema: %v", err)
+	}
+	document, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaJSON))
+	if err != nil {
+		t.Fatalf("failed to decode schema: %v", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("autoteam.schema.json", document); err != nil {
+		t.Fatalf("failed to add schema: %v", err)
+	}
+	compiled, err := compiler.Compile("autoteam.schema.json")
+	if err != nil {
+		t.Fatalf("generated schema is invalid: %v", err)
+	}
+
+	var value interface{}
+	if err := yaml.Unmarshal(data, &value); err != nil {
+		t.Fatalf("failed to parse config: %v", err)
+	}
+	return compiled.Validate(value)
+}
@@ -0,0 +1,286 @@
+package config
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"autoteam/internal/flow"
+	"autoteam/internal/schedule"
+	"autoteam/internal/secrets"
+	"autoteam/internal/worker"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationError is a configuration problem together with the location of the YAML value
+// it concerns
+type ValidationError struct {
+	Path   string // Location in the config, e.g. settings.flow[1].retry
+	Line   int    // 1-based line of the value (0 if the location is unknown)
+	Column int    // 1-based column of the value
+	Err    error
+}
+
+// Error formats the problem with its line and column
+func (e ValidationError) Error() string {
+	if e.Line == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateFile performs the static analysis of a config file and returns every problem
+// with its line and column, ordered by position. The error is only set if the file cannot
+// be read or parsed.
+func ValidateFile(filename string) ([]ValidationError, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	// Unresolvable references are reported like any other problem; the analysis then
+	// sees them unexpanded
+	errs := newInterpolator(filename).interpolateConfig(&root)
+
+	var config Config
+	if err := root.Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	errs = append(errs, analyzeConfig(&config)...)
+	for i := range errs {
+		if errs[i].Line != 0 {
+			continue
+		}
+		if node := locate(&root, errs[i].Path); node != nil {
+			errs[i].Line, errs[i].Column = node.Line, node.Column
+		}
+	}
+	slices.SortStableFunc(errs, func(a, b ValidationError) int {
+		return cmp.Or(cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+	return errs, nil
+}
+
+// validator collects the problems found by the static analysis
+type validator struct {
+	errs []ValidationError
+	seen map[string]bool
+}
+
+// add records a problem at a path
+func (v *validator) add(path string, err error) {
+	v.errs = append(v.errs, ValidationError{Path: path, Err: err})
+}
+
+// addOnce records a problem unless the same problem was already recorded at the path,
+// e.g. for the global flow shared by several workers
+func (v *validator) addOnce(path string, problem string, err error) {
+	key := path + "\x00" + problem
+	if v.seen[key] {
+		return
+	}
+	if v.seen == nil {
+		v.seen = make(map[string]bool)
+	}
+	v.seen[key] = true
+	v.add(path, err)
+}
+
+// analyzeConfig checks the whole config and returns every problem in the order the
+// workers, steps and settings appear
+func analyzeConfig(config *Config) []ValidationError {
+	var v validator
+
+	if len(config.Workers) == 0 {
+		v.add("workers", fmt.Errorf("at least one worker must be configured"))
+		return v.errs
+	}
+
+	// Count enabled workers
+	enabledCount := 0
+	for _, worker := range config.Workers {
+		if worker.IsEnabled() {
+			enabledCount++
+		}
+	}
+	if enabledCount == 0 {
+		v.add("workers", fmt.Errorf("at least one worker must be enabled"))
+	}
+
+	for i, worker := range config.Workers {
+		path := fmt.Sprintf("workers[%d]", i)
+		if worker.Name == "" {
+			v.add(path+".name", fmt.Errorf("worker[%d].name is required", i))
+			continue
+		}
+		// Only validate required fields for enabled workers
+		if !worker.IsEnabled() {
+			continue
+		}
+		if worker.Prompt == "" {
+			v.add(path+".prompt", fmt.Errorf("worker[%d].prompt is required for enabled workers", i))
+		}
+
+		// Get effective settings to check flow configuration
+		settings := worker.GetEffectiveSettings(config.Settings)
+		if len(settings.Flow) == 0 {
+			v.add(path, fmt.Errorf("worker[%d].flow is required for enabled workers", i))
+		} else {
+			stepsPath := settingsPath(i, worker.Settings != nil && len(worker.Settings.Flow) > 0, "flow")
+
+			// Validate flow steps
+			for _, problem := range flow.ValidateSteps(settings.Flow, settings.MCPServers) {
+				v.addOnce(stepsPath+flowPath(problem), problem.Error(), fmt.Errorf("worker[%d].flow validation failed: %w", i, problem.Err))
+			}
+
+			// Pre-compile templates so syntax errors surface before the first cycle
+			for j, step := range settings.Flow {
+				if err := step.ValidateTemplates(); err != nil {
+					v.addOnce(fmt.Sprintf("%s[%d]", stepsPath, j), err.Error(), fmt.Errorf("worker %s: step %s: %w", worker.Name, step.Name, err))
+				}
+			}
+		}
+
+		// Validate schedule
+		if _, err := schedule.New(settings.Schedule, time.Duration(settings.GetSleepDuration())*time.Second); err != nil {
+			own := worker.Settings != nil && (worker.Settings.Schedule != nil || worker.Settings.SleepDuration != nil)
+			v.add(settingsPath(i, own, "schedule"), fmt.Errorf("worker[%d].settings.schedule validation failed: %w", i, err))
+		}
+
+		// Validate flow timeout and parallelism
+		if _, err := settings.GetFlowTimeout(); err != nil {
+			own := worker.Settings != nil && worker.Settings.FlowTimeout != nil
+			v.add(settingsPath(i, own, "flow_timeout"), fmt.Errorf("worker[%d].settings validation failed: %w", i, err))
+		}
+		if settings.GetMaxParallel() < 0 {
+			own := worker.Settings != nil && worker.Settings.MaxParallel != nil
+			v.add(settingsPath(i, own, "max_parallel"), fmt.Errorf("worker[%d].settings validation failed: max_parallel must not be negative", i))
+		}
+		if _, err := settings.GetHistoryRetention(); err != nil {
+			own := worker.Settings != nil && worker.Settings.History != nil
+			v.add(settingsPath(i, own, "history"), fmt.Errorf("worker[%d].settings validation failed: %w", i, err))
+		}
+
+		// Worker hooks replace the global hooks
+		if worker.Settings != nil {
+			hookErrors(&v, path+".settings.hooks", worker.Settings.Hooks)
+		}
+	}
+
+	hookErrors(&v, "settings.hooks", config.Settings.Hooks)
+
+	for _, name := range slices.Sorted(maps.Keys(config.Secrets)) {
+		if err := secrets.ValidateName(name); err != nil {
+			v.add("secrets."+name, err)
+		} else if err := config.Secrets[name].Validate(); err != nil {
+			v.add("secrets."+name, fmt.Errorf("secret %s: %w", name, err))
+		}
+	}
+
+	return v.errs
+}
+
+// settingsPath returns the path of a setting of a worker: in the worker settings if the
+// worker sets it, otherwise in the global settings
+func settingsPath(index int, own bool, field string) string {
+	if own {
+		return fmt.Sprintf("workers[%d].settings.%s", index, field)
+	}
+	return "settings." + field
+}
+
+// hookErrors checks the commands of every lifecycle hook
+func hookErrors(v *validator, path string, hooks *worker.HookConfig) {
+	if hooks == nil {
+		return
+	}
+	events := []struct {
+		name     string
+		commands []worker.HookCommand
+	}{
+		{"on_init", hooks.OnInit},
+		{"on_start", hooks.OnStart},
+		{"on_stop", hooks.OnStop},
+		{"on_error", hooks.OnError},
+	}
+	for _, event := range events {
+		for j, hook := range event.commands {
+			if err := hook.Validate(); err != nil {
+				hookPath := fmt.Sprintf("%s.%s[%d]", path, event.name, j)
+				v.add(hookPath, fmt.Errorf("%s: %w", hookPath, err))
+			}
+		}
+	}
+}
+
+// flowPath returns the path of a flow problem relative to the flow, e.g. [2].retry
+func flowPath(problem flow.StepError) string {
+	if problem.Index < 0 {
+		return ""
+	}
+	path := fmt.Sprintf("[%d]", problem.Index)
+	if problem.Field != "" {
+		path += "." + problem.Field
+	}
+	return path
+}
+
+// locate returns the YAML node at a path such as workers[0].settings.flow[2].retry. If the
+// path does not exist completely, the deepest existing node is returned.
+func locate(root *yaml.Node, path string) *yaml.Node {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		key, indexes, _ := strings.Cut(segment, "[")
+		if key != "" {
+			value := mappingValue(node, key)
+			if value == nil {
+				return node
+			}
+			node = value
+		}
+		for indexes != "" {
+			var index string
+			index, indexes, _ = strings.Cut(indexes, "]")
+			indexes = strings.TrimPrefix(indexes, "[")
+			i, err := strconv.Atoi(index)
+			if err != nil || node.Kind != yaml.SequenceNode || i < 0 || i >= len(node.Content) {
+				return node
+			}
+			node = node.Content[i]
+		}
+	}
+	return node
+}
+
+// mappingValue returns the value of a key in a mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"testing"
+
+	"autoteam/internal/testutil"
+)
+
+func TestValidateFile(t *testing.T) {
+	content := `workers:
+  - name: dev1
+    prompt: "Developer"
+  - name: dev2
+    prompt: "Reviewer"
+    settings:
+      hooks:
+        on_start:
+          - command: echo
+            continue_on: sometimes
+settings:
+  flow:
+    - name: collect
+      type: gemnii
+      depends_on: [review]
+    - name: review
+      type: claude
+      depends_on: [collect, triage]
+      dependency_policy: all_good
+      retry:
+        backoff: quadratic
+      input: "{{ index .inputs 0 }"
+`
+	path := testutil.CreateTempFile(t, testutil.CreateTempDir(t), "autoteam.yaml", content)
+
+	errs, err := ValidateFile(path)
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+
+	want := []struct {
+		line, column int
+		message      string
+	}{
+		{9, 13, `workers[1].settings.hooks.on_start[0]: invalid continue_on "sometimes" (expected success, error or always)`},
+		{14, 13, `worker[0].flow validation failed: step collect: unknown type "gemnii" (expected claude, gemini, qwen, debug, shell, http, mcp_call, router or loop)`},
+		{15, 19, "worker[0].flow validation failed: dependency cycle: collect -> review -> collect"},
+		{16, 7, `worker dev1: step review: invalid input template: template: input:1: unexpected "}" in operand`},
+		{18, 29, "worker[0].flow validation failed: step review depends on non-existent step: triage"},
+		{19, 26, `worker[0].flow validation failed: step review: invalid dependency_policy "all_good" (expected fail_fast, all_success, all_complete or any_success)`},
+		{21, 9, `worker[0].flow validation failed: step review: invalid retry backoff "quadratic" (expected fixed, exponential or linear)`},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("ValidateFile() returned %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, w := range want {
+		if errs[i].Line != w.line || errs[i].Column != w.column || errs[i].Err.Error() != w.message {
+			t.Errorf("errs[%d] = %d:%d %q, want %d:%d %q", i, errs[i].Line, errs[i].Column, errs[i].Err, w.line, w.column, w.message)
+		}
+	}
+}
+
+func TestValidateFile_Valid(t *testing.T) {
+	t.Setenv("DEV1_GITHUB_TOKEN", "dev1-token")
+	t.Setenv("ARCH1_GITHUB_TOKEN", "arch1-token")
+
+	errs, err := ValidateFile("testdata/valid.yaml")
+	if err != nil {
+		t.Fatalf("ValidateFile() error = %v", err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("ValidateFile() = %v, want no errors", errs)
+	}
+}
+
+func TestValidateFile_InvalidYAML(t *testing.T) {
+	path := testutil.CreateTempFile(t, testutil.CreateTempDir(t), "autoteam.yaml", "workers: [\n")
+	if _, err := ValidateFile(path); err == nil {
+		t.Errorf("ValidateFile() error = nil, want parse error")
+	}
+}
@@ -1,6 +1,7 @@
 package controlplane
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,18 +13,24 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // Handlers implements the control plane API handlers
 type Handlers struct {
-	registry *WorkerRegistry
+	registry      *WorkerRegistry
+	webhookSecret string        // Verifies X-Hub-Signature-256 on webhooks when set
+	retryDelay    time.Duration // Wait before reconnecting fanned-in metrics streams
 }
 
 // NewHandlers creates new control plane handlers
 func NewHandlers(registry *WorkerRegistry) *Handlers {
 	return &Handlers{
-		registry: registry,
+		registry:   registry,
+		retryDelay: metricsRetryDelay,
 	}
 }
 
@@ -313,6 +320,67 @@ func (h *Handlers) GetWorkerLogFile(ctx echo.Context, workerID string, filename
 	return ctx.JSON(http.StatusOK, resp)
 }
 
+// StreamWorkerLogFile proxies a worker log stream to the client as Server-Sent Events
+func (h *Handlers) StreamWorkerLogFile(ctx echo.Context, workerID string, filename string, params controlplaneapi.StreamWorkerLogFileParams) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	// Get worker from registry
+	worker, err := h.registry.GetWorker(workerID)
+	if err != nil {
+		log.Warn("Worker not found", zap.String("worker_id", workerID))
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Worker not found: %s", workerID))
+	}
+
+	// Create context with authentication, cancelled when the client disconnects
+	grpcCtx := h.registry.createContext(ctx.Request().Context(), worker.APIKey)
+
+	req := &workerv1.StreamLogsRequest{
+		Filename: filename,
+	}
+	if params.Tail != nil {
+		tailInt32 := int32(*params.Tail)
+		req.Tail = &tailInt32
+	}
+
+	stream, err := worker.Client.StreamLogs(grpcCtx, req)
+	if err == nil {
+		err = awaitStream(stream, func() error {
+			_, recvErr := stream.Recv()
+			return recvErr
+		})
+	}
+	if err != nil {
+		log.Error("Failed to stream worker log file",
+			zap.String("worker_id", workerID),
+			zap.String("filename", filename),
+			zap.String("worker_url", worker.URL),
+			zap.Error(err))
+		return h.workerErrorToHTTP(workerID, err)
+	}
+
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+
+	if err := startEventStream(ctx); err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			// Client disconnects and worker shutdowns both end the stream
+			log.Debug("Worker log stream ended",
+				zap.String("worker_id", workerID),
+				zap.String("filename", filename),
+				zap.Error(err))
+			return nil
+		}
+
+		if err := writeEvent(ctx, "log", chunk); err != nil {
+			return nil
+		}
+	}
+}
+
 func (h *Handlers) GetWorkerFlow(ctx echo.Context, workerID string) error {
 	log := logger.FromContext(ctx.Request().Context())
 
@@ -379,6 +447,56 @@ func (h *Handlers) GetWorkerFlowSteps(ctx echo.Context, workerID string) error {
 	return ctx.JSON(http.StatusOK, resp)
 }
 
+// SetWorkerStepEnabled enables or disables a worker flow step
+func (h *Handlers) SetWorkerStepEnabled(ctx echo.Context, workerID string, stepName string) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	var body types.StepEnabledRequest
+	if err := ctx.Bind(&body); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	// Get worker from registry
+	worker, err := h.registry.GetWorker(workerID)
+	if err != nil {
+		log.Warn("Worker not found", zap.String("worker_id", workerID))
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Worker not found: %s", workerID))
+	}
+
+	// Create context with authentication
+	grpcCtx := h.registry.createContext(ctx.Request().Context(), worker.APIKey)
+
+	// Make gRPC call
+	resp, err := worker.Client.SetStepEnabled(grpcCtx, &workerv1.SetStepEnabledRequest{
+		StepName: stepName,
+		Enabled:  body.Enabled,
+	})
+	if err != nil {
+		log.Error("Failed to set worker step state",
+			zap.String("worker_id", workerID),
+			zap.String("step_name", stepName),
+			zap.String("worker_url", worker.URL),
+			zap.Error(err))
+		return h.workerErrorToHTTP(workerID, err)
+	}
+
+	// Update worker status as reachable
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+
+	log.Info("Worker step state updated",
+		zap.String("worker_id", workerID),
+		zap.String("step_name", resp.StepName),
+		zap.Bool("enabled", resp.Enabled))
+
+	response := types.StepEnabledResponse{
+		StepName:  resp.StepName,
+		Enabled:   resp.Enabled,
+		Timestamp: resp.Timestamp.AsTime(),
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 func (h *Handlers) GetWorkerMetrics(ctx echo.Context, workerID string) error {
 	log := logger.FromContext(ctx.Request().Context())
 
@@ -412,6 +530,284 @@ func (h *Handlers) GetWorkerMetrics(ctx echo.Context, workerID string) error {
 	return ctx.JSON(http.StatusOK, resp)
 }
 
+// StreamWorkerMetrics proxies a worker metrics stream to the client as Server-Sent Events
+func (h *Handlers) StreamWorkerMetrics(ctx echo.Context, workerID string, params controlplaneapi.StreamWorkerMetricsParams) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	// Get worker from registry
+	worker, err := h.registry.GetWorker(workerID)
+	if err != nil {
+		log.Warn("Worker not found", zap.String("worker_id", workerID))
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Worker not found: %s", workerID))
+	}
+
+	// Create context with authentication, cancelled when the client disconnects
+	grpcCtx := h.registry.createContext(ctx.Request().Context(), worker.APIKey)
+
+	stream, err := worker.Client.StreamMetrics(grpcCtx, newStreamMetricsRequest(params.Interval))
+	if err == nil {
+		err = awaitStream(stream, func() error {
+			_, recvErr := stream.Recv()
+			return recvErr
+		})
+	}
+	if err != nil {
+		log.Error("Failed to stream worker metrics",
+			zap.String("worker_id", workerID),
+			zap.String("worker_url", worker.URL),
+			zap.Error(err))
+		return h.workerErrorToHTTP(workerID, err)
+	}
+
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+
+	if err := startEventStream(ctx); err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			log.Debug("Worker metrics stream ended",
+				zap.String("worker_id", workerID),
+				zap.Error(err))
+			return nil
+		}
+
+		event := workerMetricsEvent{
+			WorkerID:  workerID,
+			Metrics:   update.Metrics,
+			Timestamp: update.Timestamp.AsTime(),
+		}
+		if err := writeEvent(ctx, "metrics", event); err != nil {
+			return nil
+		}
+	}
+}
+
+// StreamAllMetrics fans in the metrics streams of all workers into a single Server-Sent Events stream
+func (h *Handlers) StreamAllMetrics(ctx echo.Context, params controlplaneapi.StreamAllMetricsParams) error {
+	reqCtx := ctx.Request().Context()
+
+	if err := startEventStream(ctx); err != nil {
+		return err
+	}
+
+	events := make(chan streamEvent)
+	req := newStreamMetricsRequest(params.Interval)
+
+	// Workers registered after the stream started are picked up as well. A worker that is
+	// registered again under the same ID is streamed from its new endpoint.
+	forwarders := make(map[string]*RegisteredWorker)
+	cancels := make(map[string]context.CancelFunc)
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+
+	for {
+		changes := h.registry.Changes()
+		for workerID, worker := range h.registry.GetAllWorkers() {
+			if forwarders[workerID] == worker {
+				continue
+			}
+			if cancel, exists := cancels[workerID]; exists {
+				cancel()
+			}
+			workerCtx, cancel := context.WithCancel(reqCtx)
+			forwarders[workerID] = worker
+			cancels[workerID] = cancel
+			go h.forwardWorkerMetrics(workerCtx, workerID, worker, req, events)
+		}
+
+		if err := writeMetricsEvents(ctx, reqCtx, events, changes); err != nil {
+			return nil
+		}
+	}
+}
+
+// writeMetricsEvents writes fanned-in events to the client until the set of registered
+// workers changes. It returns an error once the client is gone.
+func writeMetricsEvents(ctx echo.Context, reqCtx context.Context, events <-chan streamEvent, changes <-chan struct{}) error {
+	for {
+		select {
+		case <-reqCtx.Done():
+			return reqCtx.Err()
+		case <-changes:
+			return nil
+		case event := <-events:
+			if err := writeEvent(ctx, event.name, event.data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// forwardWorkerMetrics relays metrics updates from a single worker until ctx is done,
+// reconnecting after failures
+func (h *Handlers) forwardWorkerMetrics(ctx context.Context, workerID string, worker *RegisteredWorker, req *workerv1.StreamMetricsRequest, events chan<- streamEvent) {
+	log := logger.FromContext(ctx)
+
+	send := func(event streamEvent) bool {
+		select {
+		case events <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for {
+		grpcCtx := h.registry.createContext(ctx, worker.APIKey)
+		stream, err := worker.Client.StreamMetrics(grpcCtx, req)
+
+		for err == nil {
+			var update *workerv1.MetricsUpdate
+			update, err = stream.Recv()
+			if err != nil {
+				break
+			}
+
+			h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+			event := workerMetricsEvent{
+				WorkerID:  workerID,
+				Metrics:   update.Metrics,
+				Timestamp: update.Timestamp.AsTime(),
+			}
+			if !send(streamEvent{name: "metrics", data: event}) {
+				return
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Warn("Worker metrics stream failed, retrying",
+			zap.String("worker_id", workerID),
+			zap.String("worker_url", worker.URL),
+			zap.Duration("retry_delay", h.retryDelay),
+			zap.Error(err))
+		h.registry.updateWorkerStatus(workerID, types.WorkerStatusUnreachable, nil)
+
+		event := workerErrorEvent{
+			WorkerID:  workerID,
+			Error:     err.Error(),
+			Timestamp: time.Now(),
+		}
+		if !send(streamEvent{name: "worker_error", data: event}) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.retryDelay):
+		}
+	}
+}
+
+// newStreamMetricsRequest builds a metrics stream request from an optional interval in seconds
+func newStreamMetricsRequest(interval *int) *workerv1.StreamMetricsRequest {
+	req := &workerv1.StreamMetricsRequest{}
+	if interval != nil {
+		intervalInt32 := int32(*interval)
+		req.IntervalSeconds = &intervalInt32
+	}
+	return req
+}
+
+// TriggerWorkerFlow starts a worker's next flow cycle immediately
+func (h *Handlers) TriggerWorkerFlow(ctx echo.Context, workerID string) error {
+	return h.proxyControl(ctx, workerID, "trigger flow", workerv1.WorkerServiceClient.TriggerFlow)
+}
+
+// CancelWorkerCycle cancels the flow cycle a worker is currently executing
+func (h *Handlers) CancelWorkerCycle(ctx echo.Context, workerID string) error {
+	return h.proxyControl(ctx, workerID, "cancel cycle", workerv1.WorkerServiceClient.CancelCurrentCycle)
+}
+
+// PauseWorker stops a worker from starting new flow cycles
+func (h *Handlers) PauseWorker(ctx echo.Context, workerID string) error {
+	return h.proxyControl(ctx, workerID, "pause worker", workerv1.WorkerServiceClient.PauseWorker)
+}
+
+// ResumeWorker lets a paused worker start flow cycles again
+func (h *Handlers) ResumeWorker(ctx echo.Context, workerID string) error {
+	return h.proxyControl(ctx, workerID, "resume worker", workerv1.WorkerServiceClient.ResumeWorker)
+}
+
+// controlCall is a worker control RPC taking no arguments
+type controlCall func(workerv1.WorkerServiceClient, context.Context, *emptypb.Empty, ...grpc.CallOption) (*workerv1.ControlResponse, error)
+
+// proxyControl forwards a control action to a worker and converts the result
+func (h *Handlers) proxyControl(ctx echo.Context, workerID string, action string, call controlCall) error {
+	return h.forwardControl(ctx, workerID, action, func(client workerv1.WorkerServiceClient, grpcCtx context.Context) (*workerv1.ControlResponse, error) {
+		return call(client, grpcCtx, &emptypb.Empty{})
+	})
+}
+
+// forwardControl runs a control RPC against a worker and converts the result
+func (h *Handlers) forwardControl(ctx echo.Context, workerID string, action string, call func(workerv1.WorkerServiceClient, context.Context) (*workerv1.ControlResponse, error)) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	// Get worker from registry
+	worker, err := h.registry.GetWorker(workerID)
+	if err != nil {
+		log.Warn("Worker not found", zap.String("worker_id", workerID))
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Worker not found: %s", workerID))
+	}
+
+	// Create context with authentication
+	grpcCtx := h.registry.createContext(ctx.Request().Context(), worker.APIKey)
+
+	// Make gRPC call
+	resp, err := call(worker.Client, grpcCtx)
+	if err != nil {
+		log.Error("Failed to control worker",
+			zap.String("worker_id", workerID),
+			zap.String("action", action),
+			zap.String("worker_url", worker.URL),
+			zap.Error(err))
+		return h.workerErrorToHTTP(workerID, err)
+	}
+
+	// Update worker status as reachable
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+
+	log.Info("Worker control action completed",
+		zap.String("worker_id", workerID),
+		zap.String("action", action),
+		zap.Bool("accepted", resp.Accepted),
+		zap.String("message", resp.Message))
+
+	response := types.ControlResponse{
+		Accepted:  resp.Accepted,
+		Message:   resp.Message,
+		Status:    resp.Status,
+		Timestamp: resp.Timestamp.AsTime(),
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// workerErrorToHTTP converts a failed worker call into an HTTP error, marking
+// the worker unreachable when the failure is not caused by the request itself
+func (h *Handlers) workerErrorToHTTP(workerID string, err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return echo.NewHTTPError(http.StatusNotFound, status.Convert(err).Message())
+	case codes.InvalidArgument:
+		return echo.NewHTTPError(http.StatusBadRequest, status.Convert(err).Message())
+	case codes.ResourceExhausted:
+		return echo.NewHTTPError(http.StatusTooManyRequests, status.Convert(err).Message())
+	}
+
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusUnreachable, nil)
+	return echo.NewHTTPError(http.StatusBadGateway, fmt.Sprintf("Worker unreachable: %s", workerID))
+}
+
 // GetOpenAPISpec returns the control plane OpenAPI specification
 func (h *Handlers) GetOpenAPISpec(ctx echo.Context) error {
 	spec, err := controlplaneapi.GetSwagger()
@@ -0,0 +1,353 @@
+package controlplane
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"autoteam/internal/config"
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/types"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeWorker is an in-process worker gRPC service. Each test sets the calls it needs,
+// the others return Unimplemented.
+type fakeWorker struct {
+	workerv1.UnimplementedWorkerServiceServer
+
+	control       func(action string) (*workerv1.ControlResponse, error)
+	triggerEvent  func(req *workerv1.TriggerEventRequest) (*workerv1.ControlResponse, error)
+	streamLogs    func(req *workerv1.StreamLogsRequest, stream grpc.ServerStreamingServer[workerv1.LogChunk]) error
+	streamMetrics func(req *workerv1.StreamMetricsRequest, stream grpc.ServerStreamingServer[workerv1.MetricsUpdate]) error
+}
+
+func (w *fakeWorker) TriggerFlow(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if w.control == nil {
+		return w.UnimplementedWorkerServiceServer.TriggerFlow(ctx, req)
+	}
+	return w.control("trigger")
+}
+
+func (w *fakeWorker) PauseWorker(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if w.control == nil {
+		return w.UnimplementedWorkerServiceServer.PauseWorker(ctx, req)
+	}
+	return w.control("pause")
+}
+
+func (w *fakeWorker) ResumeWorker(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if w.control == nil {
+		return w.UnimplementedWorkerServiceServer.ResumeWorker(ctx, req)
+	}
+	return w.control("resume")
+}
+
+func (w *fakeWorker) CancelCurrentCycle(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if w.control == nil {
+		return w.UnimplementedWorkerServiceServer.CancelCurrentCycle(ctx, req)
+	}
+	return w.control("cancel")
+}
+
+func (w *fakeWorker) TriggerEvent(ctx context.Context, req *workerv1.TriggerEventRequest) (*workerv1.ControlResponse, error) {
+	if w.triggerEvent == nil {
+		return w.UnimplementedWorkerServiceServer.TriggerEvent(ctx, req)
+	}
+	return w.triggerEvent(req)
+}
+
+func (w *fakeWorker) StreamLogs(req *workerv1.StreamLogsRequest, stream grpc.ServerStreamingServer[workerv1.LogChunk]) error {
+	if w.streamLogs == nil {
+		return w.UnimplementedWorkerServiceServer.StreamLogs(req, stream)
+	}
+	return w.streamLogs(req, stream)
+}
+
+func (w *fakeWorker) StreamMetrics(req *workerv1.StreamMetricsRequest, stream grpc.ServerStreamingServer[workerv1.MetricsUpdate]) error {
+	if w.streamMetrics == nil {
+		return w.UnimplementedWorkerServiceServer.StreamMetrics(req, stream)
+	}
+	return w.streamMetrics(req, stream)
+}
+
+// newTestRegistry creates an empty worker registry that is closed when the test ends
+func newTestRegistry(t *testing.T) *WorkerRegistry {
+	t.Helper()
+	registry, err := NewWorkerRegistry(&config.ControlPlaneConfig{})
+	if err != nil {
+		t.Fatalf("Failed to create registry: %v", err)
+	}
+	t.Cleanup(func() { registry.Close() })
+	return registry
+}
+
+// startFakeWorker serves worker on a local gRPC server and registers it under id
+func startFakeWorker(t *testing.T, registry *WorkerRegistry, id string, worker *fakeWorker) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+
+	server := grpc.NewServer()
+	workerv1.RegisterWorkerServiceServer(server, worker)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	if err := registry.RegisterWorker(id, lis.Addr().String(), ""); err != nil {
+		t.Fatalf("Failed to register worker: %v", err)
+	}
+}
+
+// controlResponse builds a worker control response
+func controlResponse(accepted bool, message string) *workerv1.ControlResponse {
+	return &workerv1.ControlResponse{
+		Accepted:  accepted,
+		Message:   message,
+		Status:    "idle",
+		Timestamp: timestamppb.Now(),
+	}
+}
+
+// serve runs a request against the control plane routes and middleware
+func serve(server *Server, method, path, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	server.echo.ServeHTTP(rec, req)
+	return rec
+}
+
+// readEvents reads Server-Sent Events from body until it is closed
+func readEvents(body io.Reader) <-chan sseEvent {
+	events := make(chan sseEvent)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(body)
+		var event sseEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				event.name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			case line == "":
+				events <- event
+				event = sseEvent{}
+			}
+		}
+	}()
+	return events
+}
+
+// metricsStream sends a metrics update every few milliseconds until the client goes away
+func metricsStream(req *workerv1.StreamMetricsRequest, stream grpc.ServerStreamingServer[workerv1.MetricsUpdate]) error {
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for {
+		update := &workerv1.MetricsUpdate{Metrics: &workerv1.WorkerMetrics{}, Timestamp: timestamppb.Now()}
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestStreamAllMetrics(t *testing.T) {
+	// The flaky worker fails its first stream and works after reconnecting
+	var flakyCalls atomic.Int32
+	flaky := &fakeWorker{
+		streamMetrics: func(req *workerv1.StreamMetricsRequest, stream grpc.ServerStreamingServer[workerv1.MetricsUpdate]) error {
+			if flakyCalls.Add(1) == 1 {
+				return status.Error(codes.Unavailable, "worker is restarting")
+			}
+			return metricsStream(req, stream)
+		},
+	}
+
+	registry := newTestRegistry(t)
+	startFakeWorker(t, registry, "worker-1", &fakeWorker{streamMetrics: metricsStream})
+	server := NewServer(registry, ServerConfig{})
+	server.handlers.retryDelay = 10 * time.Millisecond
+	httpServer := httptest.NewServer(server.echo)
+	t.Cleanup(httpServer.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpServer.URL+"/metrics/stream?interval=1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to open metrics stream: %v", err)
+	}
+	defer resp.Body.Close()
+	if got := resp.Header.Get("Content-Type"); got != "text/event-stream" {
+		t.Fatalf("Expected text/event-stream, got %q", got)
+	}
+	events := readEvents(resp.Body)
+
+	// waitFor reads events until one from workerID with the given name arrives
+	waitFor := func(name, workerID string) {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					t.Fatalf("Stream ended while waiting for %s from %s", name, workerID)
+				}
+				var data struct {
+					WorkerID string `json:"worker_id"`
+					Error    string `json:"error"`
+				}
+				if err := json.Unmarshal([]byte(event.data), &data); err != nil {
+					t.Fatalf("Invalid event data %q: %v", event.data, err)
+				}
+				if event.name == name && data.WorkerID == workerID {
+					if name == "worker_error" && !strings.Contains(data.Error, "worker is restarting") {
+						t.Errorf("Expected worker error to carry the cause, got %q", data.Error)
+					}
+					return
+				}
+			case <-timeout:
+				t.Fatalf("Timed out waiting for %s from %s", name, workerID)
+			}
+		}
+	}
+
+	waitFor("metrics", "worker-1")
+
+	// Workers registered while the stream is open are picked up
+	startFakeWorker(t, registry, "worker-2", &fakeWorker{streamMetrics: metricsStream})
+	waitFor("metrics", "worker-2")
+
+	// A failing worker is reported and streamed again after reconnecting
+	startFakeWorker(t, registry, "worker-3", flaky)
+	waitFor("worker_error", "worker-3")
+	waitFor("metrics", "worker-3")
+	if calls := flakyCalls.Load(); calls < 2 {
+		t.Errorf("Expected the failing worker to be reconnected, got %d calls", calls)
+	}
+
+	registered, _ := registry.GetWorker("worker-3")
+	if registered.Status != types.WorkerStatusReachable {
+		t.Errorf("Expected reconnected worker to be reachable, got %s", registered.Status)
+	}
+}
+
+func TestControlEndpoints(t *testing.T) {
+	var actions []string
+	var workerErr error
+	worker := &fakeWorker{
+		control: func(action string) (*workerv1.ControlResponse, error) {
+			actions = append(actions, action)
+			if workerErr != nil {
+				return nil, workerErr
+			}
+			return controlResponse(action != "resume", action+" done"), nil
+		},
+	}
+
+	registry := newTestRegistry(t)
+	startFakeWorker(t, registry, "worker-1", worker)
+	server := NewServer(registry, ServerConfig{})
+
+	tests := []struct {
+		name     string
+		path     string
+		action   string
+		accepted bool
+	}{
+		{name: "trigger", path: "/workers/worker-1/flow/trigger", action: "trigger", accepted: true},
+		{name: "pause", path: "/workers/worker-1/pause", action: "pause", accepted: true},
+		{name: "resume_not_accepted", path: "/workers/worker-1/resume", action: "resume", accepted: false},
+		{name: "cancel", path: "/workers/worker-1/flow/cancel", action: "cancel", accepted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions = nil
+			rec := serve(server, http.MethodPost, tt.path, "", nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			if len(actions) != 1 || actions[0] != tt.action {
+				t.Errorf("Expected worker action %q, got %v", tt.action, actions)
+			}
+
+			var response types.ControlResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Invalid response %q: %v", rec.Body.String(), err)
+			}
+			if response.Accepted != tt.accepted || response.Message != tt.action+" done" || response.Status != "idle" || response.Timestamp.IsZero() {
+				t.Errorf("Unexpected response: %+v", response)
+			}
+		})
+	}
+
+	t.Run("worker_not_found", func(t *testing.T) {
+		rec := serve(server, http.MethodPost, "/workers/worker-2/pause", "", nil)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d: %s", rec.Code, rec.Body.String())
+		}
+	})
+
+	errorTests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedWorker string
+	}{
+		{name: "not_found", err: status.Error(codes.NotFound, "step not found"), expectedStatus: http.StatusNotFound, expectedWorker: types.WorkerStatusReachable},
+		{name: "invalid_argument", err: status.Error(codes.InvalidArgument, "bad request"), expectedStatus: http.StatusBadRequest, expectedWorker: types.WorkerStatusReachable},
+		{name: "resource_exhausted", err: status.Error(codes.ResourceExhausted, "event queue is full"), expectedStatus: http.StatusTooManyRequests, expectedWorker: types.WorkerStatusReachable},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "worker is shutting down"), expectedStatus: http.StatusBadGateway, expectedWorker: types.WorkerStatusUnreachable},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A successful call marks the worker reachable again
+			workerErr = nil
+			serve(server, http.MethodPost, "/workers/worker-1/pause", "", nil)
+
+			workerErr = tt.err
+			defer func() { workerErr = nil }()
+
+			rec := serve(server, http.MethodPost, "/workers/worker-1/pause", "", nil)
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.expectedStatus != http.StatusBadGateway && !strings.Contains(rec.Body.String(), status.Convert(tt.err).Message()) {
+				t.Errorf("Expected the worker's message in %s", rec.Body.String())
+			}
+
+			registered, _ := registry.GetWorker("worker-1")
+			if registered.Status != tt.expectedWorker {
+				t.Errorf("Expected worker status %s, got %s", tt.expectedWorker, registered.Status)
+			}
+		})
+	}
+}
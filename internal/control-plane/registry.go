@@ -23,6 +23,7 @@ import (
 // WorkerRegistry manages worker endpoints and their clients
 type WorkerRegistry struct {
 	workers map[string]*RegisteredWorker
+	changed chan struct{} // Closed and replaced whenever a worker is registered
 	mu      sync.RWMutex
 }
 
@@ -42,6 +43,7 @@ type RegisteredWorker struct {
 func NewWorkerRegistry(config *config.ControlPlaneConfig) (*WorkerRegistry, error) {
 	registry := &WorkerRegistry{
 		workers: make(map[string]*RegisteredWorker),
+		changed: make(chan struct{}),
 	}
 
 	// Register workers from direct API URLs
@@ -106,9 +108,21 @@ func (r *WorkerRegistry) RegisterWorker(id, url, apiKey string) error {
 		Status: types.WorkerStatusUnknown,
 	}
 
+	// Wake up everyone waiting for registrations, e.g. fanned-in metrics streams
+	close(r.changed)
+	r.changed = make(chan struct{})
+
 	return nil
 }
 
+// Changes returns a channel that is closed the next time a worker is registered. Callers
+// re-read the workers with GetAllWorkers and call Changes again to wait for the next change.
+func (r *WorkerRegistry) Changes() <-chan struct{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.changed
+}
+
 // Close gracefully closes all worker connections
 func (r *WorkerRegistry) Close() error {
 	r.mu.Lock()
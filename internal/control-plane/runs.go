@@ -0,0 +1,151 @@
+package controlplane
+
+import (
+	"fmt"
+	"net/http"
+
+	controlplaneapi "autoteam/api/control-plane"
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/logger"
+	"autoteam/internal/types"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// GetWorkerRuns lists a worker's recorded flow runs
+func (h *Handlers) GetWorkerRuns(ctx echo.Context, workerID string, params controlplaneapi.GetWorkerRunsParams) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	// Get worker from registry
+	worker, err := h.registry.GetWorker(workerID)
+	if err != nil {
+		log.Warn("Worker not found", zap.String("worker_id", workerID))
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Worker not found: %s", workerID))
+	}
+
+	// Create context with authentication
+	grpcCtx := h.registry.createContext(ctx.Request().Context(), worker.APIKey)
+
+	// Convert control plane params to gRPC request
+	req := &workerv1.ListRunsRequest{}
+	if params.Limit != nil {
+		limitInt32 := int32(*params.Limit)
+		req.Limit = &limitInt32
+	}
+	if params.Offset != nil {
+		offsetInt32 := int32(*params.Offset)
+		req.Offset = &offsetInt32
+	}
+
+	// Make gRPC call
+	resp, err := worker.Client.ListRuns(grpcCtx, req)
+	if err != nil {
+		log.Error("Failed to get worker runs",
+			zap.String("worker_id", workerID),
+			zap.String("worker_url", worker.URL),
+			zap.Error(err))
+		return h.workerErrorToHTTP(workerID, err)
+	}
+
+	// Update worker status as reachable
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+
+	response := types.RunsResponse{
+		Runs:      make([]types.RunSummary, 0, len(resp.Runs)),
+		Total:     int(resp.Total),
+		Timestamp: resp.Timestamp.AsTime(),
+	}
+	for _, run := range resp.Runs {
+		response.Runs = append(response.Runs, types.RunSummary{
+			ID:        run.Id,
+			Start:     run.Start.AsTime(),
+			End:       run.End.AsTime(),
+			Duration:  run.Duration,
+			Status:    run.Status,
+			Error:     run.Error,
+			StepCount: int(run.StepCount),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetWorkerRun returns a single recorded flow run with step details
+func (h *Handlers) GetWorkerRun(ctx echo.Context, workerID string, runID string) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	// Get worker from registry
+	worker, err := h.registry.GetWorker(workerID)
+	if err != nil {
+		log.Warn("Worker not found", zap.String("worker_id", workerID))
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Worker not found: %s", workerID))
+	}
+
+	// Create context with authentication
+	grpcCtx := h.registry.createContext(ctx.Request().Context(), worker.APIKey)
+
+	// Make gRPC call
+	resp, err := worker.Client.GetRun(grpcCtx, &workerv1.GetRunRequest{RunId: runID})
+	if err != nil {
+		log.Error("Failed to get worker run",
+			zap.String("worker_id", workerID),
+			zap.String("run_id", runID),
+			zap.String("worker_url", worker.URL),
+			zap.Error(err))
+		return h.workerErrorToHTTP(workerID, err)
+	}
+
+	// Update worker status as reachable
+	h.registry.updateWorkerStatus(workerID, types.WorkerStatusReachable, nil)
+
+	run := resp.Run
+	record := types.RunRecord{
+		ID:       run.Id,
+		Start:    run.Start.AsTime(),
+		End:      run.End.AsTime(),
+		Duration: run.Duration,
+		Status:   run.Status,
+		Error:    run.Error,
+		Steps:    make([]types.StepRecord, 0, len(run.Steps)),
+	}
+	for _, step := range run.Steps {
+		record.Steps = append(record.Steps, toStepRecord(step))
+	}
+
+	return ctx.JSON(http.StatusOK, types.RunResponse{Run: record, Timestamp: resp.Timestamp.AsTime()})
+}
+
+// toStepRecord converts a step of a run received from a worker
+func toStepRecord(step *workerv1.StepRecord) types.StepRecord {
+	record := types.StepRecord{
+		Name:       step.Name,
+		Status:     step.Status,
+		Prompt:     step.Prompt,
+		Stdout:     step.Stdout,
+		Stderr:     step.Stderr,
+		Attempts:   int(step.Attempts),
+		SkipReason: step.SkipReason,
+		Duration:   step.Duration,
+	}
+	for _, iteration := range step.Iterations {
+		iterationRecord := types.IterationRecord{
+			Iteration: int(iteration.Iteration),
+			Prompt:    iteration.Prompt,
+			Stdout:    iteration.Stdout,
+			Stderr:    iteration.Stderr,
+			Error:     iteration.Error,
+			Attempts:  int(iteration.Attempts),
+			Duration:  iteration.Duration,
+		}
+		if iteration.ExitCode != nil {
+			exitCode := int(*iteration.ExitCode)
+			iterationRecord.ExitCode = &exitCode
+		}
+		for _, sub := range iteration.Steps {
+			iterationRecord.Steps = append(iterationRecord.Steps, toStepRecord(sub))
+		}
+		record.Iterations = append(record.Iterations, iterationRecord)
+	}
+	return record
+}
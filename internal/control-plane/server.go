@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	controlplaneapi "autoteam/api/control-plane"
@@ -22,6 +23,7 @@ type Server struct {
 	registry  *WorkerRegistry
 	port      int
 	apiKey    string
+	webhooks  bool // Webhooks are authenticated by signature instead of API key
 	startTime time.Time
 	server    *http.Server
 	handlers  *Handlers
@@ -29,8 +31,9 @@ type Server struct {
 
 // ServerConfig contains server configuration
 type ServerConfig struct {
-	Port   int
-	APIKey string
+	Port          int
+	APIKey        string
+	WebhookSecret string // HMAC secret for /webhooks signatures (optional)
 }
 
 // NewServer creates a new HTTP API server for the control plane
@@ -43,11 +46,13 @@ func NewServer(registry *WorkerRegistry, config ServerConfig) *Server {
 		registry:  registry,
 		port:      config.Port,
 		apiKey:    config.APIKey,
+		webhooks:  config.WebhookSecret != "",
 		startTime: time.Now(),
 	}
 
 	// Create handlers
 	server.handlers = NewHandlers(registry)
+	server.handlers.webhookSecret = config.WebhookSecret
 
 	// Setup middleware
 	server.setupMiddleware()
@@ -63,7 +68,7 @@ func (s *Server) setupMiddleware() {
 	// CORS middleware
 	s.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodOptions},
+		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodOptions},
 		AllowHeaders: []string{"*"},
 	}))
 
@@ -87,6 +92,11 @@ func (s *Server) setupMiddleware() {
 // apiKeyMiddleware validates API key if configured
 func (s *Server) apiKeyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
+		// Signed webhooks are verified by their handler
+		if s.webhooks && strings.HasPrefix(c.Request().URL.Path, webhooksPath) {
+			return next(c)
+		}
+
 		apiKey := c.Request().Header.Get("X-API-Key")
 		if apiKey == "" || apiKey != s.apiKey {
 			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or missing API key")
@@ -200,6 +210,10 @@ func (a *APIAdapter) GetWorkerLogFile(ctx echo.Context, workerID string, filenam
 	return a.handlers.GetWorkerLogFile(ctx, workerID, filename, params)
 }
 
+func (a *APIAdapter) StreamWorkerLogFile(ctx echo.Context, workerID string, filename string, params controlplaneapi.StreamWorkerLogFileParams) error {
+	return a.handlers.StreamWorkerLogFile(ctx, workerID, filename, params)
+}
+
 func (a *APIAdapter) GetWorkerFlow(ctx echo.Context, workerID string) error {
 	return a.handlers.GetWorkerFlow(ctx, workerID)
 }
@@ -208,10 +222,50 @@ func (a *APIAdapter) GetWorkerFlowSteps(ctx echo.Context, workerID string) error
 	return a.handlers.GetWorkerFlowSteps(ctx, workerID)
 }
 
+func (a *APIAdapter) SetWorkerStepEnabled(ctx echo.Context, workerID string, stepName string) error {
+	return a.handlers.SetWorkerStepEnabled(ctx, workerID, stepName)
+}
+
+func (a *APIAdapter) GetWorkerRuns(ctx echo.Context, workerID string, params controlplaneapi.GetWorkerRunsParams) error {
+	return a.handlers.GetWorkerRuns(ctx, workerID, params)
+}
+
+func (a *APIAdapter) GetWorkerRun(ctx echo.Context, workerID string, runID string) error {
+	return a.handlers.GetWorkerRun(ctx, workerID, runID)
+}
+
 func (a *APIAdapter) GetWorkerMetrics(ctx echo.Context, workerID string) error {
 	return a.handlers.GetWorkerMetrics(ctx, workerID)
 }
 
+func (a *APIAdapter) StreamWorkerMetrics(ctx echo.Context, workerID string, params controlplaneapi.StreamWorkerMetricsParams) error {
+	return a.handlers.StreamWorkerMetrics(ctx, workerID, params)
+}
+
+func (a *APIAdapter) StreamAllMetrics(ctx echo.Context, params controlplaneapi.StreamAllMetricsParams) error {
+	return a.handlers.StreamAllMetrics(ctx, params)
+}
+
+func (a *APIAdapter) TriggerWorkerFlow(ctx echo.Context, workerID string) error {
+	return a.handlers.TriggerWorkerFlow(ctx, workerID)
+}
+
+func (a *APIAdapter) TriggerWorkerWebhook(ctx echo.Context, workerID string, params controlplaneapi.TriggerWorkerWebhookParams) error {
+	return a.handlers.TriggerWorkerWebhook(ctx, workerID, params)
+}
+
+func (a *APIAdapter) CancelWorkerCycle(ctx echo.Context, workerID string) error {
+	return a.handlers.CancelWorkerCycle(ctx, workerID)
+}
+
+func (a *APIAdapter) PauseWorker(ctx echo.Context, workerID string) error {
+	return a.handlers.PauseWorker(ctx, workerID)
+}
+
+func (a *APIAdapter) ResumeWorker(ctx echo.Context, workerID string) error {
+	return a.handlers.ResumeWorker(ctx, workerID)
+}
+
 func (a *APIAdapter) GetOpenAPISpec(ctx echo.Context) error {
 	return a.handlers.GetOpenAPISpec(ctx)
 }
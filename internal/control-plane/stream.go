@@ -0,0 +1,89 @@
+package controlplane
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+)
+
+// metricsRetryDelay is how long a fanned-in metrics stream waits before reconnecting to a worker
+const metricsRetryDelay = 5 * time.Second
+
+// streamEvent is a named Server-Sent Event waiting to be written
+type streamEvent struct {
+	name string
+	data interface{}
+}
+
+// workerMetricsEvent is the payload of a `metrics` event
+type workerMetricsEvent struct {
+	WorkerID  string                  `json:"worker_id"`
+	Metrics   *workerv1.WorkerMetrics `json:"metrics"`
+	Timestamp time.Time               `json:"timestamp"`
+}
+
+// workerErrorEvent is the payload of a `worker_error` event
+type workerErrorEvent struct {
+	WorkerID  string    `json:"worker_id"`
+	Error     string    `json:"error"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// startEventStream writes Server-Sent Events headers and disables the server write timeout
+// for the lifetime of the request
+func startEventStream(ctx echo.Context) error {
+	// Long-lived streams must not be cut off by the server's WriteTimeout
+	if err := http.NewResponseController(ctx.Response().Writer).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set(echo.HeaderCacheControl, "no-cache")
+	header.Set(echo.HeaderConnection, "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+
+	ctx.Response().WriteHeader(http.StatusOK)
+	ctx.Response().Flush()
+	return nil
+}
+
+// writeEvent writes a single JSON-encoded Server-Sent Event and flushes it to the client
+func writeEvent(ctx echo.Context, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(ctx.Response(), "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+
+	ctx.Response().Flush()
+	return nil
+}
+
+// streamHeaderReceiver is implemented by gRPC client streams
+type streamHeaderReceiver interface {
+	Header() (metadata.MD, error)
+}
+
+// awaitStream waits until a worker has accepted a server stream. Workers send headers once
+// the stream is established, so a missing header means the call failed with a status error.
+func awaitStream(stream streamHeaderReceiver, recv func() error) error {
+	md, err := stream.Header()
+	if err != nil {
+		return err
+	}
+	if md == nil {
+		return recv()
+	}
+	return nil
+}
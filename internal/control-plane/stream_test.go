@@ -0,0 +1,186 @@
+package controlplane
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/types"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sseEvent is a Server-Sent Event read back from a response body
+type sseEvent struct {
+	name string
+	data string
+}
+
+// parseEvents splits a Server-Sent Events body into events, failing on malformed frames
+func parseEvents(t *testing.T, body string) []sseEvent {
+	t.Helper()
+	var events []sseEvent
+	for _, frame := range strings.Split(body, "\n\n") {
+		if frame == "" {
+			continue
+		}
+		lines := strings.Split(frame, "\n")
+		if len(lines) != 2 || !strings.HasPrefix(lines[0], "event: ") || !strings.HasPrefix(lines[1], "data: ") {
+			t.Fatalf("Malformed event frame: %q", frame)
+		}
+		events = append(events, sseEvent{
+			name: strings.TrimPrefix(lines[0], "event: "),
+			data: strings.TrimPrefix(lines[1], "data: "),
+		})
+	}
+	if !strings.HasSuffix(body, "\n\n") && body != "" {
+		t.Fatalf("Expected body to end with a complete frame: %q", body)
+	}
+	return events
+}
+
+func TestStreamWorkerLogFile(t *testing.T) {
+	var received *workerv1.StreamLogsRequest
+	worker := &fakeWorker{
+		streamLogs: func(req *workerv1.StreamLogsRequest, stream grpc.ServerStreamingServer[workerv1.LogChunk]) error {
+			received = req
+			switch req.Filename {
+			case "missing.log":
+				return status.Error(codes.NotFound, "log file not found: missing.log")
+			case "../secret":
+				return status.Error(codes.InvalidArgument, "invalid filename")
+			case "broken.log":
+				// Failures after the stream was accepted just end it
+				if err := stream.SendHeader(metadata.MD{}); err != nil {
+					return err
+				}
+				if err := stream.Send(&workerv1.LogChunk{Content: "partial\n", Timestamp: timestamppb.Now()}); err != nil {
+					return err
+				}
+				return status.Error(codes.Internal, "failed to follow log file")
+			}
+
+			if err := stream.SendHeader(metadata.MD{}); err != nil {
+				return err
+			}
+			for _, line := range []string{"first line\n", "second line\n"} {
+				if err := stream.Send(&workerv1.LogChunk{Content: line, Timestamp: timestamppb.Now()}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	registry := newTestRegistry(t)
+	startFakeWorker(t, registry, "worker-1", worker)
+	server := NewServer(registry, ServerConfig{})
+
+	t.Run("events", func(t *testing.T) {
+		rec := serve(server, http.MethodGet, "/workers/worker-1/logs/app.log/stream?tail=5", "", nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if got := rec.Header().Get("Content-Type"); got != "text/event-stream" {
+			t.Errorf("Expected text/event-stream, got %q", got)
+		}
+		if received.Filename != "app.log" || received.GetTail() != 5 {
+			t.Errorf("Unexpected worker request: %+v", received)
+		}
+
+		events := parseEvents(t, rec.Body.String())
+		if len(events) != 2 {
+			t.Fatalf("Expected 2 events, got %d: %q", len(events), rec.Body.String())
+		}
+		for i, want := range []string{"first line\n", "second line\n"} {
+			var chunk struct {
+				Content   string          `json:"content"`
+				Timestamp json.RawMessage `json:"timestamp"`
+			}
+			if events[i].name != "log" {
+				t.Errorf("Expected log event, got %q", events[i].name)
+			}
+			if err := json.Unmarshal([]byte(events[i].data), &chunk); err != nil {
+				t.Fatalf("Invalid event data %q: %v", events[i].data, err)
+			}
+			if chunk.Content != want || chunk.Timestamp == nil {
+				t.Errorf("Unexpected chunk %d: %s", i, events[i].data)
+			}
+		}
+
+		registered, _ := registry.GetWorker("worker-1")
+		if registered.Status != types.WorkerStatusReachable {
+			t.Errorf("Expected worker to be reachable, got %s", registered.Status)
+		}
+	})
+
+	t.Run("failure_after_accept", func(t *testing.T) {
+		rec := serve(server, http.MethodGet, "/workers/worker-1/logs/broken.log/stream", "", nil)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		events := parseEvents(t, rec.Body.String())
+		if len(events) != 1 || !strings.Contains(events[0].data, "partial") {
+			t.Errorf("Expected the partial chunk before the stream ended, got %q", rec.Body.String())
+		}
+	})
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+	}{
+		{name: "log_file_not_found", path: "/workers/worker-1/logs/missing.log/stream", expectedStatus: http.StatusNotFound},
+		{name: "invalid_filename", path: "/workers/worker-1/logs/..%2Fsecret/stream", expectedStatus: http.StatusBadRequest},
+		{name: "worker_not_found", path: "/workers/worker-2/logs/app.log/stream", expectedStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(server, http.MethodGet, tt.path, "", nil)
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if strings.HasPrefix(rec.Header().Get("Content-Type"), "text/event-stream") {
+				t.Error("Expected an error response instead of an event stream")
+			}
+		})
+	}
+
+	registered, _ := registry.GetWorker("worker-1")
+	if registered.Status != types.WorkerStatusReachable {
+		t.Errorf("Expected request errors to leave the worker reachable, got %s", registered.Status)
+	}
+}
+
+func TestStreamWorkerLogFile_Unavailable(t *testing.T) {
+	// Nothing listens on the worker address once the listener is closed
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	registry := newTestRegistry(t)
+	if err := registry.RegisterWorker("worker-1", addr, ""); err != nil {
+		t.Fatalf("Failed to register worker: %v", err)
+	}
+	server := NewServer(registry, ServerConfig{})
+
+	rec := serve(server, http.MethodGet, "/workers/worker-1/logs/app.log/stream", "", nil)
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("Expected status 502, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	registered, _ := registry.GetWorker("worker-1")
+	if registered.Status != types.WorkerStatusUnreachable {
+		t.Errorf("Expected worker to be unreachable, got %s", registered.Status)
+	}
+}
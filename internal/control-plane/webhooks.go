@@ -0,0 +1,84 @@
+package controlplane
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	controlplaneapi "autoteam/api/control-plane"
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/logger"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+const (
+	// webhooksPath prefixes the webhook routes, which skip API key authentication when signed
+	webhooksPath = "/webhooks/"
+
+	// maxWebhookBodySize bounds webhook payloads (GitHub caps deliveries at 25MB)
+	maxWebhookBodySize = 25 << 20
+
+	// signaturePrefix is the algorithm prefix of X-Hub-Signature-256 values
+	signaturePrefix = "sha256="
+)
+
+// TriggerWorkerWebhook forwards a webhook payload to a worker as a flow event
+func (h *Handlers) TriggerWorkerWebhook(ctx echo.Context, workerID string, params controlplaneapi.TriggerWorkerWebhookParams) error {
+	log := logger.FromContext(ctx.Request().Context())
+
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxWebhookBodySize+1))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Failed to read request body")
+	}
+	if len(body) > maxWebhookBodySize {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "Webhook payload too large")
+	}
+
+	if h.webhookSecret != "" {
+		signature := ""
+		if params.XHubSignature256 != nil {
+			signature = *params.XHubSignature256
+		}
+		if !verifySignature(h.webhookSecret, body, signature) {
+			log.Warn("Rejected webhook with invalid signature", zap.String("worker_id", workerID))
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or missing X-Hub-Signature-256")
+		}
+	}
+
+	if !json.Valid(body) {
+		return echo.NewHTTPError(http.StatusBadRequest, "Request body must be valid JSON")
+	}
+
+	// GitHub names the event in a header, other senders fall back to a generic source
+	source := ctx.Request().Header.Get("X-GitHub-Event")
+	if source == "" {
+		source = "webhook"
+	}
+
+	req := &workerv1.TriggerEventRequest{Payload: body, Source: &source}
+	return h.forwardControl(ctx, workerID, "webhook", func(client workerv1.WorkerServiceClient, grpcCtx context.Context) (*workerv1.ControlResponse, error) {
+		return client.TriggerEvent(grpcCtx, req)
+	})
+}
+
+// verifySignature checks a GitHub-style "sha256=<hex>" HMAC of body
+func verifySignature(secret string, body []byte, signature string) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
@@ -0,0 +1,196 @@
+package controlplane
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sign returns the X-Hub-Signature-256 value of body
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestTriggerWorkerWebhook(t *testing.T) {
+	const secret = "webhook-secret"
+	const body = `{"action":"opened"}`
+
+	var received []*workerv1.TriggerEventRequest
+	worker := &fakeWorker{
+		triggerEvent: func(req *workerv1.TriggerEventRequest) (*workerv1.ControlResponse, error) {
+			if strings.Contains(string(req.Payload), "flood") {
+				return nil, status.Error(codes.ResourceExhausted, "event queue is full, try again later")
+			}
+			received = append(received, req)
+			return controlResponse(true, "flow cycle triggered by event"), nil
+		},
+	}
+
+	registry := newTestRegistry(t)
+	startFakeWorker(t, registry, "worker-1", worker)
+	server := NewServer(registry, ServerConfig{WebhookSecret: secret})
+
+	largeBody := `{"data":"` + strings.Repeat("a", maxWebhookBodySize) + `"}`
+	tests := []struct {
+		name           string
+		workerID       string
+		body           string
+		signature      string
+		expectedStatus int
+	}{
+		{name: "valid_signature", workerID: "worker-1", body: body, signature: sign(secret, body), expectedStatus: http.StatusOK},
+		{name: "missing_signature", workerID: "worker-1", body: body, expectedStatus: http.StatusUnauthorized},
+		{name: "bad_prefix", workerID: "worker-1", body: body, signature: strings.Replace(sign(secret, body), "sha256=", "sha1=", 1), expectedStatus: http.StatusUnauthorized},
+		{name: "non_hex_signature", workerID: "worker-1", body: body, signature: "sha256=not-hex", expectedStatus: http.StatusUnauthorized},
+		{name: "wrong_hmac", workerID: "worker-1", body: body, signature: sign("other-secret", body), expectedStatus: http.StatusUnauthorized},
+		{name: "body_too_large", workerID: "worker-1", body: largeBody, signature: sign(secret, largeBody), expectedStatus: http.StatusRequestEntityTooLarge},
+		{name: "invalid_json", workerID: "worker-1", body: "not json", signature: sign(secret, "not json"), expectedStatus: http.StatusBadRequest},
+		{name: "queue_full", workerID: "worker-1", body: `{"flood":true}`, signature: sign(secret, `{"flood":true}`), expectedStatus: http.StatusTooManyRequests},
+		{name: "unknown_worker", workerID: "worker-2", body: body, signature: sign(secret, body), expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			header := http.Header{
+				"Content-Type":   {"application/json"},
+				"X-Github-Event": {"issues"},
+			}
+			if tt.signature != "" {
+				header.Set("X-Hub-Signature-256", tt.signature)
+			}
+
+			rec := serve(server, http.MethodPost, "/webhooks/"+tt.workerID, tt.body, header)
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				if len(received) != 0 {
+					t.Errorf("Expected no event to reach the worker, got %d", len(received))
+				}
+				return
+			}
+			if len(received) != 1 {
+				t.Fatalf("Expected one event to reach the worker, got %d", len(received))
+			}
+			if string(received[0].Payload) != tt.body || received[0].GetSource() != "issues" {
+				t.Errorf("Unexpected event: payload=%s source=%s", received[0].Payload, received[0].GetSource())
+			}
+		})
+	}
+
+	// Refusing a delivery is the worker's choice, not a sign that it is gone
+	registered, err := registry.GetWorker("worker-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registered.Status == types.WorkerStatusUnreachable {
+		t.Error("Expected a full event queue to leave the worker reachable")
+	}
+}
+
+func TestServer_WebhookAPIKey(t *testing.T) {
+	const apiKey = "api-key"
+	const secret = "webhook-secret"
+	const body = `{"action":"opened"}`
+
+	worker := &fakeWorker{
+		triggerEvent: func(req *workerv1.TriggerEventRequest) (*workerv1.ControlResponse, error) {
+			return controlResponse(true, "flow cycle triggered by event"), nil
+		},
+	}
+
+	registry := newTestRegistry(t)
+	startFakeWorker(t, registry, "worker-1", worker)
+
+	tests := []struct {
+		name           string
+		webhookSecret  string
+		method         string
+		path           string
+		header         http.Header
+		expectedStatus int
+	}{
+		{
+			name:           "signed_webhook_without_api_key",
+			webhookSecret:  secret,
+			method:         http.MethodPost,
+			path:           "/webhooks/worker-1",
+			header:         http.Header{"X-Hub-Signature-256": {sign(secret, body)}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unsigned_webhook_without_api_key",
+			webhookSecret:  secret,
+			method:         http.MethodPost,
+			path:           "/webhooks/worker-1",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "other_endpoints_still_need_api_key",
+			webhookSecret:  secret,
+			method:         http.MethodGet,
+			path:           "/workers",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "webhook_needs_api_key_without_secret",
+			method:         http.MethodPost,
+			path:           "/webhooks/worker-1",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "webhook_with_api_key_without_secret",
+			method:         http.MethodPost,
+			path:           "/webhooks/worker-1",
+			header:         http.Header{"X-Api-Key": {apiKey}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(registry, ServerConfig{APIKey: apiKey, WebhookSecret: tt.webhookSecret})
+
+			header := http.Header{"Content-Type": {"application/json"}}
+			for name, values := range tt.header {
+				header[name] = values
+			}
+
+			rec := serve(server, tt.method, tt.path, body, header)
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"zen":"Keep it logically awesome."}`)
+	signature := sign("secret", string(body))
+
+	if !verifySignature("secret", body, signature) {
+		t.Error("Expected valid signature to verify")
+	}
+	if verifySignature("secret", body, strings.ToUpper(signature)) {
+		t.Error("Expected the algorithm prefix to be case sensitive")
+	}
+	if verifySignature("secret", append(body, ' '), signature) {
+		t.Error("Expected a modified body to fail verification")
+	}
+	if verifySignature("secret", body, "") {
+		t.Error("Expected a missing signature to fail verification")
+	}
+}
@@ -0,0 +1,25 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// eventKey is the context key of the event that triggered a flow cycle
+type eventKey struct{}
+
+// WithEvent attaches the JSON payload of the event that triggered a flow cycle.
+// Templates see the decoded payload as .event.
+func WithEvent(ctx context.Context, payload json.RawMessage) (context.Context, error) {
+	var event interface{}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return ctx, fmt.Errorf("invalid event payload: %w", err)
+	}
+	return context.WithValue(ctx, eventKey{}, event), nil
+}
+
+// eventFromContext returns the decoded event payload, or nil for cycles not triggered by an event
+func eventFromContext(ctx context.Context) interface{} {
+	return ctx.Value(eventKey{})
+}
@@ -2,30 +2,48 @@ package flow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
-	"sync"
+	"sync/atomic"
 	"text/template"
 	"time"
 
 	"autoteam/internal/agent"
+	"autoteam/internal/history"
 	"autoteam/internal/logger"
 	"autoteam/internal/worker"
 
-	"github.com/Masterminds/sprig/v3"
 	"go.uber.org/zap"
 )
 
+var (
+	// ErrStepTimedOut is returned when a step attempt exceeds the step timeout
+	ErrStepTimedOut = errors.New("step timed out")
+
+	// ErrFlowTimedOut is returned when a flow execution exceeds the flow timeout
+	ErrFlowTimedOut = errors.New("flow timed out")
+)
+
 // FlowExecutor executes dynamic flows with dependency resolution
 type FlowExecutor struct {
-	Steps         []worker.FlowStep
-	Agents        map[string]agent.Agent
-	MCPServers    map[string]worker.MCPServer
-	WorkingDir    string
-	Worker        *worker.Worker        // Worker configuration for template context
-	WorkerRuntime *worker.WorkerRuntime // Runtime for step tracking (optional)
+	Steps           []worker.FlowStep
+	Agents          map[string]agent.Agent
+	MCPServers      map[string]worker.MCPServer
+	WorkingDir      string
+	Worker          *worker.Worker        // Worker configuration for template context
+	WorkerRuntime   *worker.WorkerRuntime // Runtime for step tracking (optional)
+	History         *history.Store        // Run history persistence (optional)
+	FlowTimeout     time.Duration         // Deadline for a whole Execute call (0 = no limit)
+	MaxParallel     int                   // Maximum number of steps running at once (0 = no limit)
+	FailFast        bool                  // Stop the flow when a step without dependents fails
+	StrictTemplates bool                  // Fail steps whose input, output or skip_when template fails
+
+	outputCache *outputCache // Last outputs of steps with every/cron (optional)
+	cycles      atomic.Int64 // Number of Execute calls, exposed to templates as .run.cycle
 }
 
 // StepOutput represents the output of a flow step
@@ -36,13 +54,31 @@ type StepOutput struct {
 	Skipped  bool // Indicates if the step was skipped due to skip_when condition
 	Failed   bool // Indicates if the step failed after all retries
 	Canceled bool // Indicates if the step was canceled due to fail_fast policy
+	Reused   bool // Indicates if the output was reused from the last run because the step was not due
+	TimedOut bool // Indicates if the last attempt was killed because it exceeded the step timeout (also Failed)
+	NotTaken bool // Indicates if the step is on a branch its router did not select (also Skipped)
+
+	Prompt     string              // Rendered prompt sent to the agent
+	Attempts   int                 // Number of agent runs, including retries
+	SkipReason string              // Why the step was skipped
+	Duration   time.Duration       // Time spent executing the step
+	Routes     []string            // Routes selected by a router step
+	ExitCode   int                 // Exit code of a shell step
+	Response   *agent.HTTPResponse // Response of an http step
+	Parsed     interface{}         // Decoded JSON output of a step with an output_schema
+
+	Iterations []history.IterationRecord // Iterations of a loop step
 }
 
 // FlowResult represents the result of executing a flow
 type FlowResult struct {
-	Steps   []StepOutput
-	Success bool
-	Error   error
+	RunID    string
+	Start    time.Time
+	End      time.Time
+	Steps    []StepOutput
+	Success  bool
+	TimedOut bool // Indicates if the flow was stopped because it exceeded the flow timeout
+	Error    error
 }
 
 // New creates a new FlowExecutor with the given steps and worker configuration
@@ -53,16 +89,53 @@ func New(steps []worker.FlowStep, mcpServers map[string]worker.MCPServer, workin
 		MCPServers: mcpServers,
 		WorkingDir: workingDir,
 		Worker:     worker,
+		History:    history.NewStore(workingDir, history.Retention{MaxRuns: history.DefaultMaxRuns}),
+		FailFast:   true,
+
+		outputCache: newOutputCache(workingDir),
 	}
 }
 
-// SetWorkerRuntime sets the worker runtime for step tracking
+// SetWorkerRuntime sets the worker runtime for step tracking and records runs in its history
 func (fe *FlowExecutor) SetWorkerRuntime(workerRuntime *worker.WorkerRuntime) {
 	fe.WorkerRuntime = workerRuntime
+	fe.History = workerRuntime.GetRunHistory()
 }
 
-// Execute runs the flow with dependency resolution and parallel execution
+// Execute runs the flow with dependency resolution and parallel execution and
+// records the run in the history
 func (fe *FlowExecutor) Execute(ctx context.Context) (*FlowResult, error) {
+	start := time.Now()
+	runID := history.NewRunID(start)
+
+	execCtx := withRunInfo(ctx, runInfo{ID: runID, Start: start, Cycle: fe.cycles.Add(1)})
+	if fe.FlowTimeout > 0 {
+		var cancel context.CancelFunc
+		execCtx, cancel = context.WithTimeoutCause(execCtx, fe.FlowTimeout, ErrFlowTimedOut)
+		defer cancel()
+	}
+
+	result, err := fe.execute(execCtx)
+	end := time.Now()
+
+	if err != nil && flowTimedOut(execCtx) {
+		logger.FromContext(ctx).Error("Flow execution timed out", zap.Duration("flow_timeout", fe.FlowTimeout))
+		err = fmt.Errorf("%w after %s", ErrFlowTimedOut, fe.FlowTimeout)
+		result = fe.timedOutResult(result, err)
+	}
+
+	if result != nil {
+		result.RunID = runID
+		result.Start = start
+		result.End = end
+	}
+
+	fe.recordRun(ctx, runID, start, end, result, err)
+	return result, err
+}
+
+// execute validates the flow and runs its steps as a dependency graph
+func (fe *FlowExecutor) execute(ctx context.Context) (*FlowResult, error) {
 	lgr := logger.FromContext(ctx)
 	lgr.Debug("Starting flow execution", zap.Int("total_steps", len(fe.Steps)))
 
@@ -71,7 +144,7 @@ func (fe *FlowExecutor) Execute(ctx context.Context) (*FlowResult, error) {
 		return nil, fmt.Errorf("flow validation failed: %w", err)
 	}
 
-	// Resolve dependencies and create execution levels for parallel execution
+	// Resolve dependency levels for logging; validation has already rejected cycles
 	dependencyLevels, err := fe.resolveDependencyLevels()
 	if err != nil {
 		return nil, fmt.Errorf("dependency resolution failed: %w", err)
@@ -86,279 +159,309 @@ func (fe *FlowExecutor) Execute(ctx context.Context) (*FlowResult, error) {
 		return nil, fmt.Errorf("agent creation failed: %w", err)
 	}
 
-	// Execute steps level by level with parallel execution within each level
-	stepOutputs := make(map[string]StepOutput)
-	stepOutputsMutex := sync.RWMutex{}
-	var allStepOutputs []StepOutput
-
-	for levelIndex, level := range dependencyLevels {
-		lgr.Debug("Processing execution level",
-			zap.Int("level", levelIndex+1),
-			zap.Int("total_levels", len(dependencyLevels)),
-			zap.Strings("steps", level))
-
-		// Execute all steps in this level in parallel
-		levelOutputs, err := fe.executeLevel(ctx, level, stepOutputs, &stepOutputsMutex)
-		if err != nil {
-			// Add partial results and return error
-			allStepOutputs = append(allStepOutputs, levelOutputs...)
-			return &FlowResult{Steps: allStepOutputs, Success: false, Error: err}, err
-		}
+	allStepOutputs, err := fe.executeGraph(ctx)
+	if err != nil {
+		return &FlowResult{Steps: allStepOutputs, Success: false, Error: err}, err
+	}
 
-		// Store outputs from this level
-		stepOutputsMutex.Lock()
-		for _, output := range levelOutputs {
-			stepOutputs[output.Name] = output
+	// Failures handled by dependency policies still make the run unsuccessful
+	result := &FlowResult{Steps: allStepOutputs, Success: true}
+	for _, output := range allStepOutputs {
+		if output.Failed {
+			result.Success = false
+			result.Error = fmt.Errorf("step %s failed: %s", output.Name, output.Stderr)
+			break
 		}
-		allStepOutputs = append(allStepOutputs, levelOutputs...)
-		stepOutputsMutex.Unlock()
-
-		lgr.Debug("Level execution completed",
-			zap.Int("level", levelIndex+1),
-			zap.Int("steps_completed", len(levelOutputs)))
 	}
 
-	lgr.Info("Flow execution completed", zap.Int("steps_executed", len(allStepOutputs)), zap.Bool("success", true))
-	return &FlowResult{Steps: allStepOutputs, Success: true}, nil
+	lgr.Info("Flow execution completed", zap.Int("steps_executed", len(allStepOutputs)), zap.Bool("success", result.Success))
+	return result, nil
 }
 
-// executeLevel executes all steps in a level in parallel
-func (fe *FlowExecutor) executeLevel(ctx context.Context, stepNames []string, stepOutputs map[string]StepOutput, stepOutputsMutex *sync.RWMutex) ([]StepOutput, error) {
-	lgr := logger.FromContext(ctx)
+// recordRun persists a run record to the history. Failures are logged and do not affect the run.
+func (fe *FlowExecutor) recordRun(ctx context.Context, runID string, start, end time.Time, result *FlowResult, runErr error) {
+	if fe.History == nil {
+		return
+	}
 
-	if len(stepNames) == 0 {
-		return []StepOutput{}, nil
+	record := history.RunRecord{
+		ID:       runID,
+		Start:    start,
+		End:      end,
+		Duration: end.Sub(start),
+		Status:   history.RunStatusSuccess,
+		Steps:    []history.StepRecord{},
+	}
+
+	switch {
+	case result != nil && result.TimedOut:
+		record.Status = history.RunStatusTimedOut
+	case runErr != nil && ctx.Err() != nil:
+		record.Status = history.RunStatusCanceled
+	case runErr != nil || result == nil || !result.Success:
+		record.Status = history.RunStatusFailed
+	}
+	if runErr != nil {
+		record.Error = runErr.Error()
+	} else if result != nil && result.Error != nil {
+		record.Error = result.Error.Error()
 	}
 
-	// For single step, execute directly without goroutines
-	if len(stepNames) == 1 {
-		stepName := stepNames[0]
-		step := fe.getStepByName(stepName)
-		if step == nil {
-			return nil, fmt.Errorf("step not found: %s", stepName)
+	if result != nil {
+		for _, output := range result.Steps {
+			record.Steps = append(record.Steps, newStepRecord(output))
 		}
+	}
 
-		lgr.Debug("Executing single step", zap.String("step_name", step.Name))
+	if err := fe.History.Append(record); err != nil {
+		logger.FromContext(ctx).Warn("Failed to record flow run",
+			zap.String("run_id", runID),
+			zap.Error(err))
+	}
+}
 
-		stepOutputsMutex.RLock()
-		stepOutputsCopy := make(map[string]StepOutput)
-		for k, v := range stepOutputs {
-			stepOutputsCopy[k] = v
-		}
-		stepOutputsMutex.RUnlock()
+// timedOutResult marks every step that did not finish before the flow timeout as canceled
+func (fe *FlowExecutor) timedOutResult(result *FlowResult, err error) *FlowResult {
+	if result == nil {
+		result = &FlowResult{}
+	}
 
-		output, err := fe.executeStep(ctx, *step, stepOutputsCopy)
-		if err != nil {
-			return []StepOutput{{
+	finished := make(map[string]bool, len(result.Steps))
+	for _, output := range result.Steps {
+		finished[output.Name] = true
+	}
+	for _, step := range fe.Steps {
+		if !finished[step.Name] {
+			result.Steps = append(result.Steps, StepOutput{
 				Name:     step.Name,
-				Stdout:   "",
-				Stderr:   err.Error(),
-				Skipped:  false,
-				Failed:   true,
-				Canceled: false,
-			}}, err
+				Stderr:   cancelReason(ErrFlowTimedOut),
+				Canceled: true,
+			})
 		}
-
-		return []StepOutput{*output}, nil
 	}
 
-	// For multiple steps, execute in parallel
-	lgr.Debug("Executing parallel steps", zap.Int("count", len(stepNames)), zap.Strings("steps", stepNames))
+	result.Success = false
+	result.TimedOut = true
+	result.Error = err
+	return result
+}
 
-	// Check if any step has fail_fast policy to enable context cancellation
-	hasFailFastPolicy := false
-	for _, stepName := range stepNames {
-		if step := fe.getStepByName(stepName); step != nil {
-			policy := step.DependencyPolicy
-			if policy == "" || policy == "fail_fast" {
-				hasFailFastPolicy = true
-				break
-			}
-		}
+// flowTimedOut reports whether ctx was ended by the flow timeout
+func flowTimedOut(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrFlowTimedOut)
+}
+
+// cancelReason describes why a step was canceled, given the cause of the cancellation
+func cancelReason(cause error) string {
+	if errors.Is(cause, ErrFlowTimedOut) {
+		return "canceled because the flow timed out"
 	}
+	return "canceled due to fail_fast policy"
+}
 
-	// Create cancellable context if fail_fast policy is present
-	execCtx := ctx
-	var cancel context.CancelFunc
-	if hasFailFastPolicy {
-		execCtx, cancel = context.WithCancel(ctx)
-		defer cancel()
+// stepStatus returns the history status of a step output
+func stepStatus(output StepOutput) string {
+	switch {
+	case output.Canceled:
+		return history.StepStatusCanceled
+	case output.TimedOut:
+		return history.StepStatusTimedOut
+	case output.Failed:
+		return history.StepStatusFailed
+	case output.NotTaken:
+		return history.StepStatusNotTaken
+	case output.Skipped:
+		return history.StepStatusSkipped
+	case output.Reused:
+		return history.StepStatusReused
 	}
+	return history.StepStatusSuccess
+}
 
-	type stepResult struct {
-		output StepOutput
-		err    error
-	}
-
-	resultChan := make(chan stepResult, len(stepNames))
-	var wg sync.WaitGroup
-
-	// Start parallel execution
-	for _, stepName := range stepNames {
-		wg.Add(1)
-		go func(stepName string) {
-			defer wg.Done()
-
-			step := fe.getStepByName(stepName)
-			if step == nil {
-				resultChan <- stepResult{
-					output: StepOutput{
-						Name:     stepName,
-						Stdout:   "",
-						Stderr:   "step not found",
-						Skipped:  false,
-						Failed:   true,
-						Canceled: false,
-					},
-					err: fmt.Errorf("step not found: %s", stepName),
-				}
-				return
-			}
+// newStepRecord converts a step output into a history record
+func newStepRecord(output StepOutput) history.StepRecord {
+	return history.StepRecord{
+		Name:       output.Name,
+		Status:     stepStatus(output),
+		Prompt:     output.Prompt,
+		Stdout:     output.Stdout,
+		Stderr:     output.Stderr,
+		Attempts:   output.Attempts,
+		SkipReason: output.SkipReason,
+		Duration:   output.Duration,
+		Iterations: output.Iterations,
+	}
+}
 
-			lgr.Debug("Starting parallel step", zap.String("step_name", step.Name))
+// stepResult is the outcome of a step run by the graph scheduler
+type stepResult struct {
+	output StepOutput
+	err    error
+}
 
-			// Create a copy of stepOutputs for thread safety
-			stepOutputsMutex.RLock()
-			stepOutputsCopy := make(map[string]StepOutput)
-			for k, v := range stepOutputs {
-				stepOutputsCopy[k] = v
-			}
-			stepOutputsMutex.RUnlock()
+// executeGraph runs each step as soon as all of its dependencies have finished, with at
+// most MaxParallel steps running at once. Failed, skipped and canceled outputs are passed
+// on to the dependent steps, except when the failure reaches a fail_fast policy: then the
+// running steps are canceled, no further steps are started and the steps that never
+// started are reported as canceled.
+func (fe *FlowExecutor) executeGraph(ctx context.Context) ([]StepOutput, error) {
+	lgr := logger.FromContext(ctx)
 
-			// Execute the step with potentially cancellable context
-			output, err := fe.executeStep(execCtx, *step, stepOutputsCopy)
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-			// Check if context was canceled
-			if execCtx.Err() != nil {
-				lgr.Info("Step canceled due to context cancellation",
-					zap.String("step_name", step.Name),
-					zap.Error(execCtx.Err()))
-				resultChan <- stepResult{
-					output: StepOutput{
-						Name:     step.Name,
-						Stdout:   "",
-						Stderr:   "canceled due to fail_fast policy",
-						Skipped:  false,
-						Failed:   false,
-						Canceled: true,
-					},
-					err: execCtx.Err(),
-				}
-				return
-			}
+	completed := make(map[string]StepOutput, len(fe.Steps))
+	started := make(map[string]bool, len(fe.Steps))
+	results := make(chan stepResult)
+	running := 0
 
-			if err != nil {
-				lgr.Error("Step failed in parallel execution",
-					zap.String("step_name", step.Name),
-					zap.Error(err),
-					zap.String("error_type", fmt.Sprintf("%T", err)))
-
-				// Cancel other parallel steps if this step has fail_fast policy
-				policy := step.DependencyPolicy
-				if (policy == "" || policy == "fail_fast") && cancel != nil {
-					lgr.Info("Canceling other parallel steps due to fail_fast policy",
-						zap.String("failed_step", step.Name))
-					cancel()
+	var outputs []StepOutput
+	var flowErr error
+
+	for {
+		// Start ready steps in flow order until the parallelism limit is reached
+		if flowErr == nil && runCtx.Err() == nil {
+			for _, step := range fe.Steps {
+				if fe.MaxParallel > 0 && running >= fe.MaxParallel {
+					break
+				}
+				if started[step.Name] || !dependenciesFinished(step, completed) {
+					continue
 				}
 
-				resultChan <- stepResult{
-					output: StepOutput{
-						Name:     step.Name,
-						Stdout:   "",
-						Stderr:   err.Error(),
-						Skipped:  false,
-						Failed:   true,
-						Canceled: false,
-					},
-					err: err,
+				started[step.Name] = true
+				running++
+				lgr.Debug("Starting step", zap.String("step_name", step.Name), zap.Int("running", running))
+
+				// Each step gets its own copy of the outputs finished so far
+				previousOutputs := make(map[string]StepOutput, len(completed))
+				for name, output := range completed {
+					previousOutputs[name] = output
 				}
-				return
+				go func(step worker.FlowStep) {
+					results <- fe.runStep(runCtx, step, previousOutputs)
+				}(step)
 			}
+		}
 
-			lgr.Debug("Parallel step completed",
-				zap.String("step_name", step.Name),
-				zap.Int("output_size", len(output.Stdout)))
-
-			resultChan <- stepResult{output: *output, err: nil}
-		}(stepName)
-	}
+		if running == 0 {
+			break
+		}
 
-	// Wait for all goroutines to complete
-	wg.Wait()
-	close(resultChan)
+		result := <-results
+		running--
+		outputs = append(outputs, result.output)
+		completed[result.output.Name] = result.output
 
-	// Collect results
-	var outputs []StepOutput
-	var firstError error
+		if result.err == nil || result.output.Canceled {
+			if result.err != nil && flowErr == nil {
+				flowErr = result.err
+			}
+			continue
+		}
 
-	for result := range resultChan {
-		outputs = append(outputs, result.output)
-		if result.err != nil && firstError == nil {
-			firstError = result.err
+		// Cancel the running steps if the failure reaches a fail_fast policy
+		step := fe.getStepByName(result.output.Name)
+		if fe.failureStopsFlow(*step) {
+			if flowErr == nil {
+				flowErr = result.err
+				lgr.Info("Canceling running steps due to fail_fast policy",
+					zap.String("failed_step", step.Name),
+					zap.Int("running", running))
+				cancel()
+			}
+			continue
 		}
+
+		lgr.Warn("Step failed, dependent steps continue according to their dependency policy",
+			zap.String("step_name", step.Name),
+			zap.Error(result.err))
 	}
 
-	// Handle different dependency policies
-	if firstError != nil {
-		// Check if any step has fail_fast policy (default behavior)
-		for _, output := range outputs {
-			step := fe.getStepByName(output.Name)
-			if step != nil {
-				policy := step.DependencyPolicy
-				if policy == "" || policy == "fail_fast" {
-					// Fail fast - return immediately
-					return outputs, firstError
-				}
+	// The flow was canceled before any running step noticed
+	if flowErr == nil && ctx.Err() != nil {
+		flowErr = ctx.Err()
+	}
+
+	// Steps that never started were canceled, so that they can be told apart from steps
+	// that are not part of the flow
+	if flowErr != nil {
+		reason := cancelReason(context.Cause(runCtx))
+		for _, step := range fe.Steps {
+			if !started[step.Name] {
+				outputs = append(outputs, StepOutput{Name: step.Name, Stderr: reason, Canceled: true})
 			}
 		}
+	}
+	return outputs, flowErr
+}
 
-		// No fail_fast policy found, continue with partial results
-		lgr.Warn("Level completed with failures, but no fail_fast policies",
-			zap.Int("total_outputs", len(outputs)),
-			zap.Error(firstError))
+// runStep executes a step and converts errors into failed or canceled outputs
+func (fe *FlowExecutor) runStep(ctx context.Context, step worker.FlowStep, previousOutputs map[string]StepOutput) stepResult {
+	lgr := logger.FromContext(ctx)
+
+	output, err := fe.executeStep(ctx, step, previousOutputs)
+
+	// Keep execution details (prompt, attempts, duration) of unsuccessful steps
+	partial := StepOutput{Name: step.Name}
+	if output != nil {
+		partial = *output
 	}
 
-	return outputs, nil
-}
+	// Check if context was canceled
+	if ctx.Err() != nil {
+		lgr.Info("Step canceled due to context cancellation",
+			zap.String("step_name", step.Name),
+			zap.Error(ctx.Err()))
+		partial.Stdout = ""
+		partial.Stderr = cancelReason(context.Cause(ctx))
+		partial.Skipped = false
+		partial.Failed = false
+		partial.TimedOut = false
+		partial.Canceled = true
+		return stepResult{output: partial, err: ctx.Err()}
+	}
 
-// validateFlow validates the flow configuration
-func (fe *FlowExecutor) validateFlow() error {
-	if len(fe.Steps) == 0 {
-		return fmt.Errorf("flow must contain at least one step")
+	if err != nil {
+		lgr.Error("Step failed",
+			zap.String("step_name", step.Name),
+			zap.Error(err),
+			zap.String("error_type", fmt.Sprintf("%T", err)))
+
+		// The output of a failed step is kept, e.g. the item outcomes of a for_each step
+		partial.Stderr = err.Error()
+		partial.Failed = true
+		return stepResult{output: partial, err: err}
 	}
 
-	stepNames := make(map[string]bool)
-	for _, step := range fe.Steps {
-		if step.Name == "" {
-			return fmt.Errorf("step name is required")
-		}
-		if step.Type == "" {
-			return fmt.Errorf("step type is required for step: %s", step.Name)
-		}
-		if stepNames[step.Name] {
-			return fmt.Errorf("duplicate step name: %s", step.Name)
-		}
-		stepNames[step.Name] = true
+	lgr.Debug("Step completed",
+		zap.String("step_name", step.Name),
+		zap.Int("output_size", len(output.Stdout)))
 
-		// Validate dependencies exist
-		for _, dep := range step.DependsOn {
-			if !stepNames[dep] && !fe.stepExistsInFlow(dep) {
-				return fmt.Errorf("step %s depends on non-existent step: %s", step.Name, dep)
-			}
+	return stepResult{output: *output}
+}
+
+// dependenciesFinished reports whether every dependency of a step has an output
+func dependenciesFinished(step worker.FlowStep, completed map[string]StepOutput) bool {
+	for _, dep := range step.DependsOn {
+		if _, ok := completed[dep]; !ok {
+			return false
 		}
 	}
-
-	return nil
+	return true
 }
 
-// stepExistsInFlow checks if a step name exists in the flow
-func (fe *FlowExecutor) stepExistsInFlow(stepName string) bool {
+// validateFlow checks the steps before anything runs and returns the first problem
+func (fe *FlowExecutor) validateFlow() error {
+	if errs := ValidateSteps(fe.Steps, fe.MCPServers); len(errs) > 0 {
+		return errs[0]
+	}
 	for _, step := range fe.Steps {
-		if step.Name == stepName {
-			return true
+		if err := step.ValidateTemplates(); err != nil {
+			return fmt.Errorf("step %s: %w", step.Name, err)
 		}
 	}
-	return false
+	return nil
 }
 
 // resolveDependencyLevels groups steps by dependency levels for parallel execution
@@ -422,21 +525,35 @@ func (fe *FlowExecutor) resolveDependencyLevels() ([][]string, error) {
 	return levels, nil
 }
 
-// createAgents creates agent instances for each step in the flow
+// createAgents creates agent instances for each step in the flow, including the steps
+// within loop steps
 func (fe *FlowExecutor) createAgents(ctx context.Context) error {
 	lgr := logger.FromContext(ctx)
 
+	var steps []worker.FlowStep
 	for _, step := range fe.Steps {
+		steps = append(steps, step)
+		steps = append(steps, step.Steps...)
+	}
+
+	for _, step := range steps {
 		// Skip agent creation if agent already exists (for testing)
 		if _, exists := fe.Agents[step.Name]; exists {
 			continue
 		}
 
+		// Routers and loop steps are evaluated by the executor and need no agent
+		if step.Type == worker.StepTypeRouter || step.Type == worker.StepTypeLoop {
+			continue
+		}
+
 		// Create agent config from step
 		agentConfig := agent.AgentConfig{
 			Type: step.Type,
 			Args: step.Args,
 			Env:  step.Env,
+			HTTP: step.HTTP,
+			MCP:  step.MCP,
 		}
 
 		// Create agent with working directory + step name for proper MCP config paths
@@ -481,7 +598,33 @@ func (fe *FlowExecutor) createAgents(ctx context.Context) error {
 func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, previousOutputs map[string]StepOutput) (*StepOutput, error) {
 	lgr := logger.FromContext(ctx)
 
-	// Check dependency policy first
+	// Steps disabled at runtime are skipped so downstream dependency policies still apply
+	if fe.WorkerRuntime != nil && !fe.WorkerRuntime.IsStepEnabled(step.Name) {
+		lgr.Info("Step skipped because it is disabled", zap.String("step_name", step.Name))
+
+		return &StepOutput{
+			Name:     step.Name,
+			Stdout:   "",
+			Stderr:   "step is disabled",
+			Skipped:  true,
+			Failed:   false,
+			Canceled: false,
+
+			SkipReason: "step is disabled",
+		}, nil
+	}
+
+	// Steps on a branch their router did not select are not taken
+	if output := fe.checkRouteTaken(ctx, step, previousOutputs); output != nil {
+		return output, nil
+	}
+
+	// Steps with every/cron only run when due, otherwise their last output is reused or they are skipped
+	if output := fe.checkStepDue(ctx, step); output != nil {
+		return output, nil
+	}
+
+	// Check dependency policy
 	canExecute, reason := fe.evaluateDependencyPolicy(step, previousOutputs)
 	if !canExecute {
 		lgr.Info("Step skipped due to dependency policy",
@@ -497,6 +640,8 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 			Skipped:  true,
 			Failed:   false,
 			Canceled: false,
+
+			SkipReason: reason,
 		}, nil
 	}
 
@@ -519,12 +664,19 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 			Skipped:  true,
 			Failed:   false,
 			Canceled: false,
+
+			SkipReason: "skip_when condition is true",
 		}, nil
 	}
 
-	// Get agent for this step
+	// Routers select branches instead of running an agent
+	if step.Type == worker.StepTypeRouter {
+		return fe.executeRouter(ctx, step, previousOutputs)
+	}
+
+	// Get agent for this step; loop steps run the agents of their steps instead
 	stepAgent, exists := fe.Agents[step.Name]
-	if !exists {
+	if !exists && step.Type != worker.StepTypeLoop {
 		// Debug: list all available agents
 		var availableAgents []string
 		for agentName := range fe.Agents {
@@ -537,21 +689,7 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 	}
 
 	// Prepare input data for template processing
-	inputData := fe.prepareInputData(step, previousOutputs)
-
-	// Process input field as template if it contains template syntax
-	prompt := step.Input
-	if step.Input != "" {
-		transformedInput, transformErr := fe.applyTemplate(step.Input, inputData)
-		if transformErr != nil {
-			lgr.Warn("Input template processing failed, using original input",
-				zap.String("step_name", step.Name),
-				zap.String("input_template", step.Input),
-				zap.Error(transformErr))
-		} else {
-			prompt = transformedInput
-		}
-	}
+	inputData := fe.prepareInputData(ctx, step, previousOutputs)
 
 	// Mark step as active
 	if fe.WorkerRuntime != nil {
@@ -564,16 +702,312 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 		zap.String("step_name", step.Name),
 		zap.String("agent_type", step.Type))
 
+	// Set up run options
+	runOptions, err := fe.baseRunOptions(step)
+	if err != nil {
+		return nil, fmt.Errorf("step %s: %w", step.Name, err)
+	}
+
+	// Steps with for_each run the agent once per item
+	if step.ForEach != "" {
+		return fe.executeForEach(ctx, step, stepAgent, inputData, runOptions)
+	}
+
+	// Steps with until re-run the agent until their condition holds
+	if step.Until != "" {
+		return fe.executeLoop(ctx, step, stepAgent, inputData, runOptions)
+	}
+
+	prompt, err := fe.renderPrompt(ctx, step, inputData)
+	if err != nil {
+		return nil, fmt.Errorf("step %s: %w", step.Name, err)
+	}
 	lgr.Debug("Step prompt details",
 		zap.String("step_name", step.Name),
 		zap.String("prompt", prompt))
 
-	// Set up run options
-	runOptions := agent.RunOptions{
+	runOptions, err = fe.renderRunOptions(step, inputData, runOptions)
+	if err != nil {
+		return nil, fmt.Errorf("step %s: %w", step.Name, err)
+	}
+
+	// Execute agent with retry logic
+	startTime := time.Now()
+	run := fe.runWithRetries(ctx, step, stepAgent, prompt, runOptions)
+
+	// Apply output transformation if specified
+	var stdout string
+	if run.err == nil {
+		stdout, run.err = fe.transformOutput(ctx, step, run.output)
+	}
+
+	// Check if all attempts failed
+	if run.err != nil {
+		// Record failed execution statistics
+		if fe.WorkerRuntime != nil {
+			errorMsg := run.err.Error()
+			fe.WorkerRuntime.RecordStepExecution(step.Name, false, nil, &errorMsg, time.Since(startTime))
+		}
+		failed := &StepOutput{
+			Name:     step.Name,
+			Failed:   true,
+			TimedOut: run.timedOut,
+			Prompt:   prompt,
+			Attempts: run.attempts,
+			Duration: time.Since(startTime),
+		}
+		if run.output != nil {
+			failed.Stdout = run.output.Stdout
+			failed.ExitCode = run.output.ExitCode
+			failed.Response = run.output.Response
+		}
+		return failed, fmt.Errorf("agent execution failed for step %s after %d attempts: %w", step.Name, run.attempts, run.err)
+	}
+	output := run.output
+
+	// Log agent completion
+	lgr.Debug("Agent execution completed",
+		zap.String("step_name", step.Name),
+		zap.String("agent_type", step.Type),
+		zap.String("stdout", output.Stdout),
+		zap.String("stderr", output.Stderr),
+	)
+
+	// Log step completion
+	lgr.Info("Step completed",
+		zap.String("step_name", step.Name),
+		zap.Bool("success", true))
+
+	return fe.completeStep(ctx, step, startTime, StepOutput{
+		Name:     step.Name,
+		Stdout:   stdout,
+		Stderr:   output.Stderr,
+		Prompt:   prompt,
+		Attempts: run.attempts,
+		ExitCode: output.ExitCode,
+		Response: output.Response,
+		Parsed:   run.parsed,
+	}), nil
+}
+
+// completeStep persists and records the output of a successful step
+func (fe *FlowExecutor) completeStep(ctx context.Context, step worker.FlowStep, startTime time.Time, output StepOutput) *StepOutput {
+	// Remember the output of scheduled steps for cycles in which they are not due
+	fe.saveStepOutput(ctx, step, startTime, output.Stdout, output.Stderr)
+
+	// Record step execution statistics directly. Only successful steps are completed, so
+	// their stderr holds warnings rather than an error.
+	if fe.WorkerRuntime != nil {
+		var outputPtr *string
+		if output.Stdout != "" {
+			outputPtr = &output.Stdout
+		}
+		fe.WorkerRuntime.RecordStepExecution(step.Name, true, outputPtr, nil, time.Since(startTime))
+	}
+
+	output.Duration = time.Since(startTime)
+	return &output
+}
+
+// baseRunOptions returns the run options of a step before its templates are rendered
+func (fe *FlowExecutor) baseRunOptions(step worker.FlowStep) (agent.RunOptions, error) {
+	timeout, err := step.GetTimeout()
+	if err != nil {
+		return agent.RunOptions{}, err
+	}
+	return agent.RunOptions{
 		MaxRetries:       1,
 		ContinueMode:     false,
 		WorkingDirectory: fmt.Sprintf("%s/%s", fe.WorkingDir, step.Name),
+		Timeout:          timeout,
+	}, nil
+}
+
+// renderPrompt renders the input template of a step. Template errors fail the step with
+// StrictTemplates; otherwise the raw input is used.
+func (fe *FlowExecutor) renderPrompt(ctx context.Context, step worker.FlowStep, inputData map[string]interface{}) (string, error) {
+	// Process input field as template if it contains template syntax
+	prompt := step.Input
+	if step.Input != "" {
+		transformedInput, transformErr := fe.applyTemplate(step.Input, inputData)
+		if transformErr != nil {
+			if fe.StrictTemplates {
+				return "", fmt.Errorf("failed to render input: %w", transformErr)
+			}
+			logger.FromContext(ctx).Warn("Input template processing failed, using original input",
+				zap.String("step_name", step.Name),
+				zap.String("input_template", step.Input),
+				zap.Error(transformErr))
+		} else {
+			prompt = transformedInput
+		}
 	}
+	return prompt, nil
+}
+
+// renderRunOptions renders the command of a shell step or the request of an http step into
+// the run options. Unlike the input, they are never used with templates that failed to render.
+func (fe *FlowExecutor) renderRunOptions(step worker.FlowStep, inputData map[string]interface{}, runOptions agent.RunOptions) (agent.RunOptions, error) {
+	switch step.Type {
+	case agent.AgentTypeShell:
+		return fe.renderCommand(step, inputData, runOptions)
+	case agent.AgentTypeHTTP:
+		return fe.renderRequest(step, inputData, runOptions)
+	case agent.AgentTypeMCPCall:
+		return fe.renderToolCall(step, inputData, runOptions)
+	default:
+		return runOptions, nil
+	}
+}
+
+// renderCommand renders the args and env templates of a shell step
+func (fe *FlowExecutor) renderCommand(step worker.FlowStep, inputData map[string]interface{}, runOptions agent.RunOptions) (agent.RunOptions, error) {
+	runOptions.Args = make([]string, 0, len(step.Args))
+	for i, arg := range step.Args {
+		rendered, err := fe.applyTemplate(arg, inputData)
+		if err != nil {
+			return runOptions, fmt.Errorf("failed to render args[%d]: %w", i, err)
+		}
+		runOptions.Args = append(runOptions.Args, rendered)
+	}
+
+	runOptions.Env = make(map[string]string, len(step.Env))
+	for key, value := range step.Env {
+		rendered, err := fe.applyTemplate(value, inputData)
+		if err != nil {
+			return runOptions, fmt.Errorf("failed to render env %s: %w", key, err)
+		}
+		runOptions.Env[key] = rendered
+	}
+	return runOptions, nil
+}
+
+// renderRequest renders the method, URL, header and body templates of an http step
+func (fe *FlowExecutor) renderRequest(step worker.FlowStep, inputData map[string]interface{}, runOptions agent.RunOptions) (agent.RunOptions, error) {
+	if step.HTTP == nil {
+		return runOptions, fmt.Errorf("http step requires http.url")
+	}
+
+	request := &worker.HTTPRequest{
+		Headers:       make(map[string]string, len(step.HTTP.Headers)),
+		SuccessStatus: step.HTTP.SuccessStatus,
+	}
+	fields := []struct {
+		name     string
+		template string
+		target   *string
+	}{
+		{"method", step.HTTP.Method, &request.Method},
+		{"url", step.HTTP.URL, &request.URL},
+		{"body", step.HTTP.Body, &request.Body},
+	}
+	for _, field := range fields {
+		rendered, err := fe.applyTemplate(field.template, inputData)
+		if err != nil {
+			return runOptions, fmt.Errorf("failed to render http.%s: %w", field.name, err)
+		}
+		*field.target = rendered
+	}
+	request.Method = strings.TrimSpace(request.Method)
+	request.URL = strings.TrimSpace(request.URL)
+
+	for key, value := range step.HTTP.Headers {
+		rendered, err := fe.applyTemplate(value, inputData)
+		if err != nil {
+			return runOptions, fmt.Errorf("failed to render header %s: %w", key, err)
+		}
+		request.Headers[key] = rendered
+	}
+
+	runOptions.HTTP = request
+	return runOptions, nil
+}
+
+// renderToolCall renders the arguments template of an mcp_call step
+func (fe *FlowExecutor) renderToolCall(step worker.FlowStep, inputData map[string]interface{}, runOptions agent.RunOptions) (agent.RunOptions, error) {
+	if step.MCP == nil {
+		return runOptions, fmt.Errorf("mcp_call step requires mcp.server and mcp.tool")
+	}
+
+	arguments, err := fe.applyTemplate(step.MCP.Arguments, inputData)
+	if err != nil {
+		return runOptions, fmt.Errorf("failed to render mcp.arguments: %w", err)
+	}
+
+	runOptions.MCPCall = &worker.MCPCall{
+		Server:    step.MCP.Server,
+		Tool:      step.MCP.Tool,
+		Arguments: arguments,
+	}
+	return runOptions, nil
+}
+
+// transformOutput applies the output template of a step. Template errors fail the step with
+// StrictTemplates; otherwise the raw stdout is used.
+func (fe *FlowExecutor) transformOutput(ctx context.Context, step worker.FlowStep, output *agent.AgentOutput) (string, error) {
+	if step.Output == "" {
+		return output.Stdout, nil
+	}
+
+	lgr := logger.FromContext(ctx)
+	templateData := map[string]interface{}{"stdout": output.Stdout}
+	addOutputData(templateData, output)
+
+	transformedOutput, err := fe.applyTemplate(step.Output, templateData)
+	if err != nil {
+		if fe.StrictTemplates {
+			return "", fmt.Errorf("failed to render output: %w", err)
+		}
+		lgr.Warn("Output transformation failed, using raw output",
+			zap.String("step_name", step.Name),
+			zap.String("output_template", step.Output),
+			zap.Error(err))
+		return output.Stdout, nil
+	}
+
+	lgr.Debug("Output transformed",
+		zap.String("step_name", step.Name),
+		zap.String("output", transformedOutput),
+	)
+	return transformedOutput, nil
+}
+
+// addOutputData adds the details of an agent output besides stdout to template data
+func addOutputData(data map[string]interface{}, output *agent.AgentOutput) {
+	data["stderr"] = output.Stderr
+	data["exit_code"] = output.ExitCode
+	if output.Response != nil {
+		data["status"] = output.Response.Status
+		data["headers"] = output.Response.Headers
+		data["body"] = output.Response.Body
+	}
+}
+
+// responseData converts the response of an http step for templates of its dependents
+func responseData(response *agent.HTTPResponse) map[string]interface{} {
+	if response == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"status":  response.Status,
+		"headers": response.Headers,
+		"body":    response.Body,
+	}
+}
+
+// agentRun is the outcome of running the agent of a step with retries
+type agentRun struct {
+	output   *agent.AgentOutput
+	parsed   interface{} // Decoded JSON output if the step has an output_schema
+	attempts int
+	timedOut bool // The last attempt exceeded the step timeout
+	err      error
+}
+
+// runWithRetries runs the agent of a step until an attempt succeeds, the retry
+// configuration is exhausted or the flow is canceled
+func (fe *FlowExecutor) runWithRetries(ctx context.Context, step worker.FlowStep, stepAgent agent.Agent, prompt string, runOptions agent.RunOptions) agentRun {
+	lgr := logger.FromContext(ctx)
 
 	// Determine retry configuration
 	maxAttempts := 1
@@ -581,39 +1015,62 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 		maxAttempts = step.Retry.MaxAttempts
 	}
 
-	// Execute agent with retry logic
-	var output *agent.AgentOutput
-	var lastErr error
+	// Output that does not match the output_schema is re-requested with a corrective prompt,
+	// at least once even without retries
+	schema, err := step.CompileOutputSchema()
+	if err != nil {
+		return agentRun{err: err}
+	}
+	attemptPrompt := prompt
 
+	var run agentRun
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		run.attempts = attempt
+
 		// Update retry statistics
 		if fe.WorkerRuntime != nil {
-			stats := fe.WorkerRuntime.GetStepStats(step.Name)
-			if stats != nil {
-				stats.RetryAttempt = attempt - 1
-				if attempt > 1 {
-					now := time.Now()
-					stats.LastRetryTime = &now
-					stats.TotalRetries = attempt - 1
-				}
-			}
+			fe.WorkerRuntime.RecordStepAttempt(step.Name, attempt)
 		}
 
 		// Log retry attempt
 		if attempt > 1 {
+			backoff := ""
+			if step.Retry != nil {
+				backoff = step.Retry.Backoff
+			}
 			lgr.Info("Retrying step execution",
 				zap.String("step_name", step.Name),
 				zap.Int("attempt", attempt),
 				zap.Int("max_attempts", maxAttempts),
-				zap.String("backoff_strategy", step.Retry.Backoff))
+				zap.String("backoff_strategy", backoff))
 		}
 
 		// Execute the agent
-		output, lastErr = stepAgent.Run(ctx, prompt, runOptions)
-		if lastErr == nil {
+		run.output, run.timedOut, run.err = fe.runAttempt(ctx, stepAgent, attemptPrompt, runOptions)
+		if run.err == nil && schema != nil {
+			run.parsed, run.err = conformToSchema(schema, run.output)
+			if run.err != nil {
+				lgr.Warn("Step output rejected by output_schema",
+					zap.String("step_name", step.Name),
+					zap.Int("attempt", attempt),
+					zap.Error(run.err))
+				attemptPrompt = correctivePrompt(prompt, step, run.err)
+				maxAttempts = max(maxAttempts, minSchemaAttempts)
+			}
+		}
+		if run.err == nil {
 			// Success - exit retry loop
 			break
 		}
+		if run.timedOut {
+			lgr.Warn("Step attempt timed out",
+				zap.String("step_name", step.Name),
+				zap.Int("attempt", attempt),
+				zap.Duration("timeout", runOptions.Timeout))
+			if fe.WorkerRuntime != nil {
+				fe.WorkerRuntime.RecordStepTimeout(step.Name)
+			}
+		}
 
 		// If this isn't the last attempt, calculate delay and wait
 		if attempt < maxAttempts {
@@ -621,11 +1078,7 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 			if delay > 0 {
 				// Set next retry time for status tracking
 				if fe.WorkerRuntime != nil {
-					stats := fe.WorkerRuntime.GetStepStats(step.Name)
-					if stats != nil {
-						nextRetry := time.Now().Add(delay)
-						stats.NextRetryTime = &nextRetry
-					}
+					fe.WorkerRuntime.SetStepNextRetryTime(step.Name, time.Now().Add(delay))
 				}
 
 				lgr.Info("Waiting before retry",
@@ -633,95 +1086,65 @@ func (fe *FlowExecutor) executeStep(ctx context.Context, step worker.FlowStep, p
 					zap.Duration("delay", delay),
 					zap.Int("next_attempt", attempt+1))
 
-				time.Sleep(delay)
+				select {
+				case <-ctx.Done():
+				case <-time.After(delay):
+				}
 			}
-		}
-	}
 
-	// Check if all attempts failed
-	if lastErr != nil {
-		// Record failed execution statistics
-		if fe.WorkerRuntime != nil {
-			errorMsg := lastErr.Error()
-			fe.WorkerRuntime.RecordStepExecution(step.Name, false, nil, &errorMsg)
+			// Stop retrying once the flow has been canceled
+			if ctx.Err() != nil {
+				run.err = ctx.Err()
+				break
+			}
 		}
-		return nil, fmt.Errorf("agent execution failed for step %s after %d attempts: %w", step.Name, maxAttempts, lastErr)
 	}
+	return run
+}
 
-	// Log agent completion
-	lgr.Debug("Agent execution completed",
-		zap.String("step_name", step.Name),
-		zap.String("agent_type", step.Type),
-		zap.String("stdout", output.Stdout),
-		zap.String("stderr", output.Stderr),
-	)
-
-	// Apply output transformation if specified
-	stdout := output.Stdout
-	if step.Output != "" {
-		templateData := map[string]interface{}{
-			"stdout": output.Stdout,
-			"stderr": output.Stderr,
-		}
-
-		transformedOutput, err := fe.applyTemplate(step.Output, templateData)
-		if err != nil {
-			lgr.Warn("Output transformation failed, using raw output",
-				zap.String("step_name", step.Name),
-				zap.String("output_template", step.Output),
-				zap.Error(err))
-		} else {
-			stdout = transformedOutput
-			lgr.Debug("Output transformed",
-				zap.String("step_name", step.Name),
-				zap.String("output", stdout),
-			)
-		}
+// runAttempt runs a single agent attempt, bounded by the step timeout. The returned flag
+// reports whether the attempt was stopped because it exceeded the timeout.
+func (fe *FlowExecutor) runAttempt(ctx context.Context, stepAgent agent.Agent, prompt string, runOptions agent.RunOptions) (*agent.AgentOutput, bool, error) {
+	if runOptions.Timeout <= 0 {
+		output, err := stepAgent.Run(ctx, prompt, runOptions)
+		return output, false, err
 	}
 
-	// Log step completion
-	lgr.Info("Step completed",
-		zap.String("step_name", step.Name),
-		zap.Bool("success", true))
+	attemptCtx, cancel := context.WithTimeout(ctx, runOptions.Timeout)
+	defer cancel()
 
-	// Record step execution statistics directly
-	if fe.WorkerRuntime != nil {
-		success := output.Stderr == ""
-		var outputPtr *string
-		if stdout != "" {
-			outputPtr = &stdout
-		}
-		var errorPtr *string
-		if output.Stderr != "" {
-			errorPtr = &output.Stderr
-		}
-		fe.WorkerRuntime.RecordStepExecution(step.Name, success, outputPtr, errorPtr)
+	output, err := stepAgent.Run(attemptCtx, prompt, runOptions)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return output, true, fmt.Errorf("%w after %s", ErrStepTimedOut, runOptions.Timeout)
 	}
-
-	return &StepOutput{
-		Name:     step.Name,
-		Stdout:   stdout,
-		Stderr:   output.Stderr,
-		Skipped:  false,
-		Failed:   false, // Success case
-		Canceled: false,
-	}, nil
+	return output, false, err
 }
 
 // prepareInputData prepares template data for input transformation
-func (fe *FlowExecutor) prepareInputData(step worker.FlowStep, previousOutputs map[string]StepOutput) map[string]interface{} {
+func (fe *FlowExecutor) prepareInputData(ctx context.Context, step worker.FlowStep, previousOutputs map[string]StepOutput) map[string]interface{} {
 	// Collect inputs from dependencies
 	var inputs []string
+	var exitCodes []int
+	var responses []map[string]interface{}
 	for _, dep := range step.DependsOn {
 		if output, exists := previousOutputs[dep]; exists {
 			inputs = append(inputs, output.Stdout)
+			exitCodes = append(exitCodes, output.ExitCode)
+			responses = append(responses, responseData(output.Response))
 		}
 	}
 
 	return map[string]interface{}{
-		"inputs": inputs,
-		"step":   step,
-		"flow":   fe,
+		"inputs":     inputs,
+		"exit_codes": exitCodes,
+		"responses":  responses,
+		"outputs":    fe.outputsData(step, previousOutputs),
+		"worker":     fe.workerData(),
+		"run":        runData(ctx),
+		"env":        envData(),
+		"step":       step,
+		"flow":       fe,
+		"event":      eventFromContext(ctx),
 	}
 }
 
@@ -734,16 +1157,19 @@ func (fe *FlowExecutor) evaluateSkipCondition(ctx context.Context, step worker.F
 	lgr := logger.FromContext(ctx)
 
 	// Prepare input data for skip condition evaluation (same as input transformers)
-	inputData := fe.prepareInputData(step, previousOutputs)
+	inputData := fe.prepareInputData(ctx, step, previousOutputs)
 
 	lgr.Debug("Evaluating skip condition",
 		zap.String("step_name", step.Name),
 		zap.String("skip_when", step.SkipWhen),
-		zap.Any("input_data", inputData))
+		zap.Any("inputs", inputData["inputs"]))
 
 	// Evaluate the skip condition template
 	result, err := fe.applyTemplate(step.SkipWhen, inputData)
 	if err != nil {
+		if fe.StrictTemplates {
+			return false, fmt.Errorf("failed to render skip_when: %w", err)
+		}
 		lgr.Warn("Skip condition template execution failed, assuming step should not be skipped",
 			zap.String("step_name", step.Name),
 			zap.String("skip_when", step.SkipWhen),
@@ -765,7 +1191,7 @@ func (fe *FlowExecutor) evaluateSkipCondition(ctx context.Context, step worker.F
 // applyTemplate applies a Sprig template to the given data
 func (fe *FlowExecutor) applyTemplate(templateStr string, data interface{}) (string, error) {
 	// Create template with Sprig functions
-	tmpl, err := template.New("transform").Funcs(sprig.FuncMap()).Parse(templateStr)
+	tmpl, err := template.New("transform").Funcs(worker.TemplateFuncs()).Parse(templateStr)
 	if err != nil {
 		return "", fmt.Errorf("template parsing failed: %w", err)
 	}
@@ -793,7 +1219,7 @@ func (fe *FlowExecutor) getStepByName(name string) *worker.FlowStep {
 func (fe *FlowExecutor) evaluateDependencyPolicy(step worker.FlowStep, completedSteps map[string]StepOutput) (bool, string) {
 	policy := step.DependencyPolicy
 	if policy == "" {
-		policy = "fail_fast" // Default policy
+		policy = worker.DependencyPolicyFailFast // Default policy
 	}
 
 	if len(step.DependsOn) == 0 {
@@ -811,54 +1237,82 @@ func (fe *FlowExecutor) evaluateDependencyPolicy(step worker.FlowStep, completed
 	}
 
 	switch policy {
-	case "fail_fast":
+	case worker.DependencyPolicyFailFast:
 		// Stop immediately if any dependency failed
 		for _, dep := range dependencyResults {
-			if dep.Failed {
-				return false, fmt.Sprintf("dependency %s failed and policy is fail_fast", dep.Name)
+			if dep.Failed || dep.Canceled {
+				return false, fmt.Sprintf("dependency %s %s and policy is fail_fast", dep.Name, stepStatus(dep))
 			}
 		}
 		return true, ""
 
-	case "all_success":
+	case worker.DependencyPolicyAllSuccess:
 		// All dependencies must succeed
 		for _, dep := range dependencyResults {
-			if dep.Failed || dep.Skipped {
-				return false, fmt.Sprintf("dependency %s did not succeed (failed=%v, skipped=%v)", dep.Name, dep.Failed, dep.Skipped)
+			if !succeeded(dep) {
+				return false, fmt.Sprintf("dependency %s did not succeed (%s)", dep.Name, stepStatus(dep))
 			}
 		}
 		return true, ""
 
-	case "all_complete":
+	case worker.DependencyPolicyAllComplete:
 		// All dependencies must be complete (success or failure doesn't matter)
 		// Since we already verified all dependencies exist in completedSteps, this is always true
 		return true, ""
 
-	case "any_success":
+	case worker.DependencyPolicyAnySuccess:
 		// At least one dependency must succeed
-		hasSuccess := false
 		for _, dep := range dependencyResults {
-			if !dep.Failed && !dep.Skipped {
-				hasSuccess = true
-				break
+			if succeeded(dep) {
+				return true, ""
 			}
 		}
-		if !hasSuccess {
-			return false, "no dependencies succeeded and policy is any_success"
-		}
-		return true, ""
+		return false, "no dependencies succeeded and policy is any_success"
 
 	default:
 		// Unknown policy, default to fail_fast behavior
 		for _, dep := range dependencyResults {
-			if dep.Failed {
-				return false, fmt.Sprintf("dependency %s failed and unknown policy %s defaults to fail_fast", dep.Name, policy)
+			if dep.Failed || dep.Canceled {
+				return false, fmt.Sprintf("dependency %s %s and unknown policy %s defaults to fail_fast", dep.Name, stepStatus(dep), policy)
 			}
 		}
 		return true, ""
 	}
 }
 
+// isFailFast reports whether a dependency policy stops the flow on failures. Unknown
+// policies behave like fail_fast.
+func isFailFast(policy string) bool {
+	switch policy {
+	case worker.DependencyPolicyAllSuccess, worker.DependencyPolicyAllComplete, worker.DependencyPolicyAnySuccess:
+		return false
+	}
+	return true
+}
+
+// succeeded reports whether a step produced a usable output (including reused outputs)
+func succeeded(output StepOutput) bool {
+	return !output.Failed && !output.Skipped && !output.Canceled
+}
+
+// failureStopsFlow reports whether a failure of the step stops the flow. The direct
+// dependents decide: the failure is handled downstream unless one of them uses fail_fast.
+// Nothing handles the failure of a step without dependents, so the flow-level fail_fast
+// setting decides.
+func (fe *FlowExecutor) failureStopsFlow(step worker.FlowStep) bool {
+	hasDependents := false
+	for _, other := range fe.Steps {
+		if !slices.Contains(other.DependsOn, step.Name) {
+			continue
+		}
+		hasDependents = true
+		if isFailFast(other.DependencyPolicy) {
+			return true
+		}
+	}
+	return !hasDependents && fe.FailFast
+}
+
 // calculateRetryDelay calculates the delay for a retry attempt based on the backoff strategy
 func (fe *FlowExecutor) calculateRetryDelay(retry *worker.RetryConfig, attemptNumber int) time.Duration {
 	if retry == nil || retry.Delay == 0 {
@@ -873,14 +1327,14 @@ func (fe *FlowExecutor) calculateRetryDelay(retry *worker.RetryConfig, attemptNu
 
 	var delay time.Duration
 	switch retry.Backoff {
-	case "exponential":
+	case worker.BackoffExponential:
 		// Exponential backoff: delay * 2^(attempt-1)
 		exp := 1
 		for i := 1; i < attemptNumber; i++ {
 			exp *= 2
 		}
 		delay = baseDelay * time.Duration(exp)
-	case "linear":
+	case worker.BackoffLinear:
 		// Linear backoff: delay * attempt
 		delay = baseDelay * time.Duration(attemptNumber)
 	default: // "fixed" or empty
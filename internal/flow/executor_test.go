@@ -2,12 +2,24 @@ package flow
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"autoteam/internal/agent"
+	"autoteam/internal/history"
+	"autoteam/internal/secrets"
 	"autoteam/internal/worker"
 
 	"github.com/stretchr/testify/assert"
@@ -78,8 +90,9 @@ func (f *MockAgentFactory) RegisterAgent(name string, agent agent.Agent) {
 // Test helper to create a basic flow executor
 func createTestExecutor(steps []worker.FlowStep) *FlowExecutor {
 	return &FlowExecutor{
-		Steps:  steps,
-		Agents: make(map[string]agent.Agent),
+		Steps:    steps,
+		Agents:   make(map[string]agent.Agent),
+		FailFast: true,
 	}
 }
 
@@ -387,6 +400,234 @@ func TestDependencyPolicyAnySuccess(t *testing.T) {
 	}
 }
 
+// TestDependencyPolicyMatrix tests whether each policy runs a step for each dependency outcome
+func TestDependencyPolicyMatrix(t *testing.T) {
+	outcomes := map[string]StepOutput{
+		"success":  {Name: "dep"},
+		"reused":   {Name: "dep", Reused: true},
+		"skipped":  {Name: "dep", Skipped: true},
+		"failed":   {Name: "dep", Failed: true},
+		"timedOut": {Name: "dep", Failed: true, TimedOut: true},
+		"canceled": {Name: "dep", Canceled: true},
+	}
+
+	// Expected result for each outcome of the single dependency "dep", plus a mixed case
+	// where "dep" has the outcome and "ok" succeeded
+	tests := []struct {
+		policy string
+		runs   map[string]bool
+		mixed  map[string]bool
+	}{
+		{
+			policy: "fail_fast",
+			runs:   map[string]bool{"success": true, "reused": true, "skipped": true, "failed": false, "timedOut": false, "canceled": false},
+			mixed:  map[string]bool{"success": true, "reused": true, "skipped": true, "failed": false, "timedOut": false, "canceled": false},
+		},
+		{
+			policy: "",
+			runs:   map[string]bool{"success": true, "reused": true, "skipped": true, "failed": false, "timedOut": false, "canceled": false},
+			mixed:  map[string]bool{"success": true, "reused": true, "skipped": true, "failed": false, "timedOut": false, "canceled": false},
+		},
+		{
+			policy: "all_success",
+			runs:   map[string]bool{"success": true, "reused": true, "skipped": false, "failed": false, "timedOut": false, "canceled": false},
+			mixed:  map[string]bool{"success": true, "reused": true, "skipped": false, "failed": false, "timedOut": false, "canceled": false},
+		},
+		{
+			policy: "all_complete",
+			runs:   map[string]bool{"success": true, "reused": true, "skipped": true, "failed": true, "timedOut": true, "canceled": true},
+			mixed:  map[string]bool{"success": true, "reused": true, "skipped": true, "failed": true, "timedOut": true, "canceled": true},
+		},
+		{
+			policy: "any_success",
+			runs:   map[string]bool{"success": true, "reused": true, "skipped": false, "failed": false, "timedOut": false, "canceled": false},
+			mixed:  map[string]bool{"success": true, "reused": true, "skipped": true, "failed": true, "timedOut": true, "canceled": true},
+		},
+	}
+
+	for _, tt := range tests {
+		for outcome, output := range outcomes {
+			t.Run(fmt.Sprintf("%s/%s", tt.policy, outcome), func(t *testing.T) {
+				single := worker.FlowStep{Name: "step", DependsOn: []string{"dep"}, DependencyPolicy: tt.policy}
+				mixed := worker.FlowStep{Name: "step", DependsOn: []string{"dep", "ok"}, DependencyPolicy: tt.policy}
+				executor := createTestExecutor([]worker.FlowStep{single})
+
+				canExecute, reason := executor.evaluateDependencyPolicy(single, map[string]StepOutput{"dep": output})
+				assert.Equal(t, tt.runs[outcome], canExecute, reason)
+
+				canExecute, reason = executor.evaluateDependencyPolicy(mixed, map[string]StepOutput{"dep": output, "ok": {Name: "ok"}})
+				assert.Equal(t, tt.mixed[outcome], canExecute, reason)
+			})
+		}
+	}
+}
+
+// TestDependencyPolicyPropagation tests that failed and skipped outputs reach later steps of
+// multi-level flows and that only failures reaching a fail_fast policy stop the flow
+func TestDependencyPolicyPropagation(t *testing.T) {
+	tests := []struct {
+		name        string
+		steps       []worker.FlowStep
+		failing     []string
+		keepGoing   bool              // Flow-level fail_fast: false
+		wantErr     bool              // Flow stopped by fail_fast
+		wantSuccess bool              // Run succeeded without any failed step
+		want        map[string]string // Step status
+	}{
+		{
+			name: "default_policy_stops_flow",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}},
+				{Name: "c", Type: "debug", DependsOn: []string{"b"}, DependencyPolicy: "all_complete"},
+			},
+			failing: []string{"a"},
+			wantErr: true,
+			want:    map[string]string{"a": "failed", "b": "canceled", "c": "canceled"},
+		},
+		{
+			name: "all_complete_sees_failure",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "all_complete"},
+				{Name: "c", Type: "debug", DependsOn: []string{"b"}},
+			},
+			failing: []string{"a"},
+			want:    map[string]string{"a": "failed", "b": "success", "c": "success"},
+		},
+		{
+			name: "any_success_with_partial_failure",
+			steps: []worker.FlowStep{
+				{Name: "a1", Type: "debug"},
+				{Name: "a2", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a1", "a2"}, DependencyPolicy: "any_success"},
+				{Name: "c", Type: "debug", DependsOn: []string{"b"}, DependencyPolicy: "all_success"},
+			},
+			failing: []string{"a1"},
+			want:    map[string]string{"a1": "failed", "a2": "success", "b": "success", "c": "success"},
+		},
+		{
+			name: "any_success_with_all_failed_skips_downstream",
+			steps: []worker.FlowStep{
+				{Name: "a1", Type: "debug", DependencyPolicy: "all_complete"},
+				{Name: "a2", Type: "debug", DependencyPolicy: "all_complete"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a1", "a2"}, DependencyPolicy: "any_success"},
+				{Name: "c", Type: "debug", DependsOn: []string{"b"}, DependencyPolicy: "all_success"},
+				{Name: "d", Type: "debug", DependsOn: []string{"b"}, DependencyPolicy: "all_complete"},
+			},
+			failing: []string{"a1", "a2"},
+			want:    map[string]string{"a1": "failed", "a2": "failed", "b": "skipped", "c": "skipped", "d": "success"},
+		},
+		{
+			name: "skip_propagates_through_all_success",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "all_success"},
+				{Name: "c", Type: "debug", DependsOn: []string{"b"}, DependencyPolicy: "all_success"},
+				{Name: "d", Type: "debug", DependsOn: []string{"c"}},
+			},
+			failing: []string{"a"},
+			want:    map[string]string{"a": "failed", "b": "skipped", "c": "skipped", "d": "success"},
+		},
+		{
+			name: "handled_failure_does_not_cancel_siblings",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "slow", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "all_complete"},
+				{Name: "c", Type: "debug", DependsOn: []string{"b", "slow"}},
+			},
+			failing: []string{"a"},
+			want:    map[string]string{"a": "failed", "slow": "success", "b": "success", "c": "success"},
+		},
+		{
+			name: "one_fail_fast_dependent_stops_flow",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "slow", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "all_complete"},
+				{Name: "c", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "fail_fast"},
+			},
+			failing: []string{"a"},
+			wantErr: true,
+			want:    map[string]string{"a": "failed", "slow": "canceled", "b": "canceled", "c": "canceled"},
+		},
+		{
+			name: "leaf_failure_stops_flow_whatever_its_policy",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "leaf", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "all_complete"},
+				{Name: "slow", Type: "debug", DependsOn: []string{"a"}},
+			},
+			failing: []string{"leaf"},
+			wantErr: true,
+			want:    map[string]string{"a": "success", "leaf": "failed", "slow": "canceled"},
+		},
+		{
+			name: "leaf_failure_without_flow_fail_fast_completes_with_errors",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "leaf", Type: "debug", DependsOn: []string{"a"}},
+				{Name: "slow", Type: "debug", DependsOn: []string{"a"}},
+			},
+			failing:   []string{"leaf"},
+			keepGoing: true,
+			want:      map[string]string{"a": "success", "leaf": "failed", "slow": "success"},
+		},
+		{
+			name: "fail_fast_dependent_stops_flow_without_flow_fail_fast",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "slow", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}},
+			},
+			failing:   []string{"a"},
+			keepGoing: true,
+			wantErr:   true,
+			want:      map[string]string{"a": "failed", "slow": "canceled", "b": "canceled"},
+		},
+		{
+			name: "all_succeed",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug"},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}, DependencyPolicy: "all_success"},
+				{Name: "c", Type: "debug", DependsOn: []string{"a", "b"}, DependencyPolicy: "any_success"},
+			},
+			wantSuccess: true,
+			want:        map[string]string{"a": "success", "b": "success", "c": "success"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor := createTestExecutor(tt.steps)
+			executor.FailFast = !tt.keepGoing
+			for _, step := range tt.steps {
+				delay := time.Duration(0)
+				if step.Name == "slow" {
+					delay = 300 * time.Millisecond
+				}
+				executor.Agents[step.Name] = createMockAgent(step.Name, slices.Contains(tt.failing, step.Name), delay)
+			}
+
+			result, err := executor.Execute(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantSuccess, result.Success)
+
+			got := make(map[string]string)
+			for _, output := range result.Steps {
+				got[output.Name] = stepStatus(output)
+			}
+			assert.Len(t, result.Steps, len(tt.steps))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 // TestRetryMechanisms tests all retry mechanisms and backoff strategies
 func TestRetryMechanisms(t *testing.T) {
 	tests := []struct {
@@ -507,6 +748,1049 @@ func TestRetryWithFailures(t *testing.T) {
 	})
 }
 
+// TestDisabledStep tests that runtime-disabled steps are skipped and dependency policies still apply
+func TestDisabledStep(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug"},
+		{Name: "strict", Type: "debug", DependsOn: []string{"collect"}, DependencyPolicy: "all_success"},
+		{Name: "lenient", Type: "debug", DependsOn: []string{"collect"}, DependencyPolicy: "all_complete"},
+	}
+
+	runtime := worker.NewWorkerRuntimeInDir(&worker.Worker{Name: "test"}, worker.WorkerSettings{Flow: steps}, t.TempDir())
+	assert.NoError(t, runtime.SetStepEnabled("collect", false))
+
+	collectAgent := new(MockAgent)
+	executor := createTestExecutor(steps)
+	executor.SetWorkerRuntime(runtime)
+	executor.Agents["collect"] = collectAgent
+	executor.Agents["strict"] = createMockAgent("strict", false, 0)
+	executor.Agents["lenient"] = createMockAgent("lenient", false, 0)
+
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+
+	outputs := make(map[string]StepOutput)
+	for _, output := range result.Steps {
+		outputs[output.Name] = output
+	}
+
+	assert.True(t, outputs["collect"].Skipped)
+	assert.Equal(t, "step is disabled", outputs["collect"].Stderr)
+	assert.True(t, outputs["strict"].Skipped)
+	assert.False(t, outputs["lenient"].Skipped)
+	assert.Equal(t, "Success from lenient", outputs["lenient"].Stdout)
+	collectAgent.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestRunHistory tests that each execution is persisted with per-step details
+func TestRunHistory(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug", Input: "collect {{ .step.Name }}"},
+		{Name: "process", Type: "debug", DependsOn: []string{"collect"}, Retry: &worker.RetryConfig{MaxAttempts: 2}},
+		{Name: "report", Type: "debug", DependsOn: []string{"process"}},
+	}
+
+	store := history.NewStore(t.TempDir(), history.Retention{})
+	executor := createTestExecutor(steps)
+	executor.History = store
+	executor.Agents["collect"] = createMockAgent("collect", false, 0)
+	executor.Agents["process"] = createMockAgent("process", true, 0)
+	executor.Agents["report"] = createMockAgent("report", false, 0)
+
+	result, err := executor.Execute(context.Background())
+	assert.Error(t, err)
+
+	records, total, listErr := store.List(0, 0)
+	assert.NoError(t, listErr)
+	assert.Equal(t, 1, total)
+
+	record := records[0]
+	assert.Equal(t, result.RunID, record.ID)
+	assert.Equal(t, history.RunStatusFailed, record.Status)
+	assert.Contains(t, record.Error, "process")
+	assert.False(t, record.End.Before(record.Start))
+
+	assert.Len(t, record.Steps, 3)
+	assert.Equal(t, history.StepStatusSuccess, record.Steps[0].Status)
+	assert.Equal(t, "collect collect", record.Steps[0].Prompt)
+	assert.Equal(t, "Success from collect", record.Steps[0].Stdout)
+	assert.Equal(t, 1, record.Steps[0].Attempts)
+
+	assert.Equal(t, history.StepStatusFailed, record.Steps[1].Status)
+	assert.Equal(t, 2, record.Steps[1].Attempts)
+	assert.Contains(t, record.Steps[1].Stderr, "mock agent failure")
+
+	assert.Equal(t, history.StepStatusCanceled, record.Steps[2].Status)
+	assert.Equal(t, 0, record.Steps[2].Attempts)
+}
+
+// TestScheduledSteps tests that steps with every/cron reuse or skip their output when not due
+func TestScheduledSteps(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "collector", Type: "debug"},
+		{Name: "summary", Type: "debug", Every: "168h"},
+		{Name: "digest", Type: "debug", Cron: "@weekly", NotDuePolicy: worker.NotDuePolicySkip},
+		{Name: "report", Type: "debug", DependsOn: []string{"summary"}, DependencyPolicy: "all_success", Input: "{{ index .inputs 0 }}"},
+	}
+
+	dir := t.TempDir()
+	newExecutor := func() (*FlowExecutor, map[string]*MockAgent) {
+		executor := createTestExecutor(steps)
+		executor.outputCache = newOutputCache(dir)
+		agents := make(map[string]*MockAgent)
+		for _, step := range steps {
+			agents[step.Name] = createMockAgent(step.Name, false, 0)
+			executor.Agents[step.Name] = agents[step.Name]
+		}
+		return executor, agents
+	}
+
+	// First cycle: every step runs and scheduled outputs are persisted
+	executor, agents := newExecutor()
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+	for _, step := range steps {
+		agents[step.Name].AssertNumberOfCalls(t, "Run", 1)
+	}
+	assert.FileExists(t, filepath.Join(dir, StepOutputsFile))
+
+	// Second cycle (fresh executor, as after a restart): scheduled steps are not due
+	executor, agents = newExecutor()
+	result, err = executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+
+	outputs := make(map[string]StepOutput)
+	for _, output := range result.Steps {
+		outputs[output.Name] = output
+	}
+
+	agents["collector"].AssertNumberOfCalls(t, "Run", 1)
+	agents["summary"].AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	agents["digest"].AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+
+	assert.True(t, outputs["summary"].Reused)
+	assert.Equal(t, "Success from summary", outputs["summary"].Stdout)
+	assert.True(t, outputs["digest"].Skipped)
+	assert.Contains(t, outputs["digest"].SkipReason, "not due")
+	assert.False(t, outputs["report"].Skipped)
+	assert.Equal(t, "Success from summary", outputs["report"].Prompt)
+}
+
+// TestOutputCacheRedactsSecrets tests that secret values are not persisted in step_outputs.json
+func TestOutputCacheRedactsSecrets(t *testing.T) {
+	secrets.Register("cache_test_token", "cache-test-secret")
+
+	dir := t.TempDir()
+	cache := newOutputCache(dir)
+	err := cache.put("collect", cachedOutput{LastRun: time.Now(), Stdout: "token=cache-test-secret", Stderr: "cache-test-secret"})
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, StepOutputsFile))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "cache-test-secret")
+
+	cached, ok, err := newOutputCache(dir).get("collect")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "token=[REDACTED:cache_test_token]", cached.Stdout)
+}
+
+// TestStepTimeout tests that attempts exceeding the step timeout are stopped and recorded as timed out
+func TestStepTimeout(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "slow", Type: "debug", Timeout: "50ms", Retry: &worker.RetryConfig{MaxAttempts: 2}},
+	}
+
+	// The agent blocks until its context is canceled, like a hung CLI process
+	slowAgent := new(MockAgent)
+	slowAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(nil, context.DeadlineExceeded).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	})
+
+	runtime := worker.NewWorkerRuntimeInDir(&worker.Worker{Name: "test"}, worker.WorkerSettings{Flow: steps}, t.TempDir())
+
+	store := history.NewStore(t.TempDir(), history.Retention{})
+	executor := createTestExecutor(steps)
+	executor.SetWorkerRuntime(runtime)
+	executor.History = store
+	executor.Agents["slow"] = slowAgent
+
+	start := time.Now()
+	result, err := executor.Execute(context.Background())
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	output := result.Steps[0]
+	assert.True(t, output.TimedOut)
+	assert.True(t, output.Failed)
+	assert.Equal(t, 2, output.Attempts)
+	assert.Contains(t, output.Stderr, "step timed out after 50ms")
+	slowAgent.AssertNumberOfCalls(t, "Run", 2)
+
+	stats := runtime.GetStepStats("slow")
+	assert.Equal(t, 2, stats.TimeoutCount)
+	assert.NotNil(t, stats.LastTimeout)
+
+	records, _, listErr := store.List(0, 0)
+	assert.NoError(t, listErr)
+	assert.Equal(t, history.StepStatusTimedOut, records[0].Steps[0].Status)
+}
+
+// TestStepStderrIsNotAFailure tests that a successful step writing warnings to stderr is recorded as successful
+func TestStepStderrIsNotAFailure(t *testing.T) {
+	steps := []worker.FlowStep{{Name: "lint", Type: "debug"}}
+
+	lintAgent := new(MockAgent)
+	lintAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{
+		Stdout: "ok",
+		Stderr: "warning: deprecated option",
+	}, nil)
+
+	runtime := worker.NewWorkerRuntimeInDir(&worker.Worker{Name: "test"}, worker.WorkerSettings{Flow: steps}, t.TempDir())
+	executor := createTestExecutor(steps)
+	executor.SetWorkerRuntime(runtime)
+	executor.Agents["lint"] = lintAgent
+
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "warning: deprecated option", result.Steps[0].Stderr)
+
+	stats := runtime.GetStepStats("lint")
+	assert.Equal(t, 1, stats.SuccessCount)
+	assert.Equal(t, 0, stats.FailureCount)
+	assert.Nil(t, stats.LastError)
+}
+
+// TestFlowTimeout tests that the flow timeout cancels unfinished steps at every level
+func TestFlowTimeout(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "fast", Type: "debug"},
+		{Name: "slow", Type: "debug", DependencyPolicy: "all_complete"},
+		{Name: "report", Type: "debug", DependsOn: []string{"fast", "slow"}},
+	}
+
+	slowAgent := new(MockAgent)
+	slowAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(nil, context.Canceled).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	})
+	reportAgent := new(MockAgent)
+
+	store := history.NewStore(t.TempDir(), history.Retention{})
+	executor := createTestExecutor(steps)
+	executor.History = store
+	executor.FlowTimeout = 100 * time.Millisecond
+	executor.Agents["fast"] = createMockAgent("fast", false, 0)
+	executor.Agents["slow"] = slowAgent
+	executor.Agents["report"] = reportAgent
+
+	result, err := executor.Execute(context.Background())
+	assert.ErrorIs(t, err, ErrFlowTimedOut)
+	assert.True(t, result.TimedOut)
+	assert.False(t, result.Success)
+
+	outputs := make(map[string]StepOutput)
+	for _, output := range result.Steps {
+		outputs[output.Name] = output
+	}
+	assert.Len(t, outputs, 3)
+	assert.False(t, outputs["fast"].Canceled)
+	assert.Equal(t, "Success from fast", outputs["fast"].Stdout)
+	for _, name := range []string{"slow", "report"} {
+		assert.True(t, outputs[name].Canceled, name)
+		assert.Equal(t, "canceled because the flow timed out", outputs[name].Stderr, name)
+	}
+	reportAgent.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+
+	records, _, listErr := store.List(0, 0)
+	assert.NoError(t, listErr)
+	assert.Equal(t, history.RunStatusTimedOut, records[0].Status)
+	assert.Contains(t, records[0].Error, "flow timed out after 100ms")
+}
+
+// TestGraphScheduling tests that steps start as soon as their own dependencies finish
+func TestGraphScheduling(t *testing.T) {
+	t.Run("fast_branch_does_not_wait_for_slow_sibling", func(t *testing.T) {
+		steps := []worker.FlowStep{
+			{Name: "slow", Type: "debug"},
+			{Name: "fast", Type: "debug"},
+			{Name: "after_fast", Type: "debug", DependsOn: []string{"fast"}},
+			{Name: "join", Type: "debug", DependsOn: []string{"slow", "after_fast"}},
+		}
+
+		var mu sync.Mutex
+		finished := make(map[string]time.Time)
+		executor := createTestExecutor(steps)
+		for _, step := range steps {
+			name := step.Name
+			delay := 10 * time.Millisecond
+			if name == "slow" {
+				delay = 300 * time.Millisecond
+			}
+			mockAgent := new(MockAgent)
+			mockAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(
+				&agent.AgentOutput{Stdout: "Success from " + name}, nil,
+			).Run(func(args mock.Arguments) {
+				time.Sleep(delay)
+				mu.Lock()
+				finished[name] = time.Now()
+				mu.Unlock()
+			})
+			executor.Agents[name] = mockAgent
+		}
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Len(t, result.Steps, 4)
+
+		assert.True(t, finished["after_fast"].Before(finished["slow"]), "after_fast should not wait for slow")
+		assert.True(t, finished["join"].After(finished["slow"]), "join should wait for both dependencies")
+		assert.Equal(t, "join", result.Steps[3].Name)
+	})
+
+	t.Run("max_parallel_limits_running_steps", func(t *testing.T) {
+		steps := []worker.FlowStep{
+			{Name: "step1", Type: "debug"},
+			{Name: "step2", Type: "debug"},
+			{Name: "step3", Type: "debug"},
+			{Name: "step4", Type: "debug"},
+		}
+
+		var mu sync.Mutex
+		running, maxRunning := 0, 0
+		executor := createTestExecutor(steps)
+		executor.MaxParallel = 2
+		for _, step := range steps {
+			mockAgent := new(MockAgent)
+			mockAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(
+				&agent.AgentOutput{Stdout: "Success"}, nil,
+			).Run(func(args mock.Arguments) {
+				mu.Lock()
+				running++
+				maxRunning = max(maxRunning, running)
+				mu.Unlock()
+				time.Sleep(50 * time.Millisecond)
+				mu.Lock()
+				running--
+				mu.Unlock()
+			})
+			executor.Agents[step.Name] = mockAgent
+		}
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Len(t, result.Steps, 4)
+		assert.Equal(t, 2, maxRunning)
+	})
+
+	t.Run("fail_fast_stops_unrelated_branches", func(t *testing.T) {
+		steps := []worker.FlowStep{
+			{Name: "broken", Type: "debug"},
+			{Name: "slow", Type: "debug"},
+			{Name: "after_slow", Type: "debug", DependsOn: []string{"slow"}},
+		}
+
+		afterSlow := new(MockAgent)
+		executor := createTestExecutor(steps)
+		executor.Agents["broken"] = createMockAgent("broken", true, 10*time.Millisecond)
+		executor.Agents["slow"] = createMockAgent("slow", false, time.Second)
+		executor.Agents["after_slow"] = afterSlow
+
+		result, err := executor.Execute(context.Background())
+		assert.Error(t, err)
+		assert.False(t, result.Success)
+
+		outputs := make(map[string]StepOutput)
+		for _, output := range result.Steps {
+			outputs[output.Name] = output
+		}
+		assert.True(t, outputs["broken"].Failed)
+		assert.True(t, outputs["slow"].Canceled)
+		assert.True(t, outputs["after_slow"].Canceled)
+		assert.Equal(t, "canceled due to fail_fast policy", outputs["after_slow"].Stderr)
+		afterSlow.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestRouterStep tests that routers run the selected branches and mark the others as not taken
+func TestRouterStep_BranchNotTaken(t *testing.T) {
+	steps := []worker.FlowStep{
+		{
+			Name:   "triage",
+			Type:   worker.StepTypeRouter,
+			When:   "question",
+			Routes: map[string][]string{"bug": {"fix_bug"}, "question": {"answer"}},
+		},
+		{Name: "fix_bug", Type: "debug", DependsOn: []string{"triage"}},
+		{Name: "verify_fix", Type: "debug", DependsOn: []string{"fix_bug"}},
+		{Name: "release", Type: "debug", DependsOn: []string{"verify_fix"}, DependencyPolicy: "all_complete"},
+		{Name: "answer", Type: "debug", DependsOn: []string{"triage"}},
+		{Name: "reply", Type: "debug", DependsOn: []string{"verify_fix", "answer"}, DependencyPolicy: "any_success"},
+	}
+
+	executor := createTestExecutor(steps)
+	for _, step := range steps[1:] {
+		executor.Agents[step.Name] = createMockAgent(step.Name, false, 0)
+	}
+
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+
+	got := make(map[string]string)
+	for _, output := range result.Steps {
+		got[output.Name] = stepStatus(output)
+	}
+	assert.Equal(t, map[string]string{
+		"triage": "success", "fix_bug": "not_taken", "verify_fix": "not_taken", "release": "not_taken",
+		"answer": "success", "reply": "success",
+	}, got)
+
+	// Every step down the branch is not taken, whatever its dependency policy
+	for _, name := range []string{"fix_bug", "verify_fix", "release"} {
+		executor.Agents[name].(*MockAgent).AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func TestRouterStep(t *testing.T) {
+	newSteps := func(when string) []worker.FlowStep {
+		return []worker.FlowStep{
+			{Name: "collect", Type: "debug"},
+			{
+				Name:      "triage",
+				Type:      worker.StepTypeRouter,
+				DependsOn: []string{"collect"},
+				When:      when,
+				Routes: map[string][]string{
+					"bug":      {"fix_bug"},
+					"question": {"answer"},
+					"feature":  {"plan_feature"},
+					"default":  {"answer"},
+				},
+			},
+			{Name: "fix_bug", Type: "debug", DependsOn: []string{"triage"}},
+			{Name: "answer", Type: "debug", DependsOn: []string{"triage"}},
+			{Name: "plan_feature", Type: "debug", DependsOn: []string{"triage"}},
+			{Name: "reply", Type: "debug", DependsOn: []string{"fix_bug", "answer", "plan_feature"}, DependencyPolicy: "any_success"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		when    string
+		wantErr bool
+		want    map[string]string
+	}{
+		{
+			name: "single_route",
+			when: `{{ if contains "collect" (index .inputs 0) }}bug{{ else }}feature{{ end }}`,
+			want: map[string]string{"collect": "success", "triage": "success", "fix_bug": "success", "answer": "not_taken", "plan_feature": "not_taken", "reply": "success"},
+		},
+		{
+			name: "multiple_routes",
+			when: "bug, feature",
+			want: map[string]string{"collect": "success", "triage": "success", "fix_bug": "success", "answer": "not_taken", "plan_feature": "success", "reply": "success"},
+		},
+		{
+			name: "default_route",
+			when: "{{ if false }}bug{{ end }}",
+			want: map[string]string{"collect": "success", "triage": "success", "fix_bug": "not_taken", "answer": "success", "plan_feature": "not_taken", "reply": "success"},
+		},
+		{
+			name:    "unknown_route",
+			when:    "spam",
+			wantErr: true,
+			want:    map[string]string{"collect": "success", "triage": "failed", "fix_bug": "canceled", "answer": "canceled", "plan_feature": "canceled", "reply": "canceled"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps := newSteps(tt.when)
+			executor := createTestExecutor(steps)
+			for _, step := range steps {
+				if step.Type != worker.StepTypeRouter {
+					executor.Agents[step.Name] = createMockAgent(step.Name, false, 0)
+				}
+			}
+
+			result, err := executor.Execute(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, result.Success)
+			}
+
+			got := make(map[string]string)
+			outputs := make(map[string]StepOutput)
+			for _, step := range steps {
+				got[step.Name] = ""
+			}
+			for _, output := range result.Steps {
+				got[output.Name] = stepStatus(output)
+				outputs[output.Name] = output
+			}
+			assert.Equal(t, tt.want, got)
+
+			for name, status := range tt.want {
+				if status == history.StepStatusNotTaken {
+					assert.True(t, outputs[name].Skipped)
+					assert.Equal(t, "route not taken by router triage", outputs[name].SkipReason)
+					executor.Agents[name].(*MockAgent).AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+				}
+			}
+		})
+	}
+}
+
+// TestForEachStep tests that for_each runs the agent once per item and aggregates the outputs
+func TestForEachStep(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug"},
+		{
+			Name:        "process",
+			Type:        "debug",
+			DependsOn:   []string{"collect"},
+			ForEach:     "{{ index .inputs 0 }}",
+			Concurrency: 2,
+			Input:       "{{ .index }}: {{ .item.title }}",
+			Output:      "{{ .stdout | upper }}",
+		},
+	}
+
+	newExecutor := func(failing string, concurrency int) (*FlowExecutor, *MockAgent) {
+		collectAgent := new(MockAgent)
+		collectAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(
+			&agent.AgentOutput{Stdout: `[{"title": "a"}, {"title": "b"}, {"title": "c"}]`}, nil,
+		)
+
+		processAgent := new(MockAgent)
+		for i, title := range []string{"a", "b", "c"} {
+			prompt := fmt.Sprintf("%d: %s", i, title)
+			if title == failing {
+				processAgent.On("Run", mock.Anything, prompt, mock.Anything).Return(nil, fmt.Errorf("mock agent failure"))
+				continue
+			}
+			processAgent.On("Run", mock.Anything, prompt, mock.Anything).Return(&agent.AgentOutput{Stdout: "done " + title}, nil)
+		}
+
+		itemSteps := slices.Clone(steps)
+		itemSteps[1].Concurrency = concurrency
+		executor := createTestExecutor(itemSteps)
+		executor.Agents["collect"] = collectAgent
+		executor.Agents["process"] = processAgent
+		return executor, processAgent
+	}
+
+	t.Run("aggregates_item_outputs", func(t *testing.T) {
+		executor, processAgent := newExecutor("", 2)
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		output := result.Steps[1]
+		assert.Equal(t, "process", output.Name)
+		assert.JSONEq(t, `[
+			{"index": 0, "item": {"title": "a"}, "status": "success", "output": "DONE A"},
+			{"index": 1, "item": {"title": "b"}, "status": "success", "output": "DONE B"},
+			{"index": 2, "item": {"title": "c"}, "status": "success", "output": "DONE C"}
+		]`, output.Stdout)
+		assert.JSONEq(t, `["0: a", "1: b", "2: c"]`, output.Prompt)
+		assert.Equal(t, 3, output.Attempts)
+		processAgent.AssertNumberOfCalls(t, "Run", 3)
+	})
+
+	t.Run("failed_item_fails_step", func(t *testing.T) {
+		executor, _ := newExecutor("b", 2)
+
+		result, err := executor.Execute(context.Background())
+		assert.Error(t, err)
+		assert.True(t, result.Steps[1].Failed)
+		assert.Contains(t, result.Steps[1].Stderr, "item 1: mock agent failure")
+
+		var items []forEachItem
+		assert.NoError(t, json.Unmarshal([]byte(result.Steps[1].Stdout), &items))
+		assert.Len(t, items, 3)
+		assert.Equal(t, "failed", items[1].Status)
+		assert.Contains(t, items[1].Error, "mock agent failure")
+		for _, index := range []int{0, 2} {
+			// Whether the other items finished before the failure depends on scheduling
+			assert.Contains(t, []string{"success", "canceled", "skipped"}, items[index].Status)
+		}
+	})
+
+	t.Run("items_after_failure_are_skipped", func(t *testing.T) {
+		executor, processAgent := newExecutor("a", 1)
+
+		result, err := executor.Execute(context.Background())
+		assert.Error(t, err)
+		assert.True(t, result.Steps[1].Failed)
+		assert.JSONEq(t, `[
+			{"index": 0, "item": {"title": "a"}, "status": "failed", "error": "mock agent failure"},
+			{"index": 1, "item": {"title": "b"}, "status": "skipped"},
+			{"index": 2, "item": {"title": "c"}, "status": "skipped"}
+		]`, result.Steps[1].Stdout)
+		processAgent.AssertNumberOfCalls(t, "Run", 1)
+	})
+
+	t.Run("invalid_array_fails_step", func(t *testing.T) {
+		invalid := []worker.FlowStep{{Name: "process", Type: "debug", ForEach: "not json"}}
+		executor := createTestExecutor(invalid)
+		executor.Agents["process"] = new(MockAgent)
+
+		result, err := executor.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Contains(t, result.Steps[0].Stderr, "for_each must render a JSON array")
+	})
+
+	t.Run("empty_array_succeeds", func(t *testing.T) {
+		empty := []worker.FlowStep{{Name: "process", Type: "debug", ForEach: "[]"}}
+		executor := createTestExecutor(empty)
+		processAgent := new(MockAgent)
+		executor.Agents["process"] = processAgent
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", result.Steps[0].Stdout)
+		processAgent.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestLoopStep tests that loop steps re-run their agent until the until condition holds
+func TestLoopStep(t *testing.T) {
+	steps := []worker.FlowStep{
+		{
+			Name:          "refine",
+			Type:          "debug",
+			Input:         "draft {{ .iteration }} after '{{ .previous }}'",
+			Until:         `{{ contains "LGTM" .stdout }}`,
+			MaxIterations: 3,
+		},
+	}
+
+	newExecutor := func(outputs ...string) (*FlowExecutor, *MockAgent) {
+		refineAgent := new(MockAgent)
+		previous := ""
+		for i, stdout := range outputs {
+			prompt := fmt.Sprintf("draft %d after '%s'", i+1, previous)
+			refineAgent.On("Run", mock.Anything, prompt, mock.Anything).Return(&agent.AgentOutput{Stdout: stdout}, nil)
+			previous = stdout
+		}
+
+		executor := createTestExecutor(steps)
+		executor.Agents["refine"] = refineAgent
+		executor.WorkerRuntime = worker.NewWorkerRuntime(&worker.Worker{Name: "test"}, worker.WorkerSettings{Flow: steps})
+		return executor, refineAgent
+	}
+
+	t.Run("stops_when_condition_holds", func(t *testing.T) {
+		executor, refineAgent := newExecutor("needs work", "LGTM")
+		store := history.NewStore(t.TempDir(), history.Retention{})
+		executor.History = store
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		output := result.Steps[0]
+		assert.Equal(t, "LGTM", output.Stdout)
+		assert.Equal(t, "draft 2 after 'needs work'", output.Prompt)
+		assert.Equal(t, 2, output.Attempts)
+		assert.Len(t, output.Iterations, 2)
+		refineAgent.AssertNumberOfCalls(t, "Run", 2)
+
+		stats := executor.WorkerRuntime.SnapshotStepStats()["refine"]
+		assert.Equal(t, 2, stats.Iteration)
+		assert.Equal(t, 2, stats.TotalIterations)
+
+		records, _, listErr := store.List(0, 0)
+		assert.NoError(t, listErr)
+		iterations := records[0].Steps[0].Iterations
+		assert.Len(t, iterations, 2)
+		assert.Equal(t, 1, iterations[0].Iteration)
+		assert.Equal(t, "needs work", iterations[0].Stdout)
+		assert.Equal(t, "draft 1 after ''", iterations[0].Prompt)
+		assert.Equal(t, "LGTM", iterations[1].Stdout)
+	})
+
+	t.Run("fails_after_max_iterations", func(t *testing.T) {
+		executor, refineAgent := newExecutor("one", "two", "three")
+
+		result, err := executor.Execute(context.Background())
+		assert.Error(t, err)
+		assert.False(t, result.Success)
+
+		output := result.Steps[0]
+		assert.True(t, output.Failed)
+		assert.Len(t, output.Iterations, 3)
+		assert.Equal(t, "three", output.Iterations[2].Stdout)
+		assert.Contains(t, output.Stderr, "until condition not met after 3 iterations")
+		refineAgent.AssertNumberOfCalls(t, "Run", 3)
+	})
+
+	t.Run("failed_iteration_feeds_until_and_previous", func(t *testing.T) {
+		steps := []worker.FlowStep{
+			{
+				Name:  "fix",
+				Type:  "debug",
+				Input: "fix {{ .iteration }} after '{{ .previous }}' ({{ .previous_exit_code }})",
+				Until: "{{ eq .exit_code 0 }}",
+			},
+		}
+		fixAgent := new(MockAgent)
+		fixAgent.On("Run", mock.Anything, "fix 1 after '' (0)", mock.Anything).
+			Return(&agent.AgentOutput{Stdout: "FAIL: TestParse", ExitCode: 1}, errors.New("exit status 1"))
+		fixAgent.On("Run", mock.Anything, "fix 2 after 'FAIL: TestParse' (1)", mock.Anything).
+			Return(&agent.AgentOutput{Stdout: "ok"}, nil)
+
+		executor := createTestExecutor(steps)
+		executor.Agents["fix"] = fixAgent
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+
+		output := result.Steps[0]
+		assert.Equal(t, "ok", output.Stdout)
+		assert.Len(t, output.Iterations, 2)
+		assert.Equal(t, 1, output.Iterations[0].ExitCode)
+		assert.Equal(t, "exit status 1", output.Iterations[0].Error)
+		fixAgent.AssertNumberOfCalls(t, "Run", 2)
+	})
+}
+
+// TestLoopStepWithSteps tests that loop steps run their steps in order in every iteration
+// and that a failing step ends the iteration instead of the loop
+func TestLoopStepWithSteps(t *testing.T) {
+	steps := []worker.FlowStep{
+		{
+			Name:          "green",
+			Type:          worker.StepTypeLoop,
+			Until:         "{{ eq .exit_code 0 }}",
+			MaxIterations: 3,
+			Steps: []worker.FlowStep{
+				{Name: "implement", Type: "debug", Input: "implement {{ .iteration }}: {{ .previous }}"},
+				{Name: "test", Type: "debug", Input: "test {{ .loop.implement.stdout }}"},
+			},
+		},
+		{Name: "report", Type: "debug", DependsOn: []string{"green"}, Input: "report {{ index .inputs 0 }}"},
+	}
+
+	implementAgent := new(MockAgent)
+	implementAgent.On("Run", mock.Anything, "implement 1: ", mock.Anything).Return(&agent.AgentOutput{Stdout: "v1"}, nil)
+	implementAgent.On("Run", mock.Anything, "implement 2: FAIL: TestParse", mock.Anything).Return(&agent.AgentOutput{Stdout: "v2"}, nil)
+	testAgent := new(MockAgent)
+	testAgent.On("Run", mock.Anything, "test v1", mock.Anything).
+		Return(&agent.AgentOutput{Stdout: "FAIL: TestParse", ExitCode: 1}, errors.New("exit status 1"))
+	testAgent.On("Run", mock.Anything, "test v2", mock.Anything).Return(&agent.AgentOutput{Stdout: "PASS"}, nil)
+	reportAgent := new(MockAgent)
+	reportAgent.On("Run", mock.Anything, "report PASS", mock.Anything).Return(&agent.AgentOutput{Stdout: "done"}, nil)
+
+	executor := createTestExecutor(steps)
+	executor.Agents["implement"] = implementAgent
+	executor.Agents["test"] = testAgent
+	executor.Agents["report"] = reportAgent
+
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+
+	green := result.Steps[0]
+	assert.Equal(t, "green", green.Name)
+	assert.Equal(t, "PASS", green.Stdout)
+	assert.Equal(t, 4, green.Attempts)
+	assert.Len(t, green.Iterations, 2)
+
+	first := green.Iterations[0]
+	assert.Equal(t, "FAIL: TestParse", first.Stdout)
+	assert.Equal(t, 1, first.ExitCode)
+	assert.Equal(t, "step test: exit status 1", first.Error)
+	assert.Equal(t, []string{"success", "failed"}, []string{first.Steps[0].Status, first.Steps[1].Status})
+	assert.Equal(t, "v2", green.Iterations[1].Steps[0].Stdout)
+
+	implementAgent.AssertNumberOfCalls(t, "Run", 2)
+	testAgent.AssertNumberOfCalls(t, "Run", 2)
+	reportAgent.AssertNumberOfCalls(t, "Run", 1)
+}
+
+// TestShellStep tests templated args and env of shell steps and their exit codes
+func TestShellStep(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug"},
+		{
+			Name:      "build",
+			Type:      agent.AgentTypeShell,
+			DependsOn: []string{"collect"},
+			Args:      []string{"go", "test", "{{ index .inputs 0 }}"},
+			Env:       map[string]string{"STEP": "{{ .step.Name }}"},
+			Retry:     &worker.RetryConfig{MaxAttempts: 2},
+		},
+		{
+			Name:             "report",
+			Type:             "debug",
+			DependsOn:        []string{"build"},
+			DependencyPolicy: worker.DependencyPolicyAllComplete,
+			Input:            "exit {{ index .exit_codes 0 }}",
+		},
+	}
+
+	renderedCommand := mock.MatchedBy(func(options agent.RunOptions) bool {
+		return slices.Equal(options.Args, []string{"go", "test", "./..."}) && options.Env["STEP"] == "build"
+	})
+
+	newExecutor := func(buildAgent *MockAgent) (*FlowExecutor, *MockAgent) {
+		collectAgent := new(MockAgent)
+		collectAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: "./..."}, nil)
+
+		reportAgent := new(MockAgent)
+		reportAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: "reported"}, nil)
+
+		executor := createTestExecutor(steps)
+		executor.Agents["collect"] = collectAgent
+		executor.Agents["build"] = buildAgent
+		executor.Agents["report"] = reportAgent
+		return executor, reportAgent
+	}
+
+	t.Run("non_zero_exit_is_retried", func(t *testing.T) {
+		buildAgent := new(MockAgent)
+		buildAgent.On("Run", mock.Anything, "", renderedCommand).Return(
+			&agent.AgentOutput{Stderr: "FAIL", ExitCode: 1}, fmt.Errorf("command exited with code 1: FAIL"),
+		).Once()
+		buildAgent.On("Run", mock.Anything, "", renderedCommand).Return(&agent.AgentOutput{Stdout: "PASS"}, nil).Once()
+		executor, reportAgent := newExecutor(buildAgent)
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "PASS", result.Steps[1].Stdout)
+		assert.Equal(t, 2, result.Steps[1].Attempts)
+		reportAgent.AssertCalled(t, "Run", mock.Anything, "exit 0", mock.Anything)
+	})
+
+	t.Run("exit_code_reaches_dependents", func(t *testing.T) {
+		buildAgent := new(MockAgent)
+		buildAgent.On("Run", mock.Anything, "", renderedCommand).Return(
+			&agent.AgentOutput{Stderr: "FAIL", ExitCode: 2}, fmt.Errorf("command exited with code 2: FAIL"),
+		)
+		executor, reportAgent := newExecutor(buildAgent)
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.True(t, result.Steps[1].Failed)
+		assert.Equal(t, 2, result.Steps[1].ExitCode)
+		assert.Contains(t, result.Steps[1].Stderr, "command exited with code 2")
+		reportAgent.AssertCalled(t, "Run", mock.Anything, "exit 2", mock.Anything)
+	})
+}
+
+// TestHTTPStep tests templated requests of http steps and their responses in templates
+func TestHTTPStep(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request fails to exercise the retry configuration
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Path", r.URL.Path)
+		_, _ = fmt.Fprintf(w, "%s %s", r.Header.Get("X-Step"), body)
+	}))
+	defer server.Close()
+
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug"},
+		{
+			Name:      "notify",
+			Type:      agent.AgentTypeHTTP,
+			DependsOn: []string{"collect"},
+			HTTP: &worker.HTTPRequest{
+				Method:  "POST",
+				URL:     server.URL + "/hooks/{{ .step.Name }}",
+				Headers: map[string]string{"X-Step": "{{ .step.Name }}"},
+				Body:    "{{ index .inputs 0 }}",
+			},
+			Retry:  &worker.RetryConfig{MaxAttempts: 2},
+			Output: "{{ .status }} {{ .body }}",
+		},
+		{
+			Name:      "report",
+			Type:      "debug",
+			DependsOn: []string{"notify"},
+			Input:     `{{ $response := index .responses 0 }}{{ $response.status }} {{ index $response.headers "X-Path" }}`,
+		},
+	}
+
+	collectAgent := new(MockAgent)
+	collectAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: "3 new issues"}, nil)
+	reportAgent := new(MockAgent)
+	reportAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: "reported"}, nil)
+
+	executor := createTestExecutor(steps)
+	executor.Agents["collect"] = collectAgent
+	executor.Agents["report"] = reportAgent
+
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+
+	output := result.Steps[1]
+	assert.Equal(t, "notify", output.Name)
+	assert.Equal(t, 2, output.Attempts)
+	assert.Equal(t, "200 notify 3 new issues", output.Stdout)
+	reportAgent.AssertCalled(t, "Run", mock.Anything, "200 /hooks/notify", mock.Anything)
+}
+
+// TestMCPCallStep tests that mcp_call steps get their rendered tool arguments
+func TestMCPCallStep(t *testing.T) {
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug"},
+		{
+			Name:      "mark_read",
+			Type:      agent.AgentTypeMCPCall,
+			DependsOn: []string{"collect"},
+			MCP: &worker.MCPCall{
+				Server:    "github",
+				Tool:      "mark_notification_read",
+				Arguments: `{"id": {{ index .inputs 0 | toJson }}}`,
+			},
+		},
+	}
+
+	collectAgent := new(MockAgent)
+	collectAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: "n-42"}, nil)
+	callAgent := new(MockAgent)
+	callAgent.On("Run", mock.Anything, "", mock.MatchedBy(func(options agent.RunOptions) bool {
+		call := options.MCPCall
+		return call != nil && call.Server == "github" && call.Tool == "mark_notification_read" && call.Arguments == `{"id": "n-42"}`
+	})).Return(&agent.AgentOutput{Stdout: "marked"}, nil)
+
+	executor := createTestExecutor(steps)
+	executor.MCPServers = map[string]worker.MCPServer{"github": {Command: "github-mcp-server"}}
+	executor.Agents["collect"] = collectAgent
+	executor.Agents["mark_read"] = callAgent
+
+	result, err := executor.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "marked", result.Steps[1].Stdout)
+}
+
+// TestOutputSchema tests JSON extraction, validation and corrective re-prompts for output_schema
+func TestOutputSchema(t *testing.T) {
+	steps := []worker.FlowStep{
+		{
+			Name:  "collector",
+			Type:  "debug",
+			Input: "collect",
+			OutputSchema: map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"items"},
+				"properties": map[string]interface{}{
+					"items": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+				},
+			},
+		},
+		{
+			Name:      "report",
+			Type:      "debug",
+			DependsOn: []string{"collector"},
+			Input:     "{{ range .outputs.collector.items }}{{ . }};{{ end }}",
+		},
+	}
+
+	corrective := mock.MatchedBy(func(prompt string) bool {
+		return strings.HasPrefix(prompt, "collect\n\nYour previous response was rejected: output does not match output_schema: /items:")
+	})
+
+	newExecutor := func(collector *MockAgent) (*FlowExecutor, *MockAgent) {
+		reportAgent := new(MockAgent)
+		reportAgent.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: "reported"}, nil)
+
+		executor := createTestExecutor(steps)
+		executor.Agents["collector"] = collector
+		executor.Agents["report"] = reportAgent
+		return executor, reportAgent
+	}
+
+	t.Run("corrective_reprompt", func(t *testing.T) {
+		collector := new(MockAgent)
+		collector.On("Run", mock.Anything, "collect", mock.Anything).Return(&agent.AgentOutput{Stdout: `Here you go: {"items": 5}`}, nil)
+		collector.On("Run", mock.Anything, corrective, mock.Anything).Return(
+			&agent.AgentOutput{Stdout: "Sure:\n```json\n{\"items\": [\"a\", \"b\"]}\n```"}, nil,
+		)
+		executor, reportAgent := newExecutor(collector)
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, `{"items": ["a", "b"]}`, result.Steps[0].Stdout)
+		assert.Equal(t, 2, result.Steps[0].Attempts)
+		reportAgent.AssertCalled(t, "Run", mock.Anything, "a;b;", mock.Anything)
+	})
+
+	t.Run("invalid_output_fails_step", func(t *testing.T) {
+		collector := new(MockAgent)
+		collector.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(&agent.AgentOutput{Stdout: `{"items": 5}`}, nil)
+		executor, reportAgent := newExecutor(collector)
+
+		result, err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, ErrOutputSchema)
+		assert.True(t, result.Steps[0].Failed)
+		assert.Equal(t, 2, result.Steps[0].Attempts)
+		reportAgent.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("agent_failure_is_not_retried", func(t *testing.T) {
+		collector := new(MockAgent)
+		collector.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("agent crashed"))
+		executor, _ := newExecutor(collector)
+
+		result, err := executor.Execute(context.Background())
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrOutputSchema)
+		assert.True(t, result.Steps[0].Failed)
+		assert.Equal(t, 1, result.Steps[0].Attempts)
+		collector.AssertNumberOfCalls(t, "Run", 1)
+	})
+}
+
+// TestExtractJSON tests extraction of JSON values from agent output
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{name: "whole output", text: "  [1, 2]\n", want: "[1, 2]"},
+		{name: "surrounded by prose", text: `The result is {"ok": true}. Done.`, want: `{"ok": true}`},
+		{name: "fenced block", text: "```json\n{\"a\": {\"b\": 1}}\n```", want: `{"a": {"b": 1}}`},
+		{name: "skips invalid candidates", text: `[draft] final: {"ok": 1}`, want: `{"ok": 1}`},
+		{name: "no JSON", text: "nothing here", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, raw, err := extractJSON(tt.text)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, raw)
+		})
+	}
+}
+
 // TestParallelExecution tests parallel execution behavior
 func TestParallelExecution(t *testing.T) {
 	t.Run("parallel_steps_execute_concurrently", func(t *testing.T) {
@@ -693,6 +1977,53 @@ func TestCyclicDependencies(t *testing.T) {
 	}
 }
 
+// TestFlowValidation tests that the executor rejects invalid flows before running any step
+func TestFlowValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []worker.FlowStep
+		wantErr string
+	}{
+		{
+			name:    "unknown_type",
+			steps:   []worker.FlowStep{{Name: "a", Type: "gemnii"}},
+			wantErr: `step a: unknown type "gemnii"`,
+		},
+		{
+			name: "dependency_cycle",
+			steps: []worker.FlowStep{
+				{Name: "a", Type: "debug", DependsOn: []string{"b"}},
+				{Name: "b", Type: "debug", DependsOn: []string{"a"}},
+			},
+			wantErr: "dependency cycle: a -> b -> a",
+		},
+		{
+			name:    "invalid_dependency_policy",
+			steps:   []worker.FlowStep{{Name: "a", Type: "debug", DependencyPolicy: "all_good"}},
+			wantErr: `step a: invalid dependency_policy "all_good"`,
+		},
+		{
+			name:    "invalid_template",
+			steps:   []worker.FlowStep{{Name: "a", Type: "debug", Input: "{{ .inputs }"}},
+			wantErr: "step a: invalid input template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			executor := createTestExecutor(tt.steps)
+			stepAgent := new(MockAgent)
+			for _, step := range tt.steps {
+				executor.Agents[step.Name] = stepAgent
+			}
+
+			_, err := executor.Execute(context.Background())
+			assert.ErrorContains(t, err, "flow validation failed: "+tt.wantErr)
+			stepAgent.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
 // TestEdgeCases tests various edge cases
 func TestEdgeCases(t *testing.T) {
 	t.Run("empty_flow", func(t *testing.T) {
@@ -0,0 +1,208 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"autoteam/internal/agent"
+	"autoteam/internal/history"
+	"autoteam/internal/logger"
+	"autoteam/internal/worker"
+
+	"go.uber.org/zap"
+)
+
+// forEachItem is the outcome of a single item in the output of a for_each step
+type forEachItem struct {
+	Index  int         `json:"index"`
+	Item   interface{} `json:"item"`
+	Status string      `json:"status"` // success, failed, canceled or skipped (never started)
+	Output string      `json:"output,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// executeForEach runs the agent of a step once per item of the JSON array rendered by its
+// for_each template, with at most step.Concurrency items at once. The outcome of every item
+// is aggregated into a JSON array in item order. The first failed item fails the step and
+// cancels the items still running. All items run in the working directory of the step,
+// where the agent configuration (e.g. MCP settings) lives.
+func (fe *FlowExecutor) executeForEach(ctx context.Context, step worker.FlowStep, stepAgent agent.Agent, inputData map[string]interface{}, runOptions agent.RunOptions) (*StepOutput, error) {
+	lgr := logger.FromContext(ctx)
+	startTime := time.Now()
+
+	items, err := fe.forEachItems(step, inputData)
+	if err != nil {
+		return nil, fmt.Errorf("step %s: %w", step.Name, err)
+	}
+
+	concurrency := step.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	lgr.Info("Running step for each item",
+		zap.String("step_name", step.Name),
+		zap.Int("items", len(items)),
+		zap.Int("concurrency", concurrency))
+
+	itemCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]forEachItem, len(items))
+	for index, item := range items {
+		results[index] = forEachItem{Index: index, Item: item, Status: history.StepStatusSkipped}
+	}
+	stderrs := make([]string, len(items))
+	prompts := make([]string, len(items))
+	parsed := make([]interface{}, len(items))
+	attempts := 0
+	timedOut := false
+	var firstErr error
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+
+	for index, item := range items {
+		// Wait for a free slot, or stop starting items once one has failed
+		select {
+		case slots <- struct{}{}:
+		case <-itemCtx.Done():
+		}
+		if itemCtx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(index int, item interface{}) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			data := maps.Clone(inputData)
+			data["item"] = item
+			data["index"] = index
+
+			var run agentRun
+			prompt, err := fe.renderPrompt(itemCtx, step, data)
+			lgr.Debug("Running step item",
+				zap.String("step_name", step.Name),
+				zap.Int("index", index),
+				zap.String("prompt", prompt))
+			if err != nil {
+				run.err = err
+			} else if itemOptions, err := fe.renderRunOptions(step, data, runOptions); err != nil {
+				run.err = err
+			} else {
+				run = fe.runWithRetries(itemCtx, step, stepAgent, prompt, itemOptions)
+			}
+
+			var stdout string
+			if run.err == nil {
+				stdout, run.err = fe.transformOutput(itemCtx, step, run.output)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			prompts[index] = prompt
+			attempts += run.attempts
+			if run.err != nil {
+				// Items stopped because another item failed or the flow was canceled did not fail
+				if firstErr != nil || ctx.Err() != nil {
+					results[index].Status = history.StepStatusCanceled
+					return
+				}
+				firstErr = fmt.Errorf("item %d: %w", index, run.err)
+				timedOut = run.timedOut
+				cancel()
+				results[index].Status = history.StepStatusFailed
+				results[index].Error = run.err.Error()
+				return
+			}
+			results[index].Status = history.StepStatusSuccess
+			results[index].Output = stdout
+			parsed[index] = run.parsed
+			stderrs[index] = run.output.Stderr
+		}(index, item)
+	}
+	wg.Wait()
+
+	prompt := marshalStrings(prompts)
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		if fe.WorkerRuntime != nil {
+			errorMsg := firstErr.Error()
+			fe.WorkerRuntime.RecordStepExecution(step.Name, false, nil, &errorMsg, time.Since(startTime))
+		}
+		return &StepOutput{
+			Name:     step.Name,
+			Stdout:   marshalItems(results),
+			Failed:   true,
+			TimedOut: timedOut,
+			Prompt:   prompt,
+			Attempts: attempts,
+			Duration: time.Since(startTime),
+		}, fmt.Errorf("agent execution failed for step %s: %w", step.Name, firstErr)
+	}
+
+	var stderr []string
+	for _, s := range stderrs {
+		if s != "" {
+			stderr = append(stderr, s)
+		}
+	}
+
+	lgr.Info("Step completed",
+		zap.String("step_name", step.Name),
+		zap.Int("items", len(items)),
+		zap.Bool("success", true))
+
+	output := StepOutput{
+		Name:     step.Name,
+		Stdout:   marshalItems(results),
+		Stderr:   strings.Join(stderr, "\n"),
+		Prompt:   prompt,
+		Attempts: attempts,
+	}
+	if len(step.OutputSchema) > 0 {
+		output.Parsed = parsed // Every item matched the schema
+	}
+	return fe.completeStep(ctx, step, startTime, output), nil
+}
+
+// forEachItems renders the for_each template of a step and decodes the JSON array
+func (fe *FlowExecutor) forEachItems(step worker.FlowStep, inputData map[string]interface{}) ([]interface{}, error) {
+	rendered, err := fe.applyTemplate(step.ForEach, inputData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate for_each template: %w", err)
+	}
+
+	var items []interface{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(rendered)), &items); err != nil {
+		return nil, fmt.Errorf("for_each must render a JSON array: %w", err)
+	}
+	return items, nil
+}
+
+// marshalItems encodes the outcomes of the items as a JSON array
+func marshalItems(items []forEachItem) string {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return "[]" // Items were decoded from JSON
+	}
+	return string(data)
+}
+
+// marshalStrings encodes per-item values, such as the prompts, as a JSON array
+func marshalStrings(values []string) string {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "[]" // Cannot happen for strings
+	}
+	return string(data)
+}
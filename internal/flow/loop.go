@@ -0,0 +1,286 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"autoteam/internal/agent"
+	"autoteam/internal/history"
+	"autoteam/internal/logger"
+	"autoteam/internal/worker"
+
+	"go.uber.org/zap"
+)
+
+// iterationResult is the outcome of a single loop iteration, which is the outcome of the
+// last step run in the iteration
+type iterationResult struct {
+	prompt   string
+	stdout   string
+	output   *agent.AgentOutput // Nil if the agent returned no output
+	parsed   interface{}
+	attempts int
+	timedOut bool
+	err      error                // Why the iteration failed; the loop continues
+	steps    []history.StepRecord // Steps run in the iteration of a loop step with steps
+}
+
+// stderr returns the stderr of the iteration
+func (r iterationResult) stderr() string {
+	if r.output == nil {
+		return ""
+	}
+	return r.output.Stderr
+}
+
+// exitCode returns the exit code of the iteration
+func (r iterationResult) exitCode() int {
+	if r.output == nil {
+		return 0
+	}
+	return r.output.ExitCode
+}
+
+// errorMessage returns why the iteration failed, or an empty string
+func (r iterationResult) errorMessage() string {
+	if r.err == nil {
+		return ""
+	}
+	return r.err.Error()
+}
+
+// executeLoop re-runs a step until its until template renders "true" for the outcome of an
+// iteration. An iteration runs the agent of the step, or the steps of a loop step one after
+// another. A failed iteration does not fail the step: its output, exit code and error are
+// passed to the until template and to the next iteration as .previous. The step fails if
+// the condition is still false after the last iteration.
+func (fe *FlowExecutor) executeLoop(ctx context.Context, step worker.FlowStep, stepAgent agent.Agent, inputData map[string]interface{}, runOptions agent.RunOptions) (*StepOutput, error) {
+	lgr := logger.FromContext(ctx)
+	startTime := time.Now()
+	maxIterations := step.GetMaxIterations()
+
+	var iterations []history.IterationRecord
+	var previous iterationResult
+	attempts := 0
+
+	// failed builds the output of a loop that stopped without meeting its condition
+	failed := func(result iterationResult, err error) (*StepOutput, error) {
+		if fe.WorkerRuntime != nil {
+			errorMsg := err.Error()
+			fe.WorkerRuntime.RecordStepExecution(step.Name, false, nil, &errorMsg, time.Since(startTime))
+		}
+		return &StepOutput{
+			Name:       step.Name,
+			Stdout:     result.stdout,
+			Failed:     true,
+			TimedOut:   result.timedOut,
+			Prompt:     result.prompt,
+			Attempts:   attempts,
+			ExitCode:   result.exitCode(),
+			Duration:   time.Since(startTime),
+			Iterations: iterations,
+		}, fmt.Errorf("agent execution failed for step %s: %w", step.Name, err)
+	}
+
+	for iteration := 1; iteration <= maxIterations; iteration++ {
+		iterationStart := time.Now()
+
+		data := maps.Clone(inputData)
+		data["iteration"] = iteration
+		data["previous"] = previous.stdout
+		data["previous_exit_code"] = previous.exitCode()
+		data["previous_error"] = previous.errorMessage()
+
+		lgr.Info("Running loop iteration",
+			zap.String("step_name", step.Name),
+			zap.Int("iteration", iteration),
+			zap.Int("max_iterations", maxIterations))
+
+		result, err := fe.runIteration(ctx, step, stepAgent, data, runOptions)
+		attempts += result.attempts
+		if err != nil {
+			return failed(result, fmt.Errorf("iteration %d: %w", iteration, err))
+		}
+
+		iterations = append(iterations, history.IterationRecord{
+			Iteration: iteration,
+			Prompt:    result.prompt,
+			Stdout:    result.stdout,
+			Stderr:    result.stderr(),
+			ExitCode:  result.exitCode(),
+			Error:     result.errorMessage(),
+			Attempts:  result.attempts,
+			Duration:  time.Since(iterationStart),
+			Steps:     result.steps,
+		})
+		if fe.WorkerRuntime != nil {
+			fe.WorkerRuntime.RecordStepIteration(step.Name, iteration, result.stdout)
+		}
+		if result.err != nil {
+			lgr.Warn("Loop iteration failed",
+				zap.String("step_name", step.Name),
+				zap.Int("iteration", iteration),
+				zap.Error(result.err))
+		}
+		previous = result
+
+		done, err := fe.evaluateUntil(step, data, result)
+		if err != nil {
+			return failed(result, err)
+		}
+		if done {
+			lgr.Info("Step completed",
+				zap.String("step_name", step.Name),
+				zap.Int("iterations", iteration),
+				zap.Bool("success", true))
+
+			output := StepOutput{
+				Name:       step.Name,
+				Stdout:     result.stdout,
+				Stderr:     result.stderr(),
+				Prompt:     result.prompt,
+				Attempts:   attempts,
+				ExitCode:   result.exitCode(),
+				Parsed:     result.parsed,
+				Iterations: iterations,
+			}
+			if result.output != nil {
+				output.Response = result.output.Response
+			}
+			return fe.completeStep(ctx, step, startTime, output), nil
+		}
+	}
+
+	err := fmt.Errorf("until condition not met after %d iterations", maxIterations)
+	if previous.err != nil {
+		err = fmt.Errorf("%w; last iteration failed: %w", err, previous.err)
+	}
+	return failed(previous, err)
+}
+
+// runIteration runs a loop iteration: the agent of the step, or the steps of a loop step
+// one after another until one of them fails. Each step of a loop step sees the outputs of
+// the steps before it in the iteration as .loop. Only template errors and the cancellation
+// of the flow are returned as errors; other failures end the iteration and are reported
+// in the result.
+func (fe *FlowExecutor) runIteration(ctx context.Context, step worker.FlowStep, stepAgent agent.Agent, data map[string]interface{}, runOptions agent.RunOptions) (iterationResult, error) {
+	if len(step.Steps) == 0 {
+		return fe.runIterationStep(ctx, step, stepAgent, data, runOptions)
+	}
+
+	var result iterationResult
+	var steps []history.StepRecord
+	outputs := make(map[string]interface{})
+	attempts := 0
+	for _, sub := range step.Steps {
+		subAgent, exists := fe.Agents[sub.Name]
+		if !exists {
+			return result, fmt.Errorf("agent not found for step: %s", sub.Name)
+		}
+		subOptions, err := fe.baseRunOptions(sub)
+		if err != nil {
+			return result, fmt.Errorf("step %s: %w", sub.Name, err)
+		}
+
+		subData := maps.Clone(data)
+		subData["step"] = sub
+		subData["loop"] = maps.Clone(outputs)
+
+		start := time.Now()
+		result, err = fe.runIterationStep(ctx, sub, subAgent, subData, subOptions)
+		attempts += result.attempts
+		result.attempts = attempts
+		if err != nil {
+			return result, fmt.Errorf("step %s: %w", sub.Name, err)
+		}
+
+		status := history.StepStatusSuccess
+		switch {
+		case result.timedOut:
+			status = history.StepStatusTimedOut
+		case result.err != nil:
+			status = history.StepStatusFailed
+		}
+		steps = append(steps, history.StepRecord{
+			Name:     sub.Name,
+			Status:   status,
+			Prompt:   result.prompt,
+			Stdout:   result.stdout,
+			Stderr:   result.stderr(),
+			Attempts: result.attempts,
+			Duration: time.Since(start),
+		})
+		result.steps = steps
+		if result.err != nil {
+			result.err = fmt.Errorf("step %s: %w", sub.Name, result.err)
+			return result, nil
+		}
+
+		outputs[sub.Name] = map[string]interface{}{
+			"stdout":    result.stdout,
+			"stderr":    result.stderr(),
+			"exit_code": result.exitCode(),
+			"json":      result.parsed,
+		}
+	}
+	return result, nil
+}
+
+// runIterationStep runs the agent of a step once, with retries, within a loop iteration
+func (fe *FlowExecutor) runIterationStep(ctx context.Context, step worker.FlowStep, stepAgent agent.Agent, data map[string]interface{}, runOptions agent.RunOptions) (iterationResult, error) {
+	prompt, err := fe.renderPrompt(ctx, step, data)
+	if err != nil {
+		return iterationResult{prompt: prompt}, err
+	}
+	options, err := fe.renderRunOptions(step, data, runOptions)
+	if err != nil {
+		return iterationResult{prompt: prompt}, err
+	}
+
+	run := fe.runWithRetries(ctx, step, stepAgent, prompt, options)
+	result := iterationResult{
+		prompt:   prompt,
+		output:   run.output,
+		parsed:   run.parsed,
+		attempts: run.attempts,
+		timedOut: run.timedOut,
+		err:      run.err,
+	}
+	if run.err != nil && ctx.Err() != nil {
+		return result, run.err
+	}
+
+	if run.output != nil {
+		result.stdout = run.output.Stdout
+	}
+	if run.err == nil {
+		stdout, err := fe.transformOutput(ctx, step, run.output)
+		if err != nil {
+			result.err = err
+		} else {
+			result.stdout = stdout
+		}
+	}
+	return result, nil
+}
+
+// evaluateUntil renders the until template of a loop step for the outcome of an iteration
+func (fe *FlowExecutor) evaluateUntil(step worker.FlowStep, iterationData map[string]interface{}, result iterationResult) (bool, error) {
+	data := maps.Clone(iterationData)
+	data["stdout"] = result.stdout
+	data["stderr"] = ""
+	data["exit_code"] = 0
+	data["error"] = result.errorMessage()
+	if result.output != nil {
+		addOutputData(data, result.output)
+	}
+
+	text, err := fe.applyTemplate(step.Until, data)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate until template: %w", err)
+	}
+	return strings.TrimSpace(text) == "true", nil
+}
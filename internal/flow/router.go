@@ -0,0 +1,143 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"autoteam/internal/logger"
+	"autoteam/internal/worker"
+
+	"go.uber.org/zap"
+)
+
+// executeRouter renders the when template of a router step and selects the routes whose
+// steps run. The route names are returned one per line as the step output.
+func (fe *FlowExecutor) executeRouter(ctx context.Context, step worker.FlowStep, previousOutputs map[string]StepOutput) (*StepOutput, error) {
+	lgr := logger.FromContext(ctx)
+	startTime := time.Now()
+
+	inputData := fe.prepareInputData(ctx, step, previousOutputs)
+	rendered, err := fe.applyTemplate(step.When, inputData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate when template for router %s: %w", step.Name, err)
+	}
+
+	routes, err := selectRoutes(step, rendered)
+	if err != nil {
+		return nil, fmt.Errorf("router %s: %w", step.Name, err)
+	}
+
+	lgr.Info("Router selected routes",
+		zap.String("step_name", step.Name),
+		zap.Strings("routes", routes))
+
+	stdout := strings.Join(routes, "\n")
+	if fe.WorkerRuntime != nil {
+		fe.WorkerRuntime.RecordStepExecution(step.Name, true, &stdout, nil, time.Since(startTime))
+	}
+
+	return &StepOutput{
+		Name:     step.Name,
+		Stdout:   stdout,
+		Routes:   routes,
+		Duration: time.Since(startTime),
+	}, nil
+}
+
+// selectRoutes parses the rendered when template into route names. Names are separated by
+// commas or whitespace; an empty result selects the default route if the router has one.
+func selectRoutes(step worker.FlowStep, rendered string) ([]string, error) {
+	names := strings.FieldsFunc(rendered, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+
+	if len(names) == 0 {
+		if _, ok := step.Routes[worker.DefaultRoute]; ok {
+			return []string{worker.DefaultRoute}, nil
+		}
+		return []string{}, nil
+	}
+
+	var routes []string
+	for _, name := range names {
+		if _, ok := step.Routes[name]; !ok {
+			known := make([]string, 0, len(step.Routes))
+			for route := range step.Routes {
+				known = append(known, route)
+			}
+			sort.Strings(known)
+			return nil, fmt.Errorf("unknown route %q (routes: %s)", name, strings.Join(known, ", "))
+		}
+		if !slices.Contains(routes, name) {
+			routes = append(routes, name)
+		}
+	}
+	return routes, nil
+}
+
+// checkRouteTaken returns a not-taken output for a step that a router dependency activates
+// through its routes but did not select, or nil when the step should run. Branches of a
+// router that did not succeed are not taken either, and neither are the steps further down
+// a branch: a step whose dependencies were all not taken.
+func (fe *FlowExecutor) checkRouteTaken(ctx context.Context, step worker.FlowStep, previousOutputs map[string]StepOutput) *StepOutput {
+	for _, dep := range step.DependsOn {
+		router := fe.getStepByName(dep)
+		if router == nil || router.Type != worker.StepTypeRouter || !routesActivate(*router, step.Name) {
+			continue
+		}
+
+		output := previousOutputs[dep]
+		if succeeded(output) && slices.ContainsFunc(output.Routes, func(route string) bool {
+			return slices.Contains(router.Routes[route], step.Name)
+		}) {
+			continue
+		}
+
+		reason := fmt.Sprintf("route not taken by router %s", dep)
+		logger.FromContext(ctx).Info("Step not taken",
+			zap.String("step_name", step.Name),
+			zap.String("router", dep),
+			zap.Strings("selected_routes", output.Routes))
+
+		return notTaken(step, reason)
+	}
+
+	if len(step.DependsOn) > 0 && !slices.ContainsFunc(step.DependsOn, func(dep string) bool {
+		return !previousOutputs[dep].NotTaken
+	}) {
+		reason := fmt.Sprintf("dependencies not taken: %s", strings.Join(step.DependsOn, ", "))
+		logger.FromContext(ctx).Info("Step not taken",
+			zap.String("step_name", step.Name),
+			zap.Strings("dependencies", step.DependsOn))
+
+		return notTaken(step, reason)
+	}
+	return nil
+}
+
+// notTaken returns the output of a step on a branch that was not taken
+func notTaken(step worker.FlowStep, reason string) *StepOutput {
+	return &StepOutput{
+		Name:     step.Name,
+		Stderr:   reason,
+		Skipped:  true,
+		NotTaken: true,
+
+		SkipReason: reason,
+	}
+}
+
+// routesActivate reports whether any route of the router activates the step
+func routesActivate(router worker.FlowStep, stepName string) bool {
+	for _, targets := range router.Routes {
+		if slices.Contains(targets, stepName) {
+			return true
+		}
+	}
+	return false
+}
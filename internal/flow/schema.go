@@ -0,0 +1,108 @@
+package flow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"autoteam/internal/agent"
+	"autoteam/internal/worker"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// minSchemaAttempts gives steps with an output_schema at least one corrective re-prompt
+// after output that does not match the schema. Other failures are only retried as configured.
+const minSchemaAttempts = 2
+
+// ErrOutputSchema is returned when the agent output contains no JSON matching the output_schema
+var ErrOutputSchema = errors.New("output does not match output_schema")
+
+// conformToSchema extracts the JSON value from the agent stdout and validates it against the
+// schema. On success the stdout is replaced by the extracted JSON and the decoded value is returned.
+func conformToSchema(schema *jsonschema.Schema, output *agent.AgentOutput) (interface{}, error) {
+	value, raw, err := extractJSON(output.Stdout)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOutputSchema, err)
+	}
+	if err := schema.Validate(value); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOutputSchema, schemaErrorMessage(err))
+	}
+
+	output.Stdout = raw
+	return value, nil
+}
+
+// extractJSON returns the first JSON object or array in text, e.g. the whole output or the
+// content of a fenced code block surrounded by prose
+func extractJSON(text string) (interface{}, string, error) {
+	trimmed := strings.TrimSpace(text)
+	var value interface{}
+	if err := json.Unmarshal([]byte(trimmed), &value); err == nil {
+		return value, trimmed, nil
+	}
+
+	for i := 0; i < len(text); i++ {
+		if text[i] != '{' && text[i] != '[' {
+			continue
+		}
+		decoder := json.NewDecoder(strings.NewReader(text[i:]))
+		if err := decoder.Decode(&value); err == nil {
+			return value, text[i : i+int(decoder.InputOffset())], nil
+		}
+	}
+	return nil, "", fmt.Errorf("no JSON object or array found in output")
+}
+
+// schemaErrorMessage formats a schema validation error with the path of every invalid value
+func schemaErrorMessage(err error) string {
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err.Error()
+	}
+
+	var messages []string
+	for _, unit := range validationErr.BasicOutput().Errors {
+		if unit.Error == nil || len(unit.Errors) > 0 {
+			continue
+		}
+		location := unit.InstanceLocation
+		if location == "" {
+			location = "/"
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s", location, unit.Error))
+	}
+	if len(messages) == 0 {
+		return err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// correctivePrompt asks the agent again for output matching the schema after a validation failure
+func correctivePrompt(prompt string, step worker.FlowStep, validationErr error) string {
+	schema, err := json.MarshalIndent(step.OutputSchema, "", "  ")
+	if err != nil {
+		schema = []byte("{}")
+	}
+	return fmt.Sprintf("%s\n\nYour previous response was rejected: %v.\n"+
+		"Respond with only a JSON value matching this JSON Schema:\n%s", prompt, validationErr, schema)
+}
+
+// parsedOutput returns the decoded JSON output of a step with an output_schema, or nil
+func (fe *FlowExecutor) parsedOutput(name string, output StepOutput) interface{} {
+	if output.Parsed != nil {
+		return output.Parsed
+	}
+
+	// Reused outputs of scheduled steps are only stored as text
+	step := fe.getStepByName(name)
+	if step == nil || len(step.OutputSchema) == 0 || output.Stdout == "" {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(output.Stdout), &value); err != nil {
+		return nil
+	}
+	return value
+}
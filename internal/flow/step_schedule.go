@@ -0,0 +1,170 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"autoteam/internal/logger"
+	"autoteam/internal/schedule"
+	"autoteam/internal/secrets"
+	"autoteam/internal/worker"
+
+	"go.uber.org/zap"
+)
+
+// StepOutputsFile stores the last successful output of scheduled steps in the worker directory
+const StepOutputsFile = "step_outputs.json"
+
+// cachedOutput is the persisted result of the last successful run of a scheduled step
+type cachedOutput struct {
+	LastRun time.Time `json:"last_run"`
+	Stdout  string    `json:"stdout"`
+	Stderr  string    `json:"stderr"`
+}
+
+// outputCache persists the last successful output of scheduled steps so it can be
+// reused in cycles where the step is not due
+type outputCache struct {
+	path    string
+	mu      sync.Mutex
+	entries map[string]cachedOutput // Loaded on first use
+}
+
+func newOutputCache(workingDir string) *outputCache {
+	return &outputCache{path: filepath.Join(workingDir, StepOutputsFile)}
+}
+
+// get returns the cached output of a step
+func (c *outputCache) get(stepName string) (cachedOutput, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return cachedOutput{}, false, err
+	}
+	entry, ok := c.entries[stepName]
+	return entry, ok, nil
+}
+
+// put stores the output of a step and writes the cache file. Secret values are redacted
+// before they are stored, so outputs reused in later cycles never contain them either.
+func (c *outputCache) put(stepName string, entry cachedOutput) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.load(); err != nil {
+		return err
+	}
+	entry.Stdout = secrets.Redact(entry.Stdout)
+	entry.Stderr = secrets.Redact(entry.Stderr)
+	c.entries[stepName] = entry
+
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode step outputs: %w", err)
+	}
+
+	// Write atomically so a crash never leaves a truncated file behind
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write step outputs: %w", err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return fmt.Errorf("failed to write step outputs: %w", err)
+	}
+	return nil
+}
+
+// load reads the cache file once. The caller must hold mu.
+func (c *outputCache) load() error {
+	if c.entries != nil {
+		return nil
+	}
+
+	entries := make(map[string]cachedOutput)
+	data, err := os.ReadFile(c.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("failed to read step outputs: %w", err)
+	default:
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return fmt.Errorf("failed to decode step outputs: %w", err)
+		}
+	}
+
+	c.entries = entries
+	return nil
+}
+
+// checkStepDue returns the output to use instead of running a step that is not due,
+// or nil when the step should run
+func (fe *FlowExecutor) checkStepDue(ctx context.Context, step worker.FlowStep) *StepOutput {
+	if fe.outputCache == nil {
+		return nil
+	}
+	sched, err := schedule.ForStep(step)
+	if err != nil || sched == nil {
+		return nil
+	}
+
+	lgr := logger.FromContext(ctx)
+
+	cached, ok, err := fe.outputCache.get(step.Name)
+	if err != nil {
+		lgr.Warn("Failed to read last step output, running step", zap.String("step_name", step.Name), zap.Error(err))
+		return nil
+	}
+	if !ok || sched.IsDue(cached.LastRun, time.Now()) {
+		return nil
+	}
+
+	nextRun := sched.NextRun(cached.LastRun)
+	if step.NotDuePolicy == worker.NotDuePolicySkip {
+		reason := fmt.Sprintf("step is not due until %s", nextRun.Format(time.RFC3339))
+		lgr.Info("Step skipped because it is not due",
+			zap.String("step_name", step.Name),
+			zap.Time("next_run", nextRun))
+
+		return &StepOutput{
+			Name:    step.Name,
+			Stderr:  reason,
+			Skipped: true,
+
+			SkipReason: reason,
+		}
+	}
+
+	lgr.Info("Step not due, reusing last output",
+		zap.String("step_name", step.Name),
+		zap.Time("last_run", cached.LastRun),
+		zap.Time("next_run", nextRun))
+
+	return &StepOutput{
+		Name:   step.Name,
+		Stdout: cached.Stdout,
+		Stderr: cached.Stderr,
+		Reused: true,
+	}
+}
+
+// saveStepOutput persists the output of a successful scheduled step. Failures are logged
+// and only cause the step to run again next cycle.
+func (fe *FlowExecutor) saveStepOutput(ctx context.Context, step worker.FlowStep, start time.Time, stdout, stderr string) {
+	if fe.outputCache == nil || (step.Every == "" && step.Cron == "") {
+		return
+	}
+
+	entry := cachedOutput{LastRun: start, Stdout: stdout, Stderr: stderr}
+	if err := fe.outputCache.put(step.Name, entry); err != nil {
+		logger.FromContext(ctx).Warn("Failed to persist step output",
+			zap.String("step_name", step.Name),
+			zap.Error(err))
+	}
+}
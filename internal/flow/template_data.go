@@ -0,0 +1,114 @@
+package flow
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"autoteam/internal/worker"
+)
+
+// runInfoKey is the context key of the flow run being executed
+type runInfoKey struct{}
+
+// runInfo identifies the flow run being executed; templates see it as .run
+type runInfo struct {
+	ID    string
+	Start time.Time
+	Cycle int64 // Flow cycles executed by this executor, including the current one
+}
+
+// withRunInfo attaches the flow run being executed to a context
+func withRunInfo(ctx context.Context, run runInfo) context.Context {
+	return context.WithValue(ctx, runInfoKey{}, run)
+}
+
+// runData returns the template data of the flow run, or nil outside Execute
+func runData(ctx context.Context) map[string]interface{} {
+	run, ok := ctx.Value(runInfoKey{}).(runInfo)
+	if !ok {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":    run.ID,
+		"start": run.Start,
+		"cycle": run.Cycle,
+	}
+}
+
+// workerData returns the name, team and meta of the worker for templates
+func (fe *FlowExecutor) workerData() map[string]interface{} {
+	var settings worker.WorkerSettings
+	name := ""
+	switch {
+	case fe.WorkerRuntime != nil:
+		settings = fe.WorkerRuntime.GetSettings()
+		name = fe.WorkerRuntime.Worker.Name
+	case fe.Worker != nil:
+		if fe.Worker.Settings != nil {
+			settings = *fe.Worker.Settings
+		}
+		name = fe.Worker.Name
+	}
+
+	return map[string]interface{}{
+		"name": name,
+		"team": settings.GetTeamName(),
+		"meta": settings.Meta,
+	}
+}
+
+// envData returns the environment of the worker process for templates
+func envData() map[string]string {
+	env := make(map[string]string)
+	for _, entry := range os.Environ() {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			env[key] = value
+		}
+	}
+	return env
+}
+
+// outputsData returns the outputs of every upstream step of a step (its dependencies and
+// their dependencies) by step name. The decoded JSON of steps with an output_schema is
+// available as json, and the fields of a decoded object also directly.
+func (fe *FlowExecutor) outputsData(step worker.FlowStep, previousOutputs map[string]StepOutput) map[string]interface{} {
+	outputs := make(map[string]interface{})
+
+	pending := append([]string(nil), step.DependsOn...)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if _, seen := outputs[name]; seen {
+			continue
+		}
+		output, exists := previousOutputs[name]
+		if !exists {
+			continue
+		}
+
+		data := map[string]interface{}{}
+		parsed := fe.parsedOutput(name, output)
+		if object, ok := parsed.(map[string]interface{}); ok {
+			for key, value := range object {
+				data[key] = value
+			}
+		}
+		data["stdout"] = output.Stdout
+		data["stderr"] = output.Stderr
+		data["skipped"] = output.Skipped
+		data["failed"] = output.Failed
+		data["duration"] = output.Duration
+		data["attempt"] = output.Attempts
+		data["exit_code"] = output.ExitCode
+		data["response"] = responseData(output.Response)
+		data["json"] = parsed
+		outputs[name] = data
+
+		if upstream := fe.getStepByName(name); upstream != nil {
+			pending = append(pending, upstream.DependsOn...)
+		}
+	}
+	return outputs
+}
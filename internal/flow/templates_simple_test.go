@@ -3,10 +3,14 @@ package flow
 import (
 	"context"
 	"testing"
+	"time"
 
+	"autoteam/internal/agent"
+	"autoteam/internal/util"
 	"autoteam/internal/worker"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // TestBasicTemplateRendering tests basic template functionality
@@ -125,3 +129,160 @@ func TestSkipConditionEvaluation(t *testing.T) {
 		})
 	}
 }
+
+// TestEventTemplateData tests that the payload of a triggering event is available as .event
+func TestEventTemplateData(t *testing.T) {
+	step := worker.FlowStep{
+		Name:  "handle",
+		Input: "{{ with .event }}{{ .action }} #{{ .issue.number }}{{ else }}scheduled{{ end }}",
+	}
+	executor := createTestExecutor([]worker.FlowStep{step})
+
+	// Cycles without an event see a nil .event
+	data := executor.prepareInputData(context.Background(), step, nil)
+	result, err := executor.applyTemplate(step.Input, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "scheduled", result)
+
+	ctx, err := WithEvent(context.Background(), []byte(`{"action":"opened","issue":{"number":42}}`))
+	assert.NoError(t, err)
+
+	data = executor.prepareInputData(ctx, step, nil)
+	result, err = executor.applyTemplate(step.Input, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "opened #42", result)
+
+	_, err = WithEvent(context.Background(), []byte("not json"))
+	assert.Error(t, err)
+}
+
+// TestNamedTemplateData tests .outputs, .worker, .run and .env in step templates
+func TestNamedTemplateData(t *testing.T) {
+	t.Setenv("AUTOTEAM_TEMPLATE_TEST", "from env")
+
+	steps := []worker.FlowStep{
+		{Name: "collect", Type: "debug"},
+		{Name: "classify", Type: "debug", DependsOn: []string{"collect"}},
+		{Name: "report", Type: "debug", DependsOn: []string{"classify"}},
+	}
+	executor := createTestExecutor(steps)
+	executor.Worker = &worker.Worker{
+		Name: "Senior Developer",
+		Settings: &worker.WorkerSettings{
+			TeamName: util.StringPtr("core"),
+			Meta:     map[string]interface{}{"repo": "diazoxide/autoteam"},
+		},
+	}
+
+	previousOutputs := map[string]StepOutput{
+		"collect": {Name: "collect", Stdout: "3 issues", Attempts: 2, Duration: 1500 * time.Millisecond},
+		"classify": {
+			Name:     "classify",
+			Stderr:   "rate limited",
+			Failed:   true,
+			Attempts: 3,
+			Parsed:   map[string]interface{}{"items": []interface{}{"bug", "docs"}},
+		},
+	}
+
+	start := time.Date(2025, 1, 24, 14, 30, 0, 0, time.UTC)
+	ctx := withRunInfo(context.Background(), runInfo{ID: "20250124T143000.000Z-a1b2c3", Start: start, Cycle: 7})
+	data := executor.prepareInputData(ctx, steps[2], previousOutputs)
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{name: "direct_dependency", template: "{{ .outputs.classify.failed }} {{ .outputs.classify.stderr }} {{ .outputs.classify.attempt }}", expected: "true rate limited 3"},
+		{name: "transitive_dependency", template: "{{ .outputs.collect.stdout }} {{ .outputs.collect.attempt }} {{ .outputs.collect.duration }}", expected: "3 issues 2 1.5s"},
+		{name: "skipped_flag", template: "{{ .outputs.collect.skipped }}", expected: "false"},
+		{name: "parsed_json", template: "{{ index .outputs.classify.items 1 }} {{ len .outputs.classify.json.items }}", expected: "docs 2"},
+		{name: "unknown_step", template: `{{ .outputs.missing.stdout | default "none" }}`, expected: "none"},
+		{name: "worker", template: "{{ .worker.name }} {{ .worker.team }} {{ .worker.meta.repo }}", expected: "Senior Developer core diazoxide/autoteam"},
+		{name: "run", template: "{{ .run.id }} {{ .run.cycle }} {{ .run.start.Format \"2006-01-02\" }}", expected: "20250124T143000.000Z-a1b2c3 7 2025-01-24"},
+		{name: "env", template: "{{ .env.AUTOTEAM_TEMPLATE_TEST }}", expected: "from env"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := executor.applyTemplate(tt.template, data)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+// TestRunCycleTemplateData tests that .run.cycle counts the flow executions
+func TestRunCycleTemplateData(t *testing.T) {
+	steps := []worker.FlowStep{{Name: "step", Type: "debug", Input: "cycle {{ .run.cycle }}"}}
+
+	stepAgent := new(MockAgent)
+	stepAgent.On("Run", mock.Anything, "cycle 1", mock.Anything).Return(&agent.AgentOutput{Stdout: "first"}, nil).Once()
+	stepAgent.On("Run", mock.Anything, "cycle 2", mock.Anything).Return(&agent.AgentOutput{Stdout: "second"}, nil).Once()
+
+	executor := createTestExecutor(steps)
+	executor.Agents["step"] = stepAgent
+
+	for _, expected := range []string{"first", "second"} {
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result.Steps[0].Stdout)
+	}
+}
+
+// TestStrictTemplates tests that template errors fail the step with StrictTemplates
+func TestStrictTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    worker.FlowStep
+		wantErr string
+	}{
+		{
+			name:    "input",
+			step:    worker.FlowStep{Name: "step", Type: "debug", Input: "{{ index .inputs 5 }}"},
+			wantErr: "failed to render input",
+		},
+		{
+			name:    "output",
+			step:    worker.FlowStep{Name: "step", Type: "debug", Input: "prompt", Output: "{{ index .inputs 5 }}"},
+			wantErr: "failed to render output",
+		},
+		{
+			name:    "skip_when",
+			step:    worker.FlowStep{Name: "step", Type: "debug", Input: "prompt", SkipWhen: "{{ index .inputs 5 }}"},
+			wantErr: "failed to render skip_when",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stepAgent := new(MockAgent)
+			stepAgent.On("Run", mock.Anything, "prompt", mock.Anything).Return(&agent.AgentOutput{Stdout: "done"}, nil)
+
+			executor := createTestExecutor([]worker.FlowStep{tt.step})
+			executor.Agents["step"] = stepAgent
+			executor.StrictTemplates = true
+
+			result, err := executor.Execute(context.Background())
+			assert.Error(t, err)
+			assert.False(t, result.Success)
+			assert.True(t, result.Steps[0].Failed)
+			assert.Contains(t, result.Steps[0].Stderr, tt.wantErr)
+			assert.Contains(t, result.Steps[0].Stderr, "error calling index")
+		})
+	}
+
+	t.Run("lenient", func(t *testing.T) {
+		step := worker.FlowStep{Name: "step", Type: "debug", Input: "{{ index .inputs 5 }}", Output: "{{ index .inputs 5 }}"}
+		stepAgent := new(MockAgent)
+		stepAgent.On("Run", mock.Anything, "{{ index .inputs 5 }}", mock.Anything).Return(&agent.AgentOutput{Stdout: "raw"}, nil)
+
+		executor := createTestExecutor([]worker.FlowStep{step})
+		executor.Agents["step"] = stepAgent
+
+		result, err := executor.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "raw", result.Steps[0].Stdout)
+	})
+}
@@ -0,0 +1,118 @@
+package flow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"autoteam/internal/agent"
+	"autoteam/internal/schedule"
+	"autoteam/internal/worker"
+)
+
+// StepError is a validation problem of a flow step
+type StepError struct {
+	Index int    // Index of the step in the flow, -1 for problems of the flow as a whole
+	Field string // Field of the step, e.g. retry or depends_on[1]; empty for the step itself
+	Err   error
+}
+
+// Error returns the message of the problem
+func (e StepError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e StepError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateSteps checks the steps of a flow and their dependency graph. It is shared by config
+// validation and the executor, and returns every problem found in step order. Templates are
+// checked separately with FlowStep.ValidateTemplates.
+func ValidateSteps(flow []worker.FlowStep, mcpServers map[string]worker.MCPServer) []StepError {
+	if len(flow) == 0 {
+		return []StepError{{Index: -1, Err: fmt.Errorf("flow must contain at least one step")}}
+	}
+
+	var errs []StepError
+	add := func(i int, field string, err error) {
+		errs = append(errs, StepError{Index: i, Field: field, Err: err})
+	}
+
+	stepNames := make(map[string]bool)
+	for i, step := range flow {
+		if step.Name == "" {
+			add(i, "", fmt.Errorf("step[%d].name is required", i))
+			continue
+		}
+		if step.Type == "" {
+			add(i, "", fmt.Errorf("step[%d].type is required", i))
+		} else if step.Type != worker.StepTypeRouter && step.Type != worker.StepTypeLoop && !slices.Contains(agent.SupportedTypes, step.Type) {
+			add(i, "type", fmt.Errorf("step %s: unknown type %q (expected %s, %s or %s)", step.Name, step.Type, strings.Join(agent.SupportedTypes, ", "), worker.StepTypeRouter, worker.StepTypeLoop))
+		}
+		if stepNames[step.Name] {
+			add(i, "name", fmt.Errorf("duplicate step name: %s", step.Name))
+		}
+		stepNames[step.Name] = true
+
+		// Validate step schedule (every/cron)
+		if _, err := schedule.ForStep(step); err != nil {
+			add(i, "", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if _, err := step.GetTimeout(); err != nil {
+			add(i, "timeout", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if err := step.ValidateRoutes(flow); err != nil {
+			add(i, "", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if step.Concurrency < 0 {
+			add(i, "concurrency", fmt.Errorf("step %s: concurrency must not be negative", step.Name))
+		}
+		if err := step.ValidateLoop(mcpServers); err != nil {
+			add(i, "", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		for j, sub := range step.Steps {
+			if sub.Type != "" && !slices.Contains(agent.SupportedTypes, sub.Type) {
+				add(i, fmt.Sprintf("steps[%d].type", j), fmt.Errorf("step %s: unknown type %q (expected %s)", sub.Name, sub.Type, strings.Join(agent.SupportedTypes, ", ")))
+			}
+			// Steps within loops get agents by name like any other step
+			if sub.Name != "" && stepNames[sub.Name] {
+				add(i, fmt.Sprintf("steps[%d].name", j), fmt.Errorf("duplicate step name: %s", sub.Name))
+			}
+			stepNames[sub.Name] = true
+		}
+		if err := step.ValidateHTTP(); err != nil {
+			add(i, "http", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if err := step.ValidateMCPCall(mcpServers); err != nil {
+			add(i, "mcp", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if _, err := step.CompileOutputSchema(); err != nil {
+			add(i, "output_schema", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if err := step.ValidateDependencyPolicy(); err != nil {
+			add(i, "dependency_policy", fmt.Errorf("step %s: %w", step.Name, err))
+		}
+		if step.Retry != nil {
+			if err := step.Retry.Validate(); err != nil {
+				add(i, "retry", fmt.Errorf("step %s: %w", step.Name, err))
+			}
+		}
+
+		// Validate dependencies exist
+		for j, dep := range step.DependsOn {
+			if !slices.ContainsFunc(flow, func(other worker.FlowStep) bool { return other.Name == dep }) {
+				add(i, fmt.Sprintf("depends_on[%d]", j), fmt.Errorf("step %s depends on non-existent step: %s", step.Name, dep))
+			}
+		}
+	}
+
+	// A cycle would leave its steps waiting for each other forever
+	if cycle := worker.FindDependencyCycle(flow); cycle != nil {
+		i := slices.IndexFunc(flow, func(step worker.FlowStep) bool { return step.Name == cycle[0] })
+		add(i, "depends_on", fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> ")))
+	}
+
+	return errs
+}
@@ -9,6 +9,7 @@ import (
 
 	"autoteam/internal/config"
 	"autoteam/internal/ports"
+	"autoteam/internal/secrets"
 	"autoteam/internal/worker"
 
 	"gopkg.in/yaml.v3"
@@ -179,6 +180,26 @@ func (g *Generator) generateComposeYAML(cfg *config.Config, portAllocation ports
 		}
 		serviceConfig["environment"] = environment
 
+		// Pass the secrets referenced by the worker config, written by generateWorkerConfigFiles
+		secretsPath := filepath.Join(cfg.GetSecretsDir(), secrets.EnvFile(serviceName))
+		if _, err := os.Stat(secretsPath); err == nil {
+			var envFiles []string
+			switch existing := serviceConfig["env_file"].(type) {
+			case string:
+				envFiles = append(envFiles, existing)
+			case []string:
+				envFiles = append(envFiles, existing...)
+			case []interface{}:
+				for _, v := range existing {
+					if envFile, ok := v.(string); ok {
+						envFiles = append(envFiles, envFile)
+					}
+				}
+			}
+			envFiles = append(envFiles, fmt.Sprintf("./%s/secrets/%s", teamName, secrets.EnvFile(serviceName)))
+			serviceConfig["env_file"] = envFiles
+		}
+
 		// Set default entrypoint if not specified
 		if _, hasEntrypoint := serviceConfig["entrypoint"]; !hasEntrypoint {
 			serviceConfig["entrypoint"] = []string{"/opt/autoteam/bin/entrypoint.sh"}
@@ -355,7 +376,7 @@ func (g *Generator) detectNamedVolumes(services map[string]interface{}) map[stri
 
 // generateWorkerConfigFiles creates YAML config files for each enabled worker
 func (g *Generator) generateWorkerConfigFiles(cfg *config.Config, portAllocation ports.PortAllocation) error {
-	for _, w := range cfg.Workers {
+	for i, w := range cfg.Workers {
 		// Skip disabled workers
 		if !w.IsEnabled() {
 			continue
@@ -379,9 +400,17 @@ func (g *Generator) generateWorkerConfigFiles(cfg *config.Config, portAllocation
 			return fmt.Errorf("failed to create worker config directory %s: %w", workerDir, err)
 		}
 
+		// Secret values are replaced with references to the environment variables the
+		// worker container receives them in
+		var configNode yaml.Node
+		if err := configNode.Encode(workerConfig); err != nil {
+			return fmt.Errorf("failed to marshal config for worker %s: %w", w.Name, err)
+		}
+		referenced := secrets.ReferenceNode(&configNode, cfg.GetWorkerSecretReferences(i))
+
 		// Write config file
 		configPath := filepath.Join(workerDir, "config.yaml")
-		configData, err := yaml.Marshal(workerConfig)
+		configData, err := yaml.Marshal(&configNode)
 		if err != nil {
 			return fmt.Errorf("failed to marshal config for worker %s: %w", w.Name, err)
 		}
@@ -389,6 +418,20 @@ func (g *Generator) generateWorkerConfigFiles(cfg *config.Config, portAllocation
 		if err := os.WriteFile(configPath, configData, 0644); err != nil {
 			return fmt.Errorf("failed to write config file %s: %w", configPath, err)
 		}
+
+		// Only the secrets referenced by the worker are passed to its container
+		secretsPath := filepath.Join(cfg.GetSecretsDir(), secrets.EnvFile(serviceName))
+		if len(referenced) > 0 {
+			values := make(map[string]string, len(referenced))
+			for _, name := range referenced {
+				values[name] = cfg.GetResolvedSecrets()[name]
+			}
+			if err := secrets.WriteEnvFile(secretsPath, values); err != nil {
+				return err
+			}
+		} else if err := os.Remove(secretsPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove stale secrets file %s: %w", secretsPath, err)
+		}
 	}
 
 	return nil
@@ -465,10 +508,11 @@ func (g *Generator) generateControlPlaneConfig(cfg *config.Config, portAllocatio
 
 	// Build control-plane config with worker API URLs
 	controlPlaneConfig := &config.ControlPlaneConfig{
-		Enabled:     cfg.ControlPlane.Enabled,
-		Port:        cfg.ControlPlane.Port,
-		APIKey:      cfg.ControlPlane.APIKey,
-		WorkersAPIs: workersAPIs,
+		Enabled:       cfg.ControlPlane.Enabled,
+		Port:          cfg.ControlPlane.Port,
+		APIKey:        cfg.ControlPlane.APIKey,
+		WebhookSecret: cfg.ControlPlane.WebhookSecret,
+		WorkersAPIs:   workersAPIs,
 	}
 
 	// Write config file
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -353,9 +354,10 @@ func TestGenerator_ControlPlaneConfigGeneration(t *testing.T) {
 			},
 		},
 		ControlPlane: &config.ControlPlaneConfig{
-			Enabled: true,
-			Port:    9090,
-			APIKey:  "test-key",
+			Enabled:       true,
+			Port:          9090,
+			APIKey:        "test-key",
+			WebhookSecret: "test-secret",
 		},
 		Settings: worker.WorkerSettings{
 			TeamName: util.StringPtr("test-team"),
@@ -392,6 +394,9 @@ func TestGenerator_ControlPlaneConfigGeneration(t *testing.T) {
 	if controlPlaneConfig.APIKey != "test-key" {
 		t.Errorf("Control plane API key should be 'test-key', got %s", controlPlaneConfig.APIKey)
 	}
+	if controlPlaneConfig.WebhookSecret != "test-secret" {
+		t.Errorf("Control plane webhook secret should be 'test-secret', got %s", controlPlaneConfig.WebhookSecret)
+	}
 
 	// Verify worker APIs are correctly generated with fixed port 8080
 	expectedWorkerAPIs := []string{
@@ -416,3 +421,147 @@ func TestGenerator_ControlPlaneConfigGeneration(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerator_WorkerSecretsFile(t *testing.T) {
+	tempDir := testutil.CreateTempDir(t)
+
+	originalDir, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get current directory: %v", err)
+	}
+	defer os.Chdir(originalDir)
+
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("failed to change to temp directory: %v", err)
+	}
+
+	t.Setenv("GENERATOR_TEST_TOKEN", "generator-secret-token")
+	t.Setenv("GENERATOR_TEST_BRANCH", "main")
+	testutil.CreateTempFile(t, tempDir, "autoteam.yaml", `secrets:
+  github_token:
+    provider: env
+    env: GENERATOR_TEST_TOKEN
+  branch:
+    provider: env
+    env: GENERATOR_TEST_BRANCH
+  unused:
+    provider: env
+    env: GENERATOR_TEST_UNUSED
+workers:
+  - name: dev1
+    prompt: "Developer"
+  - name: dev2
+    prompt: "Reviewer"
+    settings:
+      flow:
+        - name: review
+          type: shell
+          input: git diff main
+settings:
+  team_name: test-team
+  common_prompt: "Use the token ${secret:github_token} for the API"
+  flow:
+    - name: collect
+      type: shell
+      input: 'git push origin main && echo "${AUTOTEAM_SECRET_GITHUB_TOKEN}"'
+      env:
+        GITHUB_TOKEN: ${secret:github_token}
+        BRANCH: ${secret:branch}
+`)
+
+	cfg, err := config.LoadConfig("autoteam.yaml")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	gen := New()
+	if err := gen.generateWorkerConfigFiles(cfg, nil); err != nil {
+		t.Fatalf("generateWorkerConfigFiles() error = %v", err)
+	}
+
+	// The worker config references the secret instead of containing its value
+	workerConfig := testutil.ReadFile(t, ".autoteam/test-team/workers/dev1/config.yaml")
+	if strings.Contains(workerConfig, "generator-secret-token") {
+		t.Errorf("Worker config should not contain secret values:\n%s", workerConfig)
+	}
+	if !strings.Contains(workerConfig, "${AUTOTEAM_SECRET_GITHUB_TOKEN}") {
+		t.Errorf("Worker config should reference the secret:\n%s", workerConfig)
+	}
+
+	// Only the referenced secrets are written to the env file of the worker, outside the
+	// mounted worker directory
+	if testutil.FileExists(".autoteam/test-team/workers/dev1/secrets.yaml") {
+		t.Errorf("Secrets should not be written to the worker directory")
+	}
+	secretsPath := ".autoteam/test-team/secrets/dev1.env"
+	if got := testutil.ReadFile(t, secretsPath); got != "AUTOTEAM_SECRET_BRANCH=\"main\"\nAUTOTEAM_SECRET_GITHUB_TOKEN=\"generator-secret-token\"\n" {
+		t.Errorf("Worker secrets = %q", got)
+	}
+	info, err := os.Stat(secretsPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Worker secrets mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	// The worker loads the config with the secret passed as environment variable
+	t.Setenv("AUTOTEAM_SECRET_GITHUB_TOKEN", "generator-secret-token")
+	t.Setenv("AUTOTEAM_SECRET_BRANCH", "main")
+	loaded, err := worker.LoadWorkerFromFile(".autoteam/test-team/workers/dev1/config.yaml")
+	if err != nil {
+		t.Fatalf("LoadWorkerFromFile() error = %v", err)
+	}
+	if got := loaded.Settings.Flow[0].Env["GITHUB_TOKEN"]; got != "generator-secret-token" {
+		t.Errorf("Loaded step env GITHUB_TOKEN = %q", got)
+	}
+	if got := loaded.Prompt; got != "Developer\n\nUse the token generator-secret-token for the API" {
+		t.Errorf("Loaded prompt = %q", got)
+	}
+
+	// Values that merely equal a secret are neither rewritten nor expanded
+	if got := loaded.Settings.Flow[0].Input; got != `git push origin main && echo "${AUTOTEAM_SECRET_GITHUB_TOKEN}"` {
+		t.Errorf("Loaded step input = %q, want it untouched", got)
+	}
+
+	// A worker that overrides the flow references only the secrets it still uses
+	reviewerConfig := testutil.ReadFile(t, ".autoteam/test-team/workers/dev2/config.yaml")
+	if !strings.Contains(reviewerConfig, "git diff main") || strings.Contains(reviewerConfig, "AUTOTEAM_SECRET_BRANCH") {
+		t.Errorf("Reviewer config should keep its own values:\n%s", reviewerConfig)
+	}
+	if got := testutil.ReadFile(t, ".autoteam/test-team/secrets/dev2.env"); got != "AUTOTEAM_SECRET_GITHUB_TOKEN=\"generator-secret-token\"\n" {
+		t.Errorf("Reviewer secrets = %q", got)
+	}
+
+	// The compose service receives the env file
+	if err := gen.generateComposeYAML(cfg, nil); err != nil {
+		t.Fatalf("generateComposeYAML() error = %v", err)
+	}
+	var compose map[string]map[string]map[string]interface{}
+	if err := yaml.Unmarshal([]byte(testutil.ReadFile(t, config.ComposeFilePath)), &compose); err != nil {
+		t.Fatalf("Failed to parse compose.yaml: %v", err)
+	}
+	if got := compose["services"]["dev1"]["env_file"]; !reflect.DeepEqual(got, []interface{}{"./test-team/secrets/dev1.env"}) {
+		t.Errorf("env_file = %v", got)
+	}
+
+	// A stale secrets file is removed once no secret is referenced
+	cfg, err = config.LoadConfig(testutil.CreateTempFile(t, tempDir, "autoteam.yaml", `workers:
+  - name: dev1
+    prompt: "Developer"
+settings:
+  team_name: test-team
+  flow:
+    - name: collect
+      type: shell
+`))
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if err := gen.generateWorkerConfigFiles(cfg, nil); err != nil {
+		t.Fatalf("generateWorkerConfigFiles() error = %v", err)
+	}
+	if testutil.FileExists(secretsPath) {
+		t.Errorf("Stale worker secrets file should be removed")
+	}
+}
@@ -153,6 +153,7 @@ type StatusResponse struct {
 	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Agent         *WorkerInfo            `protobuf:"bytes,4,opt,name=agent,proto3" json:"agent,omitempty"`
 	Uptime        *string                `protobuf:"bytes,5,opt,name=uptime,proto3,oneof" json:"uptime,omitempty"`
+	NextRunTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_time,json=nextRunTime,proto3,oneof" json:"next_run_time,omitempty"` // When the next scheduled cycle starts
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +223,13 @@ func (x *StatusResponse) GetUptime() string {
 	return ""
 }
 
+func (x *StatusResponse) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
 // Worker Info
 type WorkerInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1035,29 +1043,28 @@ func (x *FlowStepInfo) GetLastError() string {
 	return ""
 }
 
-type RetryConfig struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	MaxAttempts       int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
-	DelaySeconds      int32                  `protobuf:"varint,2,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
-	BackoffMultiplier float64                `protobuf:"fixed64,3,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SetStepEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StepName      string                 `protobuf:"bytes,1,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RetryConfig) Reset() {
-	*x = RetryConfig{}
+func (x *SetStepEnabledRequest) Reset() {
+	*x = SetStepEnabledRequest{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetryConfig) String() string {
+func (x *SetStepEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetryConfig) ProtoMessage() {}
+func (*SetStepEnabledRequest) ProtoMessage() {}
 
-func (x *RetryConfig) ProtoReflect() protoreflect.Message {
+func (x *SetStepEnabledRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,55 +1076,48 @@ func (x *RetryConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetryConfig.ProtoReflect.Descriptor instead.
-func (*RetryConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetStepEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetStepEnabledRequest) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *RetryConfig) GetMaxAttempts() int32 {
-	if x != nil {
-		return x.MaxAttempts
-	}
-	return 0
-}
-
-func (x *RetryConfig) GetDelaySeconds() int32 {
+func (x *SetStepEnabledRequest) GetStepName() string {
 	if x != nil {
-		return x.DelaySeconds
+		return x.StepName
 	}
-	return 0
+	return ""
 }
 
-func (x *RetryConfig) GetBackoffMultiplier() float64 {
+func (x *SetStepEnabledRequest) GetEnabled() bool {
 	if x != nil {
-		return x.BackoffMultiplier
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-// Metrics
-type MetricsResponse struct {
+type SetStepEnabledResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       *WorkerMetrics         `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	StepName      string                 `protobuf:"bytes,1,opt,name=step_name,json=stepName,proto3" json:"step_name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MetricsResponse) Reset() {
-	*x = MetricsResponse{}
+func (x *SetStepEnabledResponse) Reset() {
+	*x = SetStepEnabledResponse{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MetricsResponse) String() string {
+func (x *SetStepEnabledResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsResponse) ProtoMessage() {}
+func (*SetStepEnabledResponse) ProtoMessage() {}
 
-func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
+func (x *SetStepEnabledResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1129,48 +1129,55 @@ func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsResponse.ProtoReflect.Descriptor instead.
-func (*MetricsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetStepEnabledResponse.ProtoReflect.Descriptor instead.
+func (*SetStepEnabledResponse) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MetricsResponse) GetMetrics() *WorkerMetrics {
+func (x *SetStepEnabledResponse) GetStepName() string {
 	if x != nil {
-		return x.Metrics
+		return x.StepName
 	}
-	return nil
+	return ""
 }
 
-func (x *MetricsResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *SetStepEnabledResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetStepEnabledResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Timestamp
 	}
 	return nil
 }
 
-type WorkerMetrics struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Uptime           *string                `protobuf:"bytes,1,opt,name=uptime,proto3,oneof" json:"uptime,omitempty"`
-	AvgExecutionTime *string                `protobuf:"bytes,2,opt,name=avg_execution_time,json=avgExecutionTime,proto3,oneof" json:"avg_execution_time,omitempty"`
-	LastActivity     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_activity,json=lastActivity,proto3,oneof" json:"last_activity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type RetryConfig struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxAttempts       int32                  `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	DelaySeconds      int32                  `protobuf:"varint,2,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	BackoffMultiplier float64                `protobuf:"fixed64,3,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *WorkerMetrics) Reset() {
-	*x = WorkerMetrics{}
+func (x *RetryConfig) Reset() {
+	*x = RetryConfig{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerMetrics) String() string {
+func (x *RetryConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerMetrics) ProtoMessage() {}
+func (*RetryConfig) ProtoMessage() {}
 
-func (x *WorkerMetrics) ProtoReflect() protoreflect.Message {
+func (x *RetryConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1182,53 +1189,55 @@ func (x *WorkerMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerMetrics.ProtoReflect.Descriptor instead.
-func (*WorkerMetrics) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryConfig.ProtoReflect.Descriptor instead.
+func (*RetryConfig) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WorkerMetrics) GetUptime() string {
-	if x != nil && x.Uptime != nil {
-		return *x.Uptime
+func (x *RetryConfig) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkerMetrics) GetAvgExecutionTime() string {
-	if x != nil && x.AvgExecutionTime != nil {
-		return *x.AvgExecutionTime
+func (x *RetryConfig) GetDelaySeconds() int32 {
+	if x != nil {
+		return x.DelaySeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkerMetrics) GetLastActivity() *timestamppb.Timestamp {
+func (x *RetryConfig) GetBackoffMultiplier() float64 {
 	if x != nil {
-		return x.LastActivity
+		return x.BackoffMultiplier
 	}
-	return nil
+	return 0
 }
 
-type StreamMetricsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	IntervalSeconds *int32                 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3,oneof" json:"interval_seconds,omitempty"` // update interval
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Run history
+type ListRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *int32                 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"` // max runs to return, newest first
+	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamMetricsRequest) Reset() {
-	*x = StreamMetricsRequest{}
+func (x *ListRunsRequest) Reset() {
+	*x = ListRunsRequest{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamMetricsRequest) String() string {
+func (x *ListRunsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamMetricsRequest) ProtoMessage() {}
+func (*ListRunsRequest) ProtoMessage() {}
 
-func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1240,40 +1249,48 @@ func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
-func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StreamMetricsRequest) GetIntervalSeconds() int32 {
-	if x != nil && x.IntervalSeconds != nil {
-		return *x.IntervalSeconds
+func (x *ListRunsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-type MetricsUpdate struct {
+func (x *ListRunsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListRunsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metrics       *WorkerMetrics         `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Runs          []*RunSummary          `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MetricsUpdate) Reset() {
-	*x = MetricsUpdate{}
+func (x *ListRunsResponse) Reset() {
+	*x = ListRunsResponse{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MetricsUpdate) String() string {
+func (x *ListRunsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsUpdate) ProtoMessage() {}
+func (*ListRunsResponse) ProtoMessage() {}
 
-func (x *MetricsUpdate) ProtoReflect() protoreflect.Message {
+func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1285,48 +1302,59 @@ func (x *MetricsUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsUpdate.ProtoReflect.Descriptor instead.
-func (*MetricsUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MetricsUpdate) GetMetrics() *WorkerMetrics {
+func (x *ListRunsResponse) GetRuns() []*RunSummary {
 	if x != nil {
-		return x.Metrics
+		return x.Runs
 	}
 	return nil
 }
 
-func (x *MetricsUpdate) GetTimestamp() *timestamppb.Timestamp {
+func (x *ListRunsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListRunsResponse) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
 		return x.Timestamp
 	}
 	return nil
 }
 
-// Configuration
-type ConfigResponse struct {
+type RunSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *WorkerConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Duration      string                 `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // success, failed, timed_out, canceled
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	StepCount     int32                  `protobuf:"varint,7,opt,name=step_count,json=stepCount,proto3" json:"step_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfigResponse) Reset() {
-	*x = ConfigResponse{}
+func (x *RunSummary) Reset() {
+	*x = RunSummary{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfigResponse) String() string {
+func (x *RunSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigResponse) ProtoMessage() {}
+func (*RunSummary) ProtoMessage() {}
 
-func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *RunSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1338,51 +1366,81 @@ func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
-func (*ConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunSummary.ProtoReflect.Descriptor instead.
+func (*RunSummary) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ConfigResponse) GetConfig() *WorkerConfig {
+func (x *RunSummary) GetId() string {
 	if x != nil {
-		return x.Config
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RunSummary) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
 	}
 	return nil
 }
 
-func (x *ConfigResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *RunSummary) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.End
 	}
 	return nil
 }
 
-type WorkerConfig struct {
+func (x *RunSummary) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *RunSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RunSummary) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *RunSummary) GetStepCount() int32 {
+	if x != nil {
+		return x.StepCount
+	}
+	return 0
+}
+
+type GetRunRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Enabled       *string                `protobuf:"bytes,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
-	Version       *string                `protobuf:"bytes,4,opt,name=version,proto3,oneof" json:"version,omitempty"`
-	TeamName      *string                `protobuf:"bytes,5,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
-	FlowSteps     *int32                 `protobuf:"varint,6,opt,name=flow_steps,json=flowSteps,proto3,oneof" json:"flow_steps,omitempty"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerConfig) Reset() {
-	*x = WorkerConfig{}
+func (x *GetRunRequest) Reset() {
+	*x = GetRunRequest{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerConfig) String() string {
+func (x *GetRunRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerConfig) ProtoMessage() {}
+func (*GetRunRequest) ProtoMessage() {}
 
-func (x *WorkerConfig) ProtoReflect() protoreflect.Message {
+func (x *GetRunRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1394,77 +1452,40 @@ func (x *WorkerConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerConfig.ProtoReflect.Descriptor instead.
-func (*WorkerConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRunRequest.ProtoReflect.Descriptor instead.
+func (*GetRunRequest) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *WorkerConfig) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *WorkerConfig) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return ""
-}
-
-func (x *WorkerConfig) GetEnabled() string {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
-	}
-	return ""
-}
-
-func (x *WorkerConfig) GetVersion() string {
-	if x != nil && x.Version != nil {
-		return *x.Version
-	}
-	return ""
-}
-
-func (x *WorkerConfig) GetTeamName() string {
-	if x != nil && x.TeamName != nil {
-		return *x.TeamName
+func (x *GetRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *WorkerConfig) GetFlowSteps() int32 {
-	if x != nil && x.FlowSteps != nil {
-		return *x.FlowSteps
-	}
-	return 0
-}
-
-// Error handling
-type ErrorResponse struct {
+type RunResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Code          *string                `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Run           *RunRecord             `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ErrorResponse) Reset() {
-	*x = ErrorResponse{}
+func (x *RunResponse) Reset() {
+	*x = RunResponse{}
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ErrorResponse) String() string {
+func (x *RunResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ErrorResponse) ProtoMessage() {}
+func (*RunResponse) ProtoMessage() {}
 
-func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+func (x *RunResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,14 +1497,1039 @@ func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
-func (*ErrorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunResponse.ProtoReflect.Descriptor instead.
+func (*RunResponse) Descriptor() ([]byte, []int) {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ErrorResponse) GetError() string {
+func (x *RunResponse) GetRun() *RunRecord {
 	if x != nil {
-		return x.Error
+		return x.Run
+	}
+	return nil
+}
+
+func (x *RunResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type RunRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Duration      string                 `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Steps         []*StepRecord          `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunRecord) Reset() {
+	*x = RunRecord{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRecord) ProtoMessage() {}
+
+func (x *RunRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRecord.ProtoReflect.Descriptor instead.
+func (*RunRecord) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RunRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RunRecord) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *RunRecord) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *RunRecord) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *RunRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RunRecord) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *RunRecord) GetSteps() []*StepRecord {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type StepRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // success, failed, timed_out, skipped, not_taken, canceled, reused
+	Prompt        *string                `protobuf:"bytes,3,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	Stdout        *string                `protobuf:"bytes,4,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
+	Stderr        *string                `protobuf:"bytes,5,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	SkipReason    *string                `protobuf:"bytes,7,opt,name=skip_reason,json=skipReason,proto3,oneof" json:"skip_reason,omitempty"`
+	Duration      string                 `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	Iterations    []*IterationRecord     `protobuf:"bytes,9,rep,name=iterations,proto3" json:"iterations,omitempty"` // Iterations of a loop step
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepRecord) Reset() {
+	*x = StepRecord{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepRecord) ProtoMessage() {}
+
+func (x *StepRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepRecord.ProtoReflect.Descriptor instead.
+func (*StepRecord) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StepRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StepRecord) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+func (x *StepRecord) GetStdout() string {
+	if x != nil && x.Stdout != nil {
+		return *x.Stdout
+	}
+	return ""
+}
+
+func (x *StepRecord) GetStderr() string {
+	if x != nil && x.Stderr != nil {
+		return *x.Stderr
+	}
+	return ""
+}
+
+func (x *StepRecord) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *StepRecord) GetSkipReason() string {
+	if x != nil && x.SkipReason != nil {
+		return *x.SkipReason
+	}
+	return ""
+}
+
+func (x *StepRecord) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *StepRecord) GetIterations() []*IterationRecord {
+	if x != nil {
+		return x.Iterations
+	}
+	return nil
+}
+
+type IterationRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iteration     int32                  `protobuf:"varint,1,opt,name=iteration,proto3" json:"iteration,omitempty"`
+	Prompt        *string                `protobuf:"bytes,2,opt,name=prompt,proto3,oneof" json:"prompt,omitempty"`
+	Stdout        *string                `protobuf:"bytes,3,opt,name=stdout,proto3,oneof" json:"stdout,omitempty"`
+	Stderr        *string                `protobuf:"bytes,4,opt,name=stderr,proto3,oneof" json:"stderr,omitempty"`
+	Attempts      int32                  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Duration      string                 `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	ExitCode      *int32                 `protobuf:"varint,7,opt,name=exit_code,json=exitCode,proto3,oneof" json:"exit_code,omitempty"`
+	Error         *string                `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"` // Why the iteration failed; the loop continued
+	Steps         []*StepRecord          `protobuf:"bytes,9,rep,name=steps,proto3" json:"steps,omitempty"`       // Steps run in the iteration of a loop step with steps
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IterationRecord) Reset() {
+	*x = IterationRecord{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IterationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IterationRecord) ProtoMessage() {}
+
+func (x *IterationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IterationRecord.ProtoReflect.Descriptor instead.
+func (*IterationRecord) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IterationRecord) GetIteration() int32 {
+	if x != nil {
+		return x.Iteration
+	}
+	return 0
+}
+
+func (x *IterationRecord) GetPrompt() string {
+	if x != nil && x.Prompt != nil {
+		return *x.Prompt
+	}
+	return ""
+}
+
+func (x *IterationRecord) GetStdout() string {
+	if x != nil && x.Stdout != nil {
+		return *x.Stdout
+	}
+	return ""
+}
+
+func (x *IterationRecord) GetStderr() string {
+	if x != nil && x.Stderr != nil {
+		return *x.Stderr
+	}
+	return ""
+}
+
+func (x *IterationRecord) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IterationRecord) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *IterationRecord) GetExitCode() int32 {
+	if x != nil && x.ExitCode != nil {
+		return *x.ExitCode
+	}
+	return 0
+}
+
+func (x *IterationRecord) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *IterationRecord) GetSteps() []*StepRecord {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// Metrics
+type MetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *WorkerMetrics         `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricsResponse) Reset() {
+	*x = MetricsResponse{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsResponse) ProtoMessage() {}
+
+func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsResponse.ProtoReflect.Descriptor instead.
+func (*MetricsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MetricsResponse) GetMetrics() *WorkerMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *MetricsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type WorkerMetrics struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Uptime                  *string                `protobuf:"bytes,1,opt,name=uptime,proto3,oneof" json:"uptime,omitempty"`
+	AvgExecutionTime        *string                `protobuf:"bytes,2,opt,name=avg_execution_time,json=avgExecutionTime,proto3,oneof" json:"avg_execution_time,omitempty"`
+	LastActivity            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_activity,json=lastActivity,proto3,oneof" json:"last_activity,omitempty"`
+	FlowExecutionCount      int32                  `protobuf:"varint,4,opt,name=flow_execution_count,json=flowExecutionCount,proto3" json:"flow_execution_count,omitempty"`
+	FlowSuccessCount        int32                  `protobuf:"varint,5,opt,name=flow_success_count,json=flowSuccessCount,proto3" json:"flow_success_count,omitempty"`
+	LastExecutionTime       *string                `protobuf:"bytes,6,opt,name=last_execution_time,json=lastExecutionTime,proto3,oneof" json:"last_execution_time,omitempty"` // duration of the most recent flow execution
+	ActiveSteps             []string               `protobuf:"bytes,7,rep,name=active_steps,json=activeSteps,proto3" json:"active_steps,omitempty"`
+	Steps                   []*StepMetrics         `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
+	RecentExecutionTimes    []string               `protobuf:"bytes,9,rep,name=recent_execution_times,json=recentExecutionTimes,proto3" json:"recent_execution_times,omitempty"`                   // durations of the most recent flow executions, oldest first
+	RollingAvgExecutionTime *string                `protobuf:"bytes,10,opt,name=rolling_avg_execution_time,json=rollingAvgExecutionTime,proto3,oneof" json:"rolling_avg_execution_time,omitempty"` // average of recent_execution_times
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *WorkerMetrics) Reset() {
+	*x = WorkerMetrics{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerMetrics) ProtoMessage() {}
+
+func (x *WorkerMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerMetrics.ProtoReflect.Descriptor instead.
+func (*WorkerMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WorkerMetrics) GetUptime() string {
+	if x != nil && x.Uptime != nil {
+		return *x.Uptime
+	}
+	return ""
+}
+
+func (x *WorkerMetrics) GetAvgExecutionTime() string {
+	if x != nil && x.AvgExecutionTime != nil {
+		return *x.AvgExecutionTime
+	}
+	return ""
+}
+
+func (x *WorkerMetrics) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *WorkerMetrics) GetFlowExecutionCount() int32 {
+	if x != nil {
+		return x.FlowExecutionCount
+	}
+	return 0
+}
+
+func (x *WorkerMetrics) GetFlowSuccessCount() int32 {
+	if x != nil {
+		return x.FlowSuccessCount
+	}
+	return 0
+}
+
+func (x *WorkerMetrics) GetLastExecutionTime() string {
+	if x != nil && x.LastExecutionTime != nil {
+		return *x.LastExecutionTime
+	}
+	return ""
+}
+
+func (x *WorkerMetrics) GetActiveSteps() []string {
+	if x != nil {
+		return x.ActiveSteps
+	}
+	return nil
+}
+
+func (x *WorkerMetrics) GetSteps() []*StepMetrics {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *WorkerMetrics) GetRecentExecutionTimes() []string {
+	if x != nil {
+		return x.RecentExecutionTimes
+	}
+	return nil
+}
+
+func (x *WorkerMetrics) GetRollingAvgExecutionTime() string {
+	if x != nil && x.RollingAvgExecutionTime != nil {
+		return *x.RollingAvgExecutionTime
+	}
+	return ""
+}
+
+type StepMetrics struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Active             bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	ExecutionCount     int32                  `protobuf:"varint,3,opt,name=execution_count,json=executionCount,proto3" json:"execution_count,omitempty"`
+	SuccessCount       int32                  `protobuf:"varint,4,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount       int32                  `protobuf:"varint,5,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	RetryCount         int32                  `protobuf:"varint,6,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LastDuration       *string                `protobuf:"bytes,7,opt,name=last_duration,json=lastDuration,proto3,oneof" json:"last_duration,omitempty"`
+	AvgDuration        *string                `protobuf:"bytes,8,opt,name=avg_duration,json=avgDuration,proto3,oneof" json:"avg_duration,omitempty"`
+	LastExecution      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_execution,json=lastExecution,proto3,oneof" json:"last_execution,omitempty"`
+	TimeoutCount       int32                  `protobuf:"varint,10,opt,name=timeout_count,json=timeoutCount,proto3" json:"timeout_count,omitempty"`                          // attempts killed by the step timeout
+	IterationCount     int32                  `protobuf:"varint,11,opt,name=iteration_count,json=iterationCount,proto3" json:"iteration_count,omitempty"`                    // loop iterations run across all executions
+	RecentDurations    []string               `protobuf:"bytes,12,rep,name=recent_durations,json=recentDurations,proto3" json:"recent_durations,omitempty"`                  // durations of the most recent executions, oldest first
+	RollingAvgDuration *string                `protobuf:"bytes,13,opt,name=rolling_avg_duration,json=rollingAvgDuration,proto3,oneof" json:"rolling_avg_duration,omitempty"` // average of recent_durations
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StepMetrics) Reset() {
+	*x = StepMetrics{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepMetrics) ProtoMessage() {}
+
+func (x *StepMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepMetrics.ProtoReflect.Descriptor instead.
+func (*StepMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StepMetrics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StepMetrics) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *StepMetrics) GetExecutionCount() int32 {
+	if x != nil {
+		return x.ExecutionCount
+	}
+	return 0
+}
+
+func (x *StepMetrics) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *StepMetrics) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *StepMetrics) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *StepMetrics) GetLastDuration() string {
+	if x != nil && x.LastDuration != nil {
+		return *x.LastDuration
+	}
+	return ""
+}
+
+func (x *StepMetrics) GetAvgDuration() string {
+	if x != nil && x.AvgDuration != nil {
+		return *x.AvgDuration
+	}
+	return ""
+}
+
+func (x *StepMetrics) GetLastExecution() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastExecution
+	}
+	return nil
+}
+
+func (x *StepMetrics) GetTimeoutCount() int32 {
+	if x != nil {
+		return x.TimeoutCount
+	}
+	return 0
+}
+
+func (x *StepMetrics) GetIterationCount() int32 {
+	if x != nil {
+		return x.IterationCount
+	}
+	return 0
+}
+
+func (x *StepMetrics) GetRecentDurations() []string {
+	if x != nil {
+		return x.RecentDurations
+	}
+	return nil
+}
+
+func (x *StepMetrics) GetRollingAvgDuration() string {
+	if x != nil && x.RollingAvgDuration != nil {
+		return *x.RollingAvgDuration
+	}
+	return ""
+}
+
+type StreamMetricsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalSeconds *int32                 `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3,oneof" json:"interval_seconds,omitempty"` // update interval
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamMetricsRequest) Reset() {
+	*x = StreamMetricsRequest{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsRequest) ProtoMessage() {}
+
+func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StreamMetricsRequest) GetIntervalSeconds() int32 {
+	if x != nil && x.IntervalSeconds != nil {
+		return *x.IntervalSeconds
+	}
+	return 0
+}
+
+type MetricsUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       *WorkerMetrics         `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricsUpdate) Reset() {
+	*x = MetricsUpdate{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsUpdate) ProtoMessage() {}
+
+func (x *MetricsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsUpdate.ProtoReflect.Descriptor instead.
+func (*MetricsUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MetricsUpdate) GetMetrics() *WorkerMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *MetricsUpdate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Control
+type TriggerEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`     // JSON payload, exposed to templates as .event
+	Source        *string                `protobuf:"bytes,2,opt,name=source,proto3,oneof" json:"source,omitempty"` // where the event came from (e.g. webhook event type), for logging
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerEventRequest) Reset() {
+	*x = TriggerEventRequest{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventRequest) ProtoMessage() {}
+
+func (x *TriggerEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventRequest.ProtoReflect.Descriptor instead.
+func (*TriggerEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TriggerEventRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TriggerEventRequest) GetSource() string {
+	if x != nil && x.Source != nil {
+		return *x.Source
+	}
+	return ""
+}
+
+type ControlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      bool                   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // false when the action had no effect
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // worker status after the action
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlResponse) Reset() {
+	*x = ControlResponse{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlResponse) ProtoMessage() {}
+
+func (x *ControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlResponse.ProtoReflect.Descriptor instead.
+func (*ControlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ControlResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ControlResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ControlResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ControlResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+// Configuration
+type ConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *WorkerConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigResponse) Reset() {
+	*x = ConfigResponse{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigResponse) ProtoMessage() {}
+
+func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
+func (*ConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfigResponse) GetConfig() *WorkerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConfigResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type WorkerConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Enabled       *string                `protobuf:"bytes,3,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Version       *string                `protobuf:"bytes,4,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	TeamName      *string                `protobuf:"bytes,5,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	FlowSteps     *int32                 `protobuf:"varint,6,opt,name=flow_steps,json=flowSteps,proto3,oneof" json:"flow_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerConfig) Reset() {
+	*x = WorkerConfig{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerConfig) ProtoMessage() {}
+
+func (x *WorkerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerConfig.ProtoReflect.Descriptor instead.
+func (*WorkerConfig) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WorkerConfig) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *WorkerConfig) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *WorkerConfig) GetEnabled() string {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return ""
+}
+
+func (x *WorkerConfig) GetVersion() string {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return ""
+}
+
+func (x *WorkerConfig) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *WorkerConfig) GetFlowSteps() int32 {
+	if x != nil && x.FlowSteps != nil {
+		return *x.FlowSteps
+	}
+	return 0
+}
+
+// Error handling
+type ErrorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Code          *string                `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorResponse) Reset() {
+	*x = ErrorResponse{}
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResponse) ProtoMessage() {}
+
+func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_autoteam_worker_v1_worker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
+func (*ErrorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ErrorResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
@@ -1519,14 +2565,16 @@ const file_proto_autoteam_worker_v1_worker_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
 	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
 	"\n" +
-	"\b_message\"\xd4\x01\n" +
+	"\b_message\"\xab\x02\n" +
 	"\x0eStatusResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
 	"\x04mode\x18\x02 \x01(\tR\x04mode\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x124\n" +
 	"\x05agent\x18\x04 \x01(\v2\x1e.autoteam.worker.v1.WorkerInfoR\x05agent\x12\x1b\n" +
-	"\x06uptime\x18\x05 \x01(\tH\x00R\x06uptime\x88\x01\x01B\t\n" +
-	"\a_uptime\"\x7f\n" +
+	"\x06uptime\x18\x05 \x01(\tH\x00R\x06uptime\x88\x01\x01\x12C\n" +
+	"\rnext_run_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vnextRunTime\x88\x01\x01B\t\n" +
+	"\a_uptimeB\x10\n" +
+	"\x0e_next_run_time\"\x7f\n" +
 	"\n" +
 	"WorkerInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
@@ -1619,27 +2667,141 @@ const file_proto_autoteam_worker_v1_worker_proto_rawDesc = "" +
 	"\x10_execution_countB\x10\n" +
 	"\x0e_success_countB\x0e\n" +
 	"\f_last_outputB\r\n" +
-	"\v_last_error\"\x84\x01\n" +
+	"\v_last_error\"N\n" +
+	"\x15SetStepEnabledRequest\x12\x1b\n" +
+	"\tstep_name\x18\x01 \x01(\tR\bstepName\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x89\x01\n" +
+	"\x16SetStepEnabledResponse\x12\x1b\n" +
+	"\tstep_name\x18\x01 \x01(\tR\bstepName\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x84\x01\n" +
 	"\vRetryConfig\x12!\n" +
 	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12#\n" +
 	"\rdelay_seconds\x18\x02 \x01(\x05R\fdelaySeconds\x12-\n" +
-	"\x12backoff_multiplier\x18\x03 \x01(\x01R\x11backoffMultiplier\"\x88\x01\n" +
+	"\x12backoff_multiplier\x18\x03 \x01(\x01R\x11backoffMultiplier\"^\n" +
+	"\x0fListRunsRequest\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\x96\x01\n" +
+	"\x10ListRunsResponse\x122\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1e.autoteam.worker.v1.RunSummaryR\x04runs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xf4\x01\n" +
+	"\n" +
+	"RunSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\tR\bduration\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x00R\x05error\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"step_count\x18\a \x01(\x05R\tstepCountB\b\n" +
+	"\x06_error\"&\n" +
+	"\rGetRunRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"x\n" +
+	"\vRunResponse\x12/\n" +
+	"\x03run\x18\x01 \x01(\v2\x1d.autoteam.worker.v1.RunRecordR\x03run\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x8a\x02\n" +
+	"\tRunRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\tR\bduration\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x19\n" +
+	"\x05error\x18\x06 \x01(\tH\x00R\x05error\x88\x01\x01\x124\n" +
+	"\x05steps\x18\a \x03(\v2\x1e.autoteam.worker.v1.StepRecordR\x05stepsB\b\n" +
+	"\x06_error\"\xe3\x02\n" +
+	"\n" +
+	"StepRecord\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\x06prompt\x18\x03 \x01(\tH\x00R\x06prompt\x88\x01\x01\x12\x1b\n" +
+	"\x06stdout\x18\x04 \x01(\tH\x01R\x06stdout\x88\x01\x01\x12\x1b\n" +
+	"\x06stderr\x18\x05 \x01(\tH\x02R\x06stderr\x88\x01\x01\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12$\n" +
+	"\vskip_reason\x18\a \x01(\tH\x03R\n" +
+	"skipReason\x88\x01\x01\x12\x1a\n" +
+	"\bduration\x18\b \x01(\tR\bduration\x12C\n" +
+	"\n" +
+	"iterations\x18\t \x03(\v2#.autoteam.worker.v1.IterationRecordR\n" +
+	"iterationsB\t\n" +
+	"\a_promptB\t\n" +
+	"\a_stdoutB\t\n" +
+	"\a_stderrB\x0e\n" +
+	"\f_skip_reason\"\xea\x02\n" +
+	"\x0fIterationRecord\x12\x1c\n" +
+	"\titeration\x18\x01 \x01(\x05R\titeration\x12\x1b\n" +
+	"\x06prompt\x18\x02 \x01(\tH\x00R\x06prompt\x88\x01\x01\x12\x1b\n" +
+	"\x06stdout\x18\x03 \x01(\tH\x01R\x06stdout\x88\x01\x01\x12\x1b\n" +
+	"\x06stderr\x18\x04 \x01(\tH\x02R\x06stderr\x88\x01\x01\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1a\n" +
+	"\bduration\x18\x06 \x01(\tR\bduration\x12 \n" +
+	"\texit_code\x18\a \x01(\x05H\x03R\bexitCode\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\b \x01(\tH\x04R\x05error\x88\x01\x01\x124\n" +
+	"\x05steps\x18\t \x03(\v2\x1e.autoteam.worker.v1.StepRecordR\x05stepsB\t\n" +
+	"\a_promptB\t\n" +
+	"\a_stdoutB\t\n" +
+	"\a_stderrB\f\n" +
+	"\n" +
+	"_exit_codeB\b\n" +
+	"\x06_error\"\x88\x01\n" +
 	"\x0fMetricsResponse\x12;\n" +
 	"\ametrics\x18\x01 \x01(\v2!.autoteam.worker.v1.WorkerMetricsR\ametrics\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xd9\x01\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xf7\x04\n" +
 	"\rWorkerMetrics\x12\x1b\n" +
 	"\x06uptime\x18\x01 \x01(\tH\x00R\x06uptime\x88\x01\x01\x121\n" +
 	"\x12avg_execution_time\x18\x02 \x01(\tH\x01R\x10avgExecutionTime\x88\x01\x01\x12D\n" +
-	"\rlast_activity\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\flastActivity\x88\x01\x01B\t\n" +
+	"\rlast_activity\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\flastActivity\x88\x01\x01\x120\n" +
+	"\x14flow_execution_count\x18\x04 \x01(\x05R\x12flowExecutionCount\x12,\n" +
+	"\x12flow_success_count\x18\x05 \x01(\x05R\x10flowSuccessCount\x123\n" +
+	"\x13last_execution_time\x18\x06 \x01(\tH\x03R\x11lastExecutionTime\x88\x01\x01\x12!\n" +
+	"\factive_steps\x18\a \x03(\tR\vactiveSteps\x125\n" +
+	"\x05steps\x18\b \x03(\v2\x1f.autoteam.worker.v1.StepMetricsR\x05steps\x124\n" +
+	"\x16recent_execution_times\x18\t \x03(\tR\x14recentExecutionTimes\x12@\n" +
+	"\x1arolling_avg_execution_time\x18\n" +
+	" \x01(\tH\x04R\x17rollingAvgExecutionTime\x88\x01\x01B\t\n" +
 	"\a_uptimeB\x15\n" +
 	"\x13_avg_execution_timeB\x10\n" +
-	"\x0e_last_activity\"[\n" +
+	"\x0e_last_activityB\x16\n" +
+	"\x14_last_execution_timeB\x1d\n" +
+	"\x1b_rolling_avg_execution_time\"\xe6\x04\n" +
+	"\vStepMetrics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x12'\n" +
+	"\x0fexecution_count\x18\x03 \x01(\x05R\x0eexecutionCount\x12#\n" +
+	"\rsuccess_count\x18\x04 \x01(\x05R\fsuccessCount\x12#\n" +
+	"\rfailure_count\x18\x05 \x01(\x05R\ffailureCount\x12\x1f\n" +
+	"\vretry_count\x18\x06 \x01(\x05R\n" +
+	"retryCount\x12(\n" +
+	"\rlast_duration\x18\a \x01(\tH\x00R\flastDuration\x88\x01\x01\x12&\n" +
+	"\favg_duration\x18\b \x01(\tH\x01R\vavgDuration\x88\x01\x01\x12F\n" +
+	"\x0elast_execution\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x02R\rlastExecution\x88\x01\x01\x12#\n" +
+	"\rtimeout_count\x18\n" +
+	" \x01(\x05R\ftimeoutCount\x12'\n" +
+	"\x0fiteration_count\x18\v \x01(\x05R\x0eiterationCount\x12)\n" +
+	"\x10recent_durations\x18\f \x03(\tR\x0frecentDurations\x125\n" +
+	"\x14rolling_avg_duration\x18\r \x01(\tH\x03R\x12rollingAvgDuration\x88\x01\x01B\x10\n" +
+	"\x0e_last_durationB\x0f\n" +
+	"\r_avg_durationB\x11\n" +
+	"\x0f_last_executionB\x17\n" +
+	"\x15_rolling_avg_duration\"[\n" +
 	"\x14StreamMetricsRequest\x12.\n" +
 	"\x10interval_seconds\x18\x01 \x01(\x05H\x00R\x0fintervalSeconds\x88\x01\x01B\x13\n" +
 	"\x11_interval_seconds\"\x86\x01\n" +
 	"\rMetricsUpdate\x12;\n" +
 	"\ametrics\x18\x01 \x01(\v2!.autoteam.worker.v1.WorkerMetricsR\ametrics\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x84\x01\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"W\n" +
+	"\x13TriggerEventRequest\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1b\n" +
+	"\x06source\x18\x02 \x01(\tH\x00R\x06source\x88\x01\x01B\t\n" +
+	"\a_source\"\x99\x01\n" +
+	"\x0fControlResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x128\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x84\x01\n" +
 	"\x0eConfigResponse\x128\n" +
 	"\x06config\x18\x01 \x01(\v2 .autoteam.worker.v1.WorkerConfigR\x06config\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x8b\x02\n" +
@@ -1664,7 +2826,7 @@ const file_proto_autoteam_worker_v1_worker_proto_rawDesc = "" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x12\x17\n" +
 	"\x04code\x18\x02 \x01(\tH\x00R\x04code\x88\x01\x01\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestampB\a\n" +
-	"\x05_code2\xab\x06\n" +
+	"\x05_code2\xcf\v\n" +
 	"\rWorkerService\x12G\n" +
 	"\tGetHealth\x12\x16.google.protobuf.Empty\x1a\".autoteam.worker.v1.HealthResponse\x12G\n" +
 	"\tGetStatus\x12\x16.google.protobuf.Empty\x1a\".autoteam.worker.v1.StatusResponse\x12Q\n" +
@@ -1674,11 +2836,19 @@ const file_proto_autoteam_worker_v1_worker_proto_rawDesc = "" +
 	"\n" +
 	"StreamLogs\x12%.autoteam.worker.v1.StreamLogsRequest\x1a\x1c.autoteam.worker.v1.LogChunk0\x01\x12C\n" +
 	"\aGetFlow\x12\x16.google.protobuf.Empty\x1a .autoteam.worker.v1.FlowResponse\x12M\n" +
-	"\fGetFlowSteps\x12\x16.google.protobuf.Empty\x1a%.autoteam.worker.v1.FlowStepsResponse\x12I\n" +
+	"\fGetFlowSteps\x12\x16.google.protobuf.Empty\x1a%.autoteam.worker.v1.FlowStepsResponse\x12g\n" +
+	"\x0eSetStepEnabled\x12).autoteam.worker.v1.SetStepEnabledRequest\x1a*.autoteam.worker.v1.SetStepEnabledResponse\x12U\n" +
+	"\bListRuns\x12#.autoteam.worker.v1.ListRunsRequest\x1a$.autoteam.worker.v1.ListRunsResponse\x12L\n" +
+	"\x06GetRun\x12!.autoteam.worker.v1.GetRunRequest\x1a\x1f.autoteam.worker.v1.RunResponse\x12I\n" +
 	"\n" +
 	"GetMetrics\x12\x16.google.protobuf.Empty\x1a#.autoteam.worker.v1.MetricsResponse\x12^\n" +
 	"\rStreamMetrics\x12(.autoteam.worker.v1.StreamMetricsRequest\x1a!.autoteam.worker.v1.MetricsUpdate0\x01\x12G\n" +
-	"\tGetConfig\x12\x16.google.protobuf.Empty\x1a\".autoteam.worker.v1.ConfigResponseB8Z6autoteam/internal/grpc/gen/autoteam/worker/v1;workerv1b\x06proto3"
+	"\tGetConfig\x12\x16.google.protobuf.Empty\x1a\".autoteam.worker.v1.ConfigResponse\x12J\n" +
+	"\vTriggerFlow\x12\x16.google.protobuf.Empty\x1a#.autoteam.worker.v1.ControlResponse\x12\\\n" +
+	"\fTriggerEvent\x12'.autoteam.worker.v1.TriggerEventRequest\x1a#.autoteam.worker.v1.ControlResponse\x12J\n" +
+	"\vPauseWorker\x12\x16.google.protobuf.Empty\x1a#.autoteam.worker.v1.ControlResponse\x12K\n" +
+	"\fResumeWorker\x12\x16.google.protobuf.Empty\x1a#.autoteam.worker.v1.ControlResponse\x12Q\n" +
+	"\x12CancelCurrentCycle\x12\x16.google.protobuf.Empty\x1a#.autoteam.worker.v1.ControlResponseB8Z6autoteam/internal/grpc/gen/autoteam/worker/v1;workerv1b\x06proto3"
 
 var (
 	file_proto_autoteam_worker_v1_worker_proto_rawDescOnce sync.Once
@@ -1692,88 +2862,133 @@ func file_proto_autoteam_worker_v1_worker_proto_rawDescGZIP() []byte {
 	return file_proto_autoteam_worker_v1_worker_proto_rawDescData
 }
 
-var file_proto_autoteam_worker_v1_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_autoteam_worker_v1_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_proto_autoteam_worker_v1_worker_proto_goTypes = []any{
-	(*HealthResponse)(nil),        // 0: autoteam.worker.v1.HealthResponse
-	(*HealthCheck)(nil),           // 1: autoteam.worker.v1.HealthCheck
-	(*StatusResponse)(nil),        // 2: autoteam.worker.v1.StatusResponse
-	(*WorkerInfo)(nil),            // 3: autoteam.worker.v1.WorkerInfo
-	(*ListLogsRequest)(nil),       // 4: autoteam.worker.v1.ListLogsRequest
-	(*LogsResponse)(nil),          // 5: autoteam.worker.v1.LogsResponse
-	(*LogFile)(nil),               // 6: autoteam.worker.v1.LogFile
-	(*GetLogFileRequest)(nil),     // 7: autoteam.worker.v1.GetLogFileRequest
-	(*LogFileResponse)(nil),       // 8: autoteam.worker.v1.LogFileResponse
-	(*StreamLogsRequest)(nil),     // 9: autoteam.worker.v1.StreamLogsRequest
-	(*LogChunk)(nil),              // 10: autoteam.worker.v1.LogChunk
-	(*FlowResponse)(nil),          // 11: autoteam.worker.v1.FlowResponse
-	(*FlowStepsResponse)(nil),     // 12: autoteam.worker.v1.FlowStepsResponse
-	(*FlowInfo)(nil),              // 13: autoteam.worker.v1.FlowInfo
-	(*FlowStepInfo)(nil),          // 14: autoteam.worker.v1.FlowStepInfo
-	(*RetryConfig)(nil),           // 15: autoteam.worker.v1.RetryConfig
-	(*MetricsResponse)(nil),       // 16: autoteam.worker.v1.MetricsResponse
-	(*WorkerMetrics)(nil),         // 17: autoteam.worker.v1.WorkerMetrics
-	(*StreamMetricsRequest)(nil),  // 18: autoteam.worker.v1.StreamMetricsRequest
-	(*MetricsUpdate)(nil),         // 19: autoteam.worker.v1.MetricsUpdate
-	(*ConfigResponse)(nil),        // 20: autoteam.worker.v1.ConfigResponse
-	(*WorkerConfig)(nil),          // 21: autoteam.worker.v1.WorkerConfig
-	(*ErrorResponse)(nil),         // 22: autoteam.worker.v1.ErrorResponse
-	nil,                           // 23: autoteam.worker.v1.HealthResponse.ChecksEntry
-	nil,                           // 24: autoteam.worker.v1.FlowStepInfo.EnvEntry
-	(*timestamppb.Timestamp)(nil), // 25: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 26: google.protobuf.Empty
+	(*HealthResponse)(nil),         // 0: autoteam.worker.v1.HealthResponse
+	(*HealthCheck)(nil),            // 1: autoteam.worker.v1.HealthCheck
+	(*StatusResponse)(nil),         // 2: autoteam.worker.v1.StatusResponse
+	(*WorkerInfo)(nil),             // 3: autoteam.worker.v1.WorkerInfo
+	(*ListLogsRequest)(nil),        // 4: autoteam.worker.v1.ListLogsRequest
+	(*LogsResponse)(nil),           // 5: autoteam.worker.v1.LogsResponse
+	(*LogFile)(nil),                // 6: autoteam.worker.v1.LogFile
+	(*GetLogFileRequest)(nil),      // 7: autoteam.worker.v1.GetLogFileRequest
+	(*LogFileResponse)(nil),        // 8: autoteam.worker.v1.LogFileResponse
+	(*StreamLogsRequest)(nil),      // 9: autoteam.worker.v1.StreamLogsRequest
+	(*LogChunk)(nil),               // 10: autoteam.worker.v1.LogChunk
+	(*FlowResponse)(nil),           // 11: autoteam.worker.v1.FlowResponse
+	(*FlowStepsResponse)(nil),      // 12: autoteam.worker.v1.FlowStepsResponse
+	(*FlowInfo)(nil),               // 13: autoteam.worker.v1.FlowInfo
+	(*FlowStepInfo)(nil),           // 14: autoteam.worker.v1.FlowStepInfo
+	(*SetStepEnabledRequest)(nil),  // 15: autoteam.worker.v1.SetStepEnabledRequest
+	(*SetStepEnabledResponse)(nil), // 16: autoteam.worker.v1.SetStepEnabledResponse
+	(*RetryConfig)(nil),            // 17: autoteam.worker.v1.RetryConfig
+	(*ListRunsRequest)(nil),        // 18: autoteam.worker.v1.ListRunsRequest
+	(*ListRunsResponse)(nil),       // 19: autoteam.worker.v1.ListRunsResponse
+	(*RunSummary)(nil),             // 20: autoteam.worker.v1.RunSummary
+	(*GetRunRequest)(nil),          // 21: autoteam.worker.v1.GetRunRequest
+	(*RunResponse)(nil),            // 22: autoteam.worker.v1.RunResponse
+	(*RunRecord)(nil),              // 23: autoteam.worker.v1.RunRecord
+	(*StepRecord)(nil),             // 24: autoteam.worker.v1.StepRecord
+	(*IterationRecord)(nil),        // 25: autoteam.worker.v1.IterationRecord
+	(*MetricsResponse)(nil),        // 26: autoteam.worker.v1.MetricsResponse
+	(*WorkerMetrics)(nil),          // 27: autoteam.worker.v1.WorkerMetrics
+	(*StepMetrics)(nil),            // 28: autoteam.worker.v1.StepMetrics
+	(*StreamMetricsRequest)(nil),   // 29: autoteam.worker.v1.StreamMetricsRequest
+	(*MetricsUpdate)(nil),          // 30: autoteam.worker.v1.MetricsUpdate
+	(*TriggerEventRequest)(nil),    // 31: autoteam.worker.v1.TriggerEventRequest
+	(*ControlResponse)(nil),        // 32: autoteam.worker.v1.ControlResponse
+	(*ConfigResponse)(nil),         // 33: autoteam.worker.v1.ConfigResponse
+	(*WorkerConfig)(nil),           // 34: autoteam.worker.v1.WorkerConfig
+	(*ErrorResponse)(nil),          // 35: autoteam.worker.v1.ErrorResponse
+	nil,                            // 36: autoteam.worker.v1.HealthResponse.ChecksEntry
+	nil,                            // 37: autoteam.worker.v1.FlowStepInfo.EnvEntry
+	(*timestamppb.Timestamp)(nil),  // 38: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 39: google.protobuf.Empty
 }
 var file_proto_autoteam_worker_v1_worker_proto_depIdxs = []int32{
-	25, // 0: autoteam.worker.v1.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 0: autoteam.worker.v1.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
 	3,  // 1: autoteam.worker.v1.HealthResponse.agent:type_name -> autoteam.worker.v1.WorkerInfo
-	23, // 2: autoteam.worker.v1.HealthResponse.checks:type_name -> autoteam.worker.v1.HealthResponse.ChecksEntry
-	25, // 3: autoteam.worker.v1.StatusResponse.timestamp:type_name -> google.protobuf.Timestamp
+	36, // 2: autoteam.worker.v1.HealthResponse.checks:type_name -> autoteam.worker.v1.HealthResponse.ChecksEntry
+	38, // 3: autoteam.worker.v1.StatusResponse.timestamp:type_name -> google.protobuf.Timestamp
 	3,  // 4: autoteam.worker.v1.StatusResponse.agent:type_name -> autoteam.worker.v1.WorkerInfo
-	6,  // 5: autoteam.worker.v1.LogsResponse.logs:type_name -> autoteam.worker.v1.LogFile
-	25, // 6: autoteam.worker.v1.LogsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 7: autoteam.worker.v1.LogFile.modified:type_name -> google.protobuf.Timestamp
-	25, // 8: autoteam.worker.v1.LogChunk.timestamp:type_name -> google.protobuf.Timestamp
-	13, // 9: autoteam.worker.v1.FlowResponse.flow:type_name -> autoteam.worker.v1.FlowInfo
-	25, // 10: autoteam.worker.v1.FlowResponse.timestamp:type_name -> google.protobuf.Timestamp
-	14, // 11: autoteam.worker.v1.FlowStepsResponse.steps:type_name -> autoteam.worker.v1.FlowStepInfo
-	25, // 12: autoteam.worker.v1.FlowStepsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 13: autoteam.worker.v1.FlowInfo.last_execution:type_name -> google.protobuf.Timestamp
-	24, // 14: autoteam.worker.v1.FlowStepInfo.env:type_name -> autoteam.worker.v1.FlowStepInfo.EnvEntry
-	15, // 15: autoteam.worker.v1.FlowStepInfo.retry:type_name -> autoteam.worker.v1.RetryConfig
-	25, // 16: autoteam.worker.v1.FlowStepInfo.last_execution:type_name -> google.protobuf.Timestamp
-	17, // 17: autoteam.worker.v1.MetricsResponse.metrics:type_name -> autoteam.worker.v1.WorkerMetrics
-	25, // 18: autoteam.worker.v1.MetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 19: autoteam.worker.v1.WorkerMetrics.last_activity:type_name -> google.protobuf.Timestamp
-	17, // 20: autoteam.worker.v1.MetricsUpdate.metrics:type_name -> autoteam.worker.v1.WorkerMetrics
-	25, // 21: autoteam.worker.v1.MetricsUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	21, // 22: autoteam.worker.v1.ConfigResponse.config:type_name -> autoteam.worker.v1.WorkerConfig
-	25, // 23: autoteam.worker.v1.ConfigResponse.timestamp:type_name -> google.protobuf.Timestamp
-	25, // 24: autoteam.worker.v1.ErrorResponse.timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 25: autoteam.worker.v1.HealthResponse.ChecksEntry.value:type_name -> autoteam.worker.v1.HealthCheck
-	26, // 26: autoteam.worker.v1.WorkerService.GetHealth:input_type -> google.protobuf.Empty
-	26, // 27: autoteam.worker.v1.WorkerService.GetStatus:input_type -> google.protobuf.Empty
-	4,  // 28: autoteam.worker.v1.WorkerService.ListLogs:input_type -> autoteam.worker.v1.ListLogsRequest
-	7,  // 29: autoteam.worker.v1.WorkerService.GetLogFile:input_type -> autoteam.worker.v1.GetLogFileRequest
-	9,  // 30: autoteam.worker.v1.WorkerService.StreamLogs:input_type -> autoteam.worker.v1.StreamLogsRequest
-	26, // 31: autoteam.worker.v1.WorkerService.GetFlow:input_type -> google.protobuf.Empty
-	26, // 32: autoteam.worker.v1.WorkerService.GetFlowSteps:input_type -> google.protobuf.Empty
-	26, // 33: autoteam.worker.v1.WorkerService.GetMetrics:input_type -> google.protobuf.Empty
-	18, // 34: autoteam.worker.v1.WorkerService.StreamMetrics:input_type -> autoteam.worker.v1.StreamMetricsRequest
-	26, // 35: autoteam.worker.v1.WorkerService.GetConfig:input_type -> google.protobuf.Empty
-	0,  // 36: autoteam.worker.v1.WorkerService.GetHealth:output_type -> autoteam.worker.v1.HealthResponse
-	2,  // 37: autoteam.worker.v1.WorkerService.GetStatus:output_type -> autoteam.worker.v1.StatusResponse
-	5,  // 38: autoteam.worker.v1.WorkerService.ListLogs:output_type -> autoteam.worker.v1.LogsResponse
-	8,  // 39: autoteam.worker.v1.WorkerService.GetLogFile:output_type -> autoteam.worker.v1.LogFileResponse
-	10, // 40: autoteam.worker.v1.WorkerService.StreamLogs:output_type -> autoteam.worker.v1.LogChunk
-	11, // 41: autoteam.worker.v1.WorkerService.GetFlow:output_type -> autoteam.worker.v1.FlowResponse
-	12, // 42: autoteam.worker.v1.WorkerService.GetFlowSteps:output_type -> autoteam.worker.v1.FlowStepsResponse
-	16, // 43: autoteam.worker.v1.WorkerService.GetMetrics:output_type -> autoteam.worker.v1.MetricsResponse
-	19, // 44: autoteam.worker.v1.WorkerService.StreamMetrics:output_type -> autoteam.worker.v1.MetricsUpdate
-	20, // 45: autoteam.worker.v1.WorkerService.GetConfig:output_type -> autoteam.worker.v1.ConfigResponse
-	36, // [36:46] is the sub-list for method output_type
-	26, // [26:36] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	38, // 5: autoteam.worker.v1.StatusResponse.next_run_time:type_name -> google.protobuf.Timestamp
+	6,  // 6: autoteam.worker.v1.LogsResponse.logs:type_name -> autoteam.worker.v1.LogFile
+	38, // 7: autoteam.worker.v1.LogsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 8: autoteam.worker.v1.LogFile.modified:type_name -> google.protobuf.Timestamp
+	38, // 9: autoteam.worker.v1.LogChunk.timestamp:type_name -> google.protobuf.Timestamp
+	13, // 10: autoteam.worker.v1.FlowResponse.flow:type_name -> autoteam.worker.v1.FlowInfo
+	38, // 11: autoteam.worker.v1.FlowResponse.timestamp:type_name -> google.protobuf.Timestamp
+	14, // 12: autoteam.worker.v1.FlowStepsResponse.steps:type_name -> autoteam.worker.v1.FlowStepInfo
+	38, // 13: autoteam.worker.v1.FlowStepsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 14: autoteam.worker.v1.FlowInfo.last_execution:type_name -> google.protobuf.Timestamp
+	37, // 15: autoteam.worker.v1.FlowStepInfo.env:type_name -> autoteam.worker.v1.FlowStepInfo.EnvEntry
+	17, // 16: autoteam.worker.v1.FlowStepInfo.retry:type_name -> autoteam.worker.v1.RetryConfig
+	38, // 17: autoteam.worker.v1.FlowStepInfo.last_execution:type_name -> google.protobuf.Timestamp
+	38, // 18: autoteam.worker.v1.SetStepEnabledResponse.timestamp:type_name -> google.protobuf.Timestamp
+	20, // 19: autoteam.worker.v1.ListRunsResponse.runs:type_name -> autoteam.worker.v1.RunSummary
+	38, // 20: autoteam.worker.v1.ListRunsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 21: autoteam.worker.v1.RunSummary.start:type_name -> google.protobuf.Timestamp
+	38, // 22: autoteam.worker.v1.RunSummary.end:type_name -> google.protobuf.Timestamp
+	23, // 23: autoteam.worker.v1.RunResponse.run:type_name -> autoteam.worker.v1.RunRecord
+	38, // 24: autoteam.worker.v1.RunResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 25: autoteam.worker.v1.RunRecord.start:type_name -> google.protobuf.Timestamp
+	38, // 26: autoteam.worker.v1.RunRecord.end:type_name -> google.protobuf.Timestamp
+	24, // 27: autoteam.worker.v1.RunRecord.steps:type_name -> autoteam.worker.v1.StepRecord
+	25, // 28: autoteam.worker.v1.StepRecord.iterations:type_name -> autoteam.worker.v1.IterationRecord
+	24, // 29: autoteam.worker.v1.IterationRecord.steps:type_name -> autoteam.worker.v1.StepRecord
+	27, // 30: autoteam.worker.v1.MetricsResponse.metrics:type_name -> autoteam.worker.v1.WorkerMetrics
+	38, // 31: autoteam.worker.v1.MetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 32: autoteam.worker.v1.WorkerMetrics.last_activity:type_name -> google.protobuf.Timestamp
+	28, // 33: autoteam.worker.v1.WorkerMetrics.steps:type_name -> autoteam.worker.v1.StepMetrics
+	38, // 34: autoteam.worker.v1.StepMetrics.last_execution:type_name -> google.protobuf.Timestamp
+	27, // 35: autoteam.worker.v1.MetricsUpdate.metrics:type_name -> autoteam.worker.v1.WorkerMetrics
+	38, // 36: autoteam.worker.v1.MetricsUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 37: autoteam.worker.v1.ControlResponse.timestamp:type_name -> google.protobuf.Timestamp
+	34, // 38: autoteam.worker.v1.ConfigResponse.config:type_name -> autoteam.worker.v1.WorkerConfig
+	38, // 39: autoteam.worker.v1.ConfigResponse.timestamp:type_name -> google.protobuf.Timestamp
+	38, // 40: autoteam.worker.v1.ErrorResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 41: autoteam.worker.v1.HealthResponse.ChecksEntry.value:type_name -> autoteam.worker.v1.HealthCheck
+	39, // 42: autoteam.worker.v1.WorkerService.GetHealth:input_type -> google.protobuf.Empty
+	39, // 43: autoteam.worker.v1.WorkerService.GetStatus:input_type -> google.protobuf.Empty
+	4,  // 44: autoteam.worker.v1.WorkerService.ListLogs:input_type -> autoteam.worker.v1.ListLogsRequest
+	7,  // 45: autoteam.worker.v1.WorkerService.GetLogFile:input_type -> autoteam.worker.v1.GetLogFileRequest
+	9,  // 46: autoteam.worker.v1.WorkerService.StreamLogs:input_type -> autoteam.worker.v1.StreamLogsRequest
+	39, // 47: autoteam.worker.v1.WorkerService.GetFlow:input_type -> google.protobuf.Empty
+	39, // 48: autoteam.worker.v1.WorkerService.GetFlowSteps:input_type -> google.protobuf.Empty
+	15, // 49: autoteam.worker.v1.WorkerService.SetStepEnabled:input_type -> autoteam.worker.v1.SetStepEnabledRequest
+	18, // 50: autoteam.worker.v1.WorkerService.ListRuns:input_type -> autoteam.worker.v1.ListRunsRequest
+	21, // 51: autoteam.worker.v1.WorkerService.GetRun:input_type -> autoteam.worker.v1.GetRunRequest
+	39, // 52: autoteam.worker.v1.WorkerService.GetMetrics:input_type -> google.protobuf.Empty
+	29, // 53: autoteam.worker.v1.WorkerService.StreamMetrics:input_type -> autoteam.worker.v1.StreamMetricsRequest
+	39, // 54: autoteam.worker.v1.WorkerService.GetConfig:input_type -> google.protobuf.Empty
+	39, // 55: autoteam.worker.v1.WorkerService.TriggerFlow:input_type -> google.protobuf.Empty
+	31, // 56: autoteam.worker.v1.WorkerService.TriggerEvent:input_type -> autoteam.worker.v1.TriggerEventRequest
+	39, // 57: autoteam.worker.v1.WorkerService.PauseWorker:input_type -> google.protobuf.Empty
+	39, // 58: autoteam.worker.v1.WorkerService.ResumeWorker:input_type -> google.protobuf.Empty
+	39, // 59: autoteam.worker.v1.WorkerService.CancelCurrentCycle:input_type -> google.protobuf.Empty
+	0,  // 60: autoteam.worker.v1.WorkerService.GetHealth:output_type -> autoteam.worker.v1.HealthResponse
+	2,  // 61: autoteam.worker.v1.WorkerService.GetStatus:output_type -> autoteam.worker.v1.StatusResponse
+	5,  // 62: autoteam.worker.v1.WorkerService.ListLogs:output_type -> autoteam.worker.v1.LogsResponse
+	8,  // 63: autoteam.worker.v1.WorkerService.GetLogFile:output_type -> autoteam.worker.v1.LogFileResponse
+	10, // 64: autoteam.worker.v1.WorkerService.StreamLogs:output_type -> autoteam.worker.v1.LogChunk
+	11, // 65: autoteam.worker.v1.WorkerService.GetFlow:output_type -> autoteam.worker.v1.FlowResponse
+	12, // 66: autoteam.worker.v1.WorkerService.GetFlowSteps:output_type -> autoteam.worker.v1.FlowStepsResponse
+	16, // 67: autoteam.worker.v1.WorkerService.SetStepEnabled:output_type -> autoteam.worker.v1.SetStepEnabledResponse
+	19, // 68: autoteam.worker.v1.WorkerService.ListRuns:output_type -> autoteam.worker.v1.ListRunsResponse
+	22, // 69: autoteam.worker.v1.WorkerService.GetRun:output_type -> autoteam.worker.v1.RunResponse
+	26, // 70: autoteam.worker.v1.WorkerService.GetMetrics:output_type -> autoteam.worker.v1.MetricsResponse
+	30, // 71: autoteam.worker.v1.WorkerService.StreamMetrics:output_type -> autoteam.worker.v1.MetricsUpdate
+	33, // 72: autoteam.worker.v1.WorkerService.GetConfig:output_type -> autoteam.worker.v1.ConfigResponse
+	32, // 73: autoteam.worker.v1.WorkerService.TriggerFlow:output_type -> autoteam.worker.v1.ControlResponse
+	32, // 74: autoteam.worker.v1.WorkerService.TriggerEvent:output_type -> autoteam.worker.v1.ControlResponse
+	32, // 75: autoteam.worker.v1.WorkerService.PauseWorker:output_type -> autoteam.worker.v1.ControlResponse
+	32, // 76: autoteam.worker.v1.WorkerService.ResumeWorker:output_type -> autoteam.worker.v1.ControlResponse
+	32, // 77: autoteam.worker.v1.WorkerService.CancelCurrentCycle:output_type -> autoteam.worker.v1.ControlResponse
+	60, // [60:78] is the sub-list for method output_type
+	42, // [42:60] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_proto_autoteam_worker_v1_worker_proto_init() }
@@ -1790,17 +3005,24 @@ func file_proto_autoteam_worker_v1_worker_proto_init() {
 	file_proto_autoteam_worker_v1_worker_proto_msgTypes[9].OneofWrappers = []any{}
 	file_proto_autoteam_worker_v1_worker_proto_msgTypes[13].OneofWrappers = []any{}
 	file_proto_autoteam_worker_v1_worker_proto_msgTypes[14].OneofWrappers = []any{}
-	file_proto_autoteam_worker_v1_worker_proto_msgTypes[17].OneofWrappers = []any{}
 	file_proto_autoteam_worker_v1_worker_proto_msgTypes[18].OneofWrappers = []any{}
-	file_proto_autoteam_worker_v1_worker_proto_msgTypes[21].OneofWrappers = []any{}
-	file_proto_autoteam_worker_v1_worker_proto_msgTypes[22].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[20].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[23].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[24].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[25].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[27].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[28].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[29].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[31].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_autoteam_worker_v1_worker_proto_msgTypes[35].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_autoteam_worker_v1_worker_proto_rawDesc), len(file_proto_autoteam_worker_v1_worker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
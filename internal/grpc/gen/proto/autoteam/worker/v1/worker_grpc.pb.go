@@ -21,16 +21,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkerService_GetHealth_FullMethodName     = "/autoteam.worker.v1.WorkerService/GetHealth"
-	WorkerService_GetStatus_FullMethodName     = "/autoteam.worker.v1.WorkerService/GetStatus"
-	WorkerService_ListLogs_FullMethodName      = "/autoteam.worker.v1.WorkerService/ListLogs"
-	WorkerService_GetLogFile_FullMethodName    = "/autoteam.worker.v1.WorkerService/GetLogFile"
-	WorkerService_StreamLogs_FullMethodName    = "/autoteam.worker.v1.WorkerService/StreamLogs"
-	WorkerService_GetFlow_FullMethodName       = "/autoteam.worker.v1.WorkerService/GetFlow"
-	WorkerService_GetFlowSteps_FullMethodName  = "/autoteam.worker.v1.WorkerService/GetFlowSteps"
-	WorkerService_GetMetrics_FullMethodName    = "/autoteam.worker.v1.WorkerService/GetMetrics"
-	WorkerService_StreamMetrics_FullMethodName = "/autoteam.worker.v1.WorkerService/StreamMetrics"
-	WorkerService_GetConfig_FullMethodName     = "/autoteam.worker.v1.WorkerService/GetConfig"
+	WorkerService_GetHealth_FullMethodName          = "/autoteam.worker.v1.WorkerService/GetHealth"
+	WorkerService_GetStatus_FullMethodName          = "/autoteam.worker.v1.WorkerService/GetStatus"
+	WorkerService_ListLogs_FullMethodName           = "/autoteam.worker.v1.WorkerService/ListLogs"
+	WorkerService_GetLogFile_FullMethodName         = "/autoteam.worker.v1.WorkerService/GetLogFile"
+	WorkerService_StreamLogs_FullMethodName         = "/autoteam.worker.v1.WorkerService/StreamLogs"
+	WorkerService_GetFlow_FullMethodName            = "/autoteam.worker.v1.WorkerService/GetFlow"
+	WorkerService_GetFlowSteps_FullMethodName       = "/autoteam.worker.v1.WorkerService/GetFlowSteps"
+	WorkerService_SetStepEnabled_FullMethodName     = "/autoteam.worker.v1.WorkerService/SetStepEnabled"
+	WorkerService_ListRuns_FullMethodName           = "/autoteam.worker.v1.WorkerService/ListRuns"
+	WorkerService_GetRun_FullMethodName             = "/autoteam.worker.v1.WorkerService/GetRun"
+	WorkerService_GetMetrics_FullMethodName         = "/autoteam.worker.v1.WorkerService/GetMetrics"
+	WorkerService_StreamMetrics_FullMethodName      = "/autoteam.worker.v1.WorkerService/StreamMetrics"
+	WorkerService_GetConfig_FullMethodName          = "/autoteam.worker.v1.WorkerService/GetConfig"
+	WorkerService_TriggerFlow_FullMethodName        = "/autoteam.worker.v1.WorkerService/TriggerFlow"
+	WorkerService_TriggerEvent_FullMethodName       = "/autoteam.worker.v1.WorkerService/TriggerEvent"
+	WorkerService_PauseWorker_FullMethodName        = "/autoteam.worker.v1.WorkerService/PauseWorker"
+	WorkerService_ResumeWorker_FullMethodName       = "/autoteam.worker.v1.WorkerService/ResumeWorker"
+	WorkerService_CancelCurrentCycle_FullMethodName = "/autoteam.worker.v1.WorkerService/CancelCurrentCycle"
 )
 
 // WorkerServiceClient is the client API for WorkerService service.
@@ -50,11 +58,21 @@ type WorkerServiceClient interface {
 	// Flow configuration
 	GetFlow(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlowResponse, error)
 	GetFlowSteps(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlowStepsResponse, error)
+	SetStepEnabled(ctx context.Context, in *SetStepEnabledRequest, opts ...grpc.CallOption) (*SetStepEnabledResponse, error)
+	// Run history
+	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*RunResponse, error)
 	// Metrics
 	GetMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetricsResponse, error)
 	StreamMetrics(ctx context.Context, in *StreamMetricsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MetricsUpdate], error)
 	// Configuration
 	GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigResponse, error)
+	// Control
+	TriggerFlow(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error)
+	TriggerEvent(ctx context.Context, in *TriggerEventRequest, opts ...grpc.CallOption) (*ControlResponse, error)
+	PauseWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error)
+	ResumeWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error)
+	CancelCurrentCycle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error)
 }
 
 type workerServiceClient struct {
@@ -144,6 +162,36 @@ func (c *workerServiceClient) GetFlowSteps(ctx context.Context, in *emptypb.Empt
 	return out, nil
 }
 
+func (c *workerServiceClient) SetStepEnabled(ctx context.Context, in *SetStepEnabledRequest, opts ...grpc.CallOption) (*SetStepEnabledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStepEnabledResponse)
+	err := c.cc.Invoke(ctx, WorkerService_SetStepEnabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunsResponse)
+	err := c.cc.Invoke(ctx, WorkerService_ListRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*RunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunResponse)
+	err := c.cc.Invoke(ctx, WorkerService_GetRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerServiceClient) GetMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetricsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MetricsResponse)
@@ -183,6 +231,56 @@ func (c *workerServiceClient) GetConfig(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *workerServiceClient) TriggerFlow(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, WorkerService_TriggerFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) TriggerEvent(ctx context.Context, in *TriggerEventRequest, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, WorkerService_TriggerEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) PauseWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, WorkerService_PauseWorker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) ResumeWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, WorkerService_ResumeWorker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerServiceClient) CancelCurrentCycle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ControlResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlResponse)
+	err := c.cc.Invoke(ctx, WorkerService_CancelCurrentCycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkerServiceServer is the server API for WorkerService service.
 // All implementations should embed UnimplementedWorkerServiceServer
 // for forward compatibility.
@@ -200,11 +298,21 @@ type WorkerServiceServer interface {
 	// Flow configuration
 	GetFlow(context.Context, *emptypb.Empty) (*FlowResponse, error)
 	GetFlowSteps(context.Context, *emptypb.Empty) (*FlowStepsResponse, error)
+	SetStepEnabled(context.Context, *SetStepEnabledRequest) (*SetStepEnabledResponse, error)
+	// Run history
+	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	GetRun(context.Context, *GetRunRequest) (*RunResponse, error)
 	// Metrics
 	GetMetrics(context.Context, *emptypb.Empty) (*MetricsResponse, error)
 	StreamMetrics(*StreamMetricsRequest, grpc.ServerStreamingServer[MetricsUpdate]) error
 	// Configuration
 	GetConfig(context.Context, *emptypb.Empty) (*ConfigResponse, error)
+	// Control
+	TriggerFlow(context.Context, *emptypb.Empty) (*ControlResponse, error)
+	TriggerEvent(context.Context, *TriggerEventRequest) (*ControlResponse, error)
+	PauseWorker(context.Context, *emptypb.Empty) (*ControlResponse, error)
+	ResumeWorker(context.Context, *emptypb.Empty) (*ControlResponse, error)
+	CancelCurrentCycle(context.Context, *emptypb.Empty) (*ControlResponse, error)
 }
 
 // UnimplementedWorkerServiceServer should be embedded to have
@@ -235,6 +343,15 @@ func (UnimplementedWorkerServiceServer) GetFlow(context.Context, *emptypb.Empty)
 func (UnimplementedWorkerServiceServer) GetFlowSteps(context.Context, *emptypb.Empty) (*FlowStepsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFlowSteps not implemented")
 }
+func (UnimplementedWorkerServiceServer) SetStepEnabled(context.Context, *SetStepEnabledRequest) (*SetStepEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStepEnabled not implemented")
+}
+func (UnimplementedWorkerServiceServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuns not implemented")
+}
+func (UnimplementedWorkerServiceServer) GetRun(context.Context, *GetRunRequest) (*RunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRun not implemented")
+}
 func (UnimplementedWorkerServiceServer) GetMetrics(context.Context, *emptypb.Empty) (*MetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
@@ -244,6 +361,21 @@ func (UnimplementedWorkerServiceServer) StreamMetrics(*StreamMetricsRequest, grp
 func (UnimplementedWorkerServiceServer) GetConfig(context.Context, *emptypb.Empty) (*ConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
 }
+func (UnimplementedWorkerServiceServer) TriggerFlow(context.Context, *emptypb.Empty) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerFlow not implemented")
+}
+func (UnimplementedWorkerServiceServer) TriggerEvent(context.Context, *TriggerEventRequest) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerEvent not implemented")
+}
+func (UnimplementedWorkerServiceServer) PauseWorker(context.Context, *emptypb.Empty) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorker not implemented")
+}
+func (UnimplementedWorkerServiceServer) ResumeWorker(context.Context, *emptypb.Empty) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorker not implemented")
+}
+func (UnimplementedWorkerServiceServer) CancelCurrentCycle(context.Context, *emptypb.Empty) (*ControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCurrentCycle not implemented")
+}
 func (UnimplementedWorkerServiceServer) testEmbeddedByValue() {}
 
 // UnsafeWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -383,6 +515,60 @@ func _WorkerService_GetFlowSteps_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerService_SetStepEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStepEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).SetStepEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_SetStepEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).SetStepEnabled(ctx, req.(*SetStepEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).ListRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_ListRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).ListRuns(ctx, req.(*ListRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_GetRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).GetRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_GetRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).GetRun(ctx, req.(*GetRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkerService_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -430,6 +616,96 @@ func _WorkerService_GetConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkerService_TriggerFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).TriggerFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_TriggerFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).TriggerFlow(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_TriggerEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).TriggerEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_TriggerEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).TriggerEvent(ctx, req.(*TriggerEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_PauseWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).PauseWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_PauseWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).PauseWorker(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_ResumeWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).ResumeWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_ResumeWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).ResumeWorker(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerService_CancelCurrentCycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServiceServer).CancelCurrentCycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkerService_CancelCurrentCycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServiceServer).CancelCurrentCycle(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkerService_ServiceDesc is the grpc.ServiceDesc for WorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -461,6 +737,18 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFlowSteps",
 			Handler:    _WorkerService_GetFlowSteps_Handler,
 		},
+		{
+			MethodName: "SetStepEnabled",
+			Handler:    _WorkerService_SetStepEnabled_Handler,
+		},
+		{
+			MethodName: "ListRuns",
+			Handler:    _WorkerService_ListRuns_Handler,
+		},
+		{
+			MethodName: "GetRun",
+			Handler:    _WorkerService_GetRun_Handler,
+		},
 		{
 			MethodName: "GetMetrics",
 			Handler:    _WorkerService_GetMetrics_Handler,
@@ -469,6 +757,26 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfig",
 			Handler:    _WorkerService_GetConfig_Handler,
 		},
+		{
+			MethodName: "TriggerFlow",
+			Handler:    _WorkerService_TriggerFlow_Handler,
+		},
+		{
+			MethodName: "TriggerEvent",
+			Handler:    _WorkerService_TriggerEvent_Handler,
+		},
+		{
+			MethodName: "PauseWorker",
+			Handler:    _WorkerService_PauseWorker_Handler,
+		},
+		{
+			MethodName: "ResumeWorker",
+			Handler:    _WorkerService_ResumeWorker_Handler,
+		},
+		{
+			MethodName: "CancelCurrentCycle",
+			Handler:    _WorkerService_CancelCurrentCycle_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
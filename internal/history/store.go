@@ -0,0 +1,298 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// RunsFile is the JSONL file in the worker directory that holds run records
+const RunsFile = "runs.jsonl"
+
+// DefaultMaxRuns is the number of runs kept when the retention is not configured
+const DefaultMaxRuns = 1000
+
+// compactionRatio is the share of removed runs, relative to the runs kept, that the file
+// may hold before it is rewritten. Compacting on every append would rewrite the whole
+// file each time once the limit is reached.
+const compactionRatio = 10
+
+// ErrRunNotFound is returned when a run ID is not present in the history
+var ErrRunNotFound = errors.New("run not found")
+
+// Retention bounds the runs kept in the history. Zero values mean no limit.
+type Retention struct {
+	MaxRuns int           // Number of newest runs to keep
+	MaxAge  time.Duration // Runs that started longer ago are removed
+}
+
+// Store persists run records as JSON lines in the worker directory. An in-memory index
+// of record offsets lets List and Get read only the records they return; it is extended
+// with the lines appended since the last call, so the file is parsed once per process.
+type Store struct {
+	path      string
+	retention Retention
+
+	mu      sync.Mutex
+	index   []indexEntry // Records in file order
+	indexed os.FileInfo  // File the index describes; nil until it is built
+	size    int64        // Bytes of the file covered by the index
+}
+
+// indexEntry locates a record in the history file
+type indexEntry struct {
+	id     string
+	start  time.Time
+	offset int64
+	length int64 // Including the trailing newline
+}
+
+// NewStore creates a run history store for the given worker directory
+func NewStore(workingDir string, retention Retention) *Store {
+	return &Store{
+		path:      filepath.Join(workingDir, RunsFile),
+		retention: retention,
+	}
+}
+
+// Append adds a run record to the history. Secret values are redacted before the record
+// is stored. Runs outside the retention are removed from the file once enough of them
+// have accumulated.
+func (s *Store) Append(record RunRecord) error {
+	data, err := json.Marshal(redactRecord(record))
+	if err != nil {
+		return fmt.Errorf("failed to encode run record: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open run history: %w", err)
+	}
+	_, err = file.Write(append(data, '\n'))
+	file.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write run record: %w", err)
+	}
+
+	if err := s.refresh(); err != nil {
+		return err
+	}
+	live := s.live(time.Now())
+	if removed := len(s.index) - len(live); removed > 0 && removed*compactionRatio >= len(live) {
+		return s.compact(live)
+	}
+	return nil
+}
+
+// List returns run records newest first, skipping offset records and returning at most
+// limit records (0 means no limit), together with the total number of runs
+func (s *Store) List(limit, offset int) ([]RunRecord, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, 0, err
+	}
+	live := s.live(time.Now())
+
+	var entries []indexEntry
+	for i := len(live) - 1 - offset; i >= 0; i-- {
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+		entries = append(entries, live[i])
+	}
+
+	records, err := s.read(entries)
+	if err != nil {
+		return nil, 0, err
+	}
+	return records, len(live), nil
+}
+
+// Get returns the run record with the given ID
+func (s *Store) Get(id string) (*RunRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	live := s.live(time.Now())
+
+	for i := len(live) - 1; i >= 0; i-- {
+		if live[i].id != id {
+			continue
+		}
+		records, err := s.read(live[i : i+1])
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 1 {
+			return &records[0], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrRunNotFound, id)
+}
+
+// live returns the index entries within the retention, in file order. Caller must hold mu.
+func (s *Store) live(now time.Time) []indexEntry {
+	entries := s.index
+	if s.retention.MaxAge > 0 {
+		cutoff := now.Add(-s.retention.MaxAge)
+		entries = make([]indexEntry, 0, len(s.index))
+		for _, entry := range s.index {
+			if !entry.start.Before(cutoff) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	if s.retention.MaxRuns > 0 && len(entries) > s.retention.MaxRuns {
+		entries = entries[len(entries)-s.retention.MaxRuns:]
+	}
+	return entries
+}
+
+// refresh brings the index up to date with the file. Appended lines are indexed
+// incrementally; a file that was replaced or truncated is indexed again from the start.
+// Caller must hold mu.
+func (s *Store) refresh() error {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		s.index, s.indexed, s.size = nil, nil, 0
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open run history: %w", err)
+	}
+
+	if s.indexed == nil || !os.SameFile(s.indexed, info) || info.Size() < s.size {
+		s.index, s.size = nil, 0
+	}
+	s.indexed = info
+	if info.Size() == s.size {
+		return nil
+	}
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to open run history: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(s.size, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read run history: %w", err)
+	}
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// A line without newline is still being written (or was cut off by a crash)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read run history: %w", err)
+		}
+
+		// Lines that cannot be parsed, e.g. a partial write after a crash, are skipped
+		var header struct {
+			ID    string    `json:"id"`
+			Start time.Time `json:"start"`
+		}
+		if json.Unmarshal(line, &header) == nil {
+			s.index = append(s.index, indexEntry{id: header.ID, start: header.Start, offset: s.size, length: int64(len(line))})
+		}
+		s.size += int64(len(line))
+	}
+}
+
+// read loads the records of index entries. Caller must hold mu.
+func (s *Store) read(entries []indexEntry) ([]RunRecord, error) {
+	records := make([]RunRecord, 0, len(entries))
+	if len(entries) == 0 {
+		return records, nil
+	}
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open run history: %w", err)
+	}
+	defer file.Close()
+
+	for _, entry := range entries {
+		line := make([]byte, entry.length)
+		if _, err := file.ReadAt(line, entry.offset); err != nil {
+			return nil, fmt.Errorf("failed to read run history: %w", err)
+		}
+		var record RunRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// compact rewrites the history file with only the live records and replaces it atomically.
+// Caller must hold mu.
+func (s *Store) compact(live []indexEntry) error {
+	source, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to open run history: %w", err)
+	}
+	defer source.Close()
+
+	tmpPath := s.path + ".tmp"
+	target, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to compact run history: %w", err)
+	}
+
+	index := make([]indexEntry, 0, len(live))
+	var size int64
+	writer := bufio.NewWriter(target)
+	for _, entry := range live {
+		if _, err := io.Copy(writer, io.NewSectionReader(source, entry.offset, entry.length)); err != nil {
+			target.Close()
+			os.Remove(tmpPath)
+			return fmt.Errorf("failed to compact run history: %w", err)
+		}
+		entry.offset = size
+		index = append(index, entry)
+		size += entry.length
+	}
+	if err := writer.Flush(); err != nil {
+		target.Close()
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to compact run history: %w", err)
+	}
+	if err := target.Close(); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to compact run history: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		os.Remove(tmpPath)
+		return fmt.Errorf("failed to compact run history: %w", err)
+	}
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		s.index, s.indexed, s.size = nil, nil, 0
+		return fmt.Errorf("failed to compact run history: %w", err)
+	}
+	s.index, s.indexed, s.size = index, info, size
+	return nil
+}
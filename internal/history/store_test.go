@@ -0,0 +1,237 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"autoteam/internal/secrets"
+)
+
+func TestStore_AppendListGet(t *testing.T) {
+	store := NewStore(t.TempDir(), Retention{})
+
+	records, total, err := store.List(0, 0)
+	if err != nil {
+		t.Fatalf("List on empty history failed: %v", err)
+	}
+	if total != 0 || len(records) != 0 {
+		t.Errorf("Expected empty history, got %d records (total %d)", len(records), total)
+	}
+
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		record := RunRecord{
+			ID:     NewRunID(start.Add(time.Duration(i) * time.Minute)),
+			Start:  start.Add(time.Duration(i) * time.Minute),
+			Status: RunStatusSuccess,
+			Steps:  []StepRecord{{Name: "collect", Status: StepStatusSuccess, Prompt: "p", Attempts: 1}},
+		}
+		if err := store.Append(record); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	records, total, err = store.List(2, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 3 || len(records) != 2 {
+		t.Fatalf("Expected 2 of 3 records, got %d of %d", len(records), total)
+	}
+	if !records[0].Start.After(records[1].Start) {
+		t.Error("Expected newest run first")
+	}
+
+	records, _, err = store.List(0, 2)
+	if err != nil {
+		t.Fatalf("List with offset failed: %v", err)
+	}
+	if len(records) != 1 || !records[0].Start.Equal(start) {
+		t.Errorf("Expected the oldest run after offset, got %+v", records)
+	}
+
+	run, err := store.Get(records[0].ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if len(run.Steps) != 1 || run.Steps[0].Prompt != "p" {
+		t.Errorf("Unexpected steps: %+v", run.Steps)
+	}
+
+	if _, err := store.Get("missing"); !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("Expected ErrRunNotFound, got %v", err)
+	}
+}
+
+func TestStore_AppendRedactsSecrets(t *testing.T) {
+	secrets.Register("history_token", "history-secret-value")
+	store := NewStore(t.TempDir(), Retention{})
+
+	record := RunRecord{
+		ID:     NewRunID(time.Now()),
+		Status: RunStatusFailed,
+		Error:  "request with history-secret-value failed",
+		Steps: []StepRecord{{
+			Name:       "deploy",
+			Prompt:     "use token history-secret-value",
+			Stdout:     "history-secret-value",
+			Iterations: []IterationRecord{{Iteration: 1, Stderr: "bad token history-secret-value"}},
+		}},
+	}
+	if err := store.Append(record); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(filepath.Dir(store.path), RunsFile))
+	if err != nil {
+		t.Fatalf("Failed to read history: %v", err)
+	}
+	if strings.Contains(string(data), "history-secret-value") {
+		t.Errorf("Stored history contains the secret: %s", data)
+	}
+
+	run, err := store.Get(record.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if run.Steps[0].Prompt != "use token [REDACTED:history_token]" || run.Steps[0].Iterations[0].Stderr != "bad token [REDACTED:history_token]" {
+		t.Errorf("Unexpected redacted step: %+v", run.Steps[0])
+	}
+	if record.Steps[0].Stdout != "history-secret-value" {
+		t.Error("Append modified the caller's record")
+	}
+}
+
+func TestStore_SkipsCorruptLines(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir, Retention{})
+
+	if err := store.Append(RunRecord{ID: "first", Status: RunStatusFailed}); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	// Simulate a partial write from a crash
+	file, err := os.OpenFile(filepath.Join(dir, RunsFile), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open history: %v", err)
+	}
+	file.WriteString(`{"id":"broken"` + "\n")
+	file.Close()
+
+	if err := store.Append(RunRecord{ID: "second", Status: RunStatusSuccess}); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+
+	records, total, err := store.List(0, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 2 || records[0].ID != "second" || records[1].ID != "first" {
+		t.Errorf("Unexpected records: %+v", records)
+	}
+}
+
+func TestStore_RetentionMaxRuns(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir, Retention{MaxRuns: 10})
+
+	start := time.Now()
+	var ids []string
+	for i := 0; i < 11; i++ {
+		record := RunRecord{ID: fmt.Sprintf("run-%02d", i), Start: start.Add(time.Duration(i) * time.Second), Status: RunStatusSuccess}
+		if err := store.Append(record); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+		ids = append(ids, record.ID)
+	}
+
+	// The oldest run is hidden at once and removed from the file by the compaction
+	records, total, err := store.List(0, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 10 || records[0].ID != ids[10] || records[9].ID != ids[1] {
+		t.Errorf("Expected the 10 newest runs, got %d (total %d)", len(records), total)
+	}
+	if _, err := store.Get(ids[0]); !errors.Is(err, ErrRunNotFound) {
+		t.Errorf("Expected the oldest run to be removed, got %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, RunsFile))
+	if err != nil {
+		t.Fatalf("Failed to read history: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 10 || strings.Contains(string(data), ids[0]) {
+		t.Errorf("Expected the history file to be compacted to 10 runs, got %d lines", lines)
+	}
+
+	// The store keeps working on the compacted file
+	if err := store.Append(RunRecord{ID: "latest", Start: start.Add(time.Minute), Status: RunStatusSuccess}); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if run, err := store.Get("latest"); err != nil || run.ID != "latest" {
+		t.Errorf("Get after compaction = %v, %v", run, err)
+	}
+}
+
+func TestStore_RetentionMaxAge(t *testing.T) {
+	store := NewStore(t.TempDir(), Retention{MaxAge: time.Hour})
+
+	now := time.Now()
+	for i, start := range []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Minute)} {
+		if err := store.Append(RunRecord{ID: fmt.Sprintf("run-%d", i), Start: start, Status: RunStatusSuccess}); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	records, total, err := store.List(0, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 1 || records[0].ID != "run-2" {
+		t.Errorf("Expected only the recent run, got %+v", records)
+	}
+}
+
+func TestStore_ReadsRunsAppendedByOtherStores(t *testing.T) {
+	dir := t.TempDir()
+	writer := NewStore(dir, Retention{MaxRuns: 2})
+	reader := NewStore(dir, Retention{MaxRuns: 2})
+
+	if err := writer.Append(RunRecord{ID: "first", Status: RunStatusSuccess}); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if _, err := reader.Get("first"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+
+	// New lines are indexed incrementally, and a compacted file is indexed again
+	for _, id := range []string{"second", "third"} {
+		if err := writer.Append(RunRecord{ID: id, Status: RunStatusSuccess}); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+	records, total, err := reader.List(0, 0)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if total != 2 || records[0].ID != "third" || records[1].ID != "second" {
+		t.Errorf("Unexpected records: %+v", records)
+	}
+}
+
+func TestNewRunID(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	first, second := NewRunID(start), NewRunID(start)
+
+	if !strings.HasPrefix(first, "20250102T030405.000Z-") {
+		t.Errorf("Unexpected run ID format: %s", first)
+	}
+	if first == second {
+		t.Error("Expected unique run IDs")
+	}
+}
@@ -0,0 +1,111 @@
+package history
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"autoteam/internal/secrets"
+)
+
+// Run statuses
+const (
+	RunStatusSuccess  = "success"
+	RunStatusFailed   = "failed"
+	RunStatusCanceled = "canceled"
+	RunStatusTimedOut = "timed_out"
+)
+
+// Step statuses
+const (
+	StepStatusSuccess  = "success"
+	StepStatusFailed   = "failed"
+	StepStatusSkipped  = "skipped"
+	StepStatusCanceled = "canceled"
+	StepStatusReused   = "reused"
+	StepStatusTimedOut = "timed_out"
+	StepStatusNotTaken = "not_taken"
+)
+
+// RunRecord is the persisted record of a single flow execution
+type RunRecord struct {
+	ID       string        `json:"id"`
+	Start    time.Time     `json:"start"`
+	End      time.Time     `json:"end"`
+	Duration time.Duration `json:"duration"`
+	Status   string        `json:"status"`
+	Error    string        `json:"error,omitempty"`
+	Steps    []StepRecord  `json:"steps"`
+}
+
+// StepRecord is the persisted record of a single step within a run
+type StepRecord struct {
+	Name       string        `json:"name"`
+	Status     string        `json:"status"`
+	Prompt     string        `json:"prompt,omitempty"`
+	Stdout     string        `json:"stdout,omitempty"`
+	Stderr     string        `json:"stderr,omitempty"`
+	Attempts   int           `json:"attempts"`
+	SkipReason string        `json:"skip_reason,omitempty"`
+	Duration   time.Duration `json:"duration"`
+
+	Iterations []IterationRecord `json:"iterations,omitempty"` // Iterations of a loop step
+}
+
+// IterationRecord is the record of a single iteration of a loop step. Prompt and output
+// are those of the last step run in the iteration.
+type IterationRecord struct {
+	Iteration int           `json:"iteration"`
+	Prompt    string        `json:"prompt,omitempty"`
+	Stdout    string        `json:"stdout,omitempty"`
+	Stderr    string        `json:"stderr,omitempty"`
+	ExitCode  int           `json:"exit_code,omitempty"`
+	Error     string        `json:"error,omitempty"` // Why the iteration failed; the loop continues
+	Attempts  int           `json:"attempts"`
+	Duration  time.Duration `json:"duration"`
+
+	Steps []StepRecord `json:"steps,omitempty"` // Steps run in the iteration of a loop step with steps
+}
+
+// NewRunID returns a sortable, unique run identifier for a run starting at t
+func NewRunID(t time.Time) string {
+	suffix := make([]byte, 3)
+	_, _ = rand.Read(suffix)
+	return t.UTC().Format("20060102T150405.000Z") + "-" + hex.EncodeToString(suffix)
+}
+
+// redactRecord returns a copy of a run record with secret values redacted from the
+// prompts, outputs and errors
+func redactRecord(record RunRecord) RunRecord {
+	record.Error = secrets.Redact(record.Error)
+	record.Steps = redactSteps(record.Steps)
+	return record
+}
+
+// redactSteps returns copies of step records with secret values redacted
+func redactSteps(records []StepRecord) []StepRecord {
+	if records == nil {
+		return nil
+	}
+	steps := make([]StepRecord, len(records))
+	for i, step := range records {
+		step.Prompt = secrets.Redact(step.Prompt)
+		step.Stdout = secrets.Redact(step.Stdout)
+		step.Stderr = secrets.Redact(step.Stderr)
+		step.SkipReason = secrets.Redact(step.SkipReason)
+		if step.Iterations != nil {
+			iterations := make([]IterationRecord, len(step.Iterations))
+			for j, iteration := range step.Iterations {
+				iteration.Prompt = secrets.Redact(iteration.Prompt)
+				iteration.Stdout = secrets.Redact(iteration.Stdout)
+				iteration.Stderr = secrets.Redact(iteration.Stderr)
+				iteration.Error = secrets.Redact(iteration.Error)
+				iteration.Steps = redactSteps(iteration.Steps)
+				iterations[j] = iteration
+			}
+			step.Iterations = iterations
+		}
+		steps[i] = step
+	}
+	return steps
+}
@@ -55,7 +55,8 @@ type contextKey struct{}
 
 var loggerKey = contextKey{}
 
-// NewLogger creates a new zap logger with the specified level
+// NewLogger creates a new zap logger with the specified level. Secret values are redacted
+// from messages and fields.
 func NewLogger(level LogLevel) (*zap.Logger, error) {
 	config := zap.NewProductionConfig()
 	config.Level = zap.NewAtomicLevelAt(level.zapLevel())
@@ -69,7 +70,7 @@ func NewLogger(level LogLevel) (*zap.Logger, error) {
 	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	config.DisableStacktrace = true // Reduce noise
 
-	return config.Build(zap.AddCallerSkip(1))
+	return config.Build(zap.AddCallerSkip(1), zap.WrapCore(newRedactingCore))
 }
 
 // WithLogger adds a logger to the context
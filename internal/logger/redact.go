@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"encoding/json"
+
+	"autoteam/internal/secrets"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// redactingCore removes secret values from log messages and fields before they are written
+type redactingCore struct {
+	zapcore.Core
+}
+
+// newRedactingCore wraps a core with secret redaction
+func newRedactingCore(core zapcore.Core) zapcore.Core {
+	return &redactingCore{Core: core}
+}
+
+// With redacts the fields added to every entry of a child logger
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+// Check adds this core instead of the wrapped one, so that Write redacts the entry
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// Write redacts the message and fields of an entry
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = secrets.Redact(entry.Message)
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+// redactFields returns the fields with secret values redacted
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	if !secrets.Registered() {
+		return fields
+	}
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		redacted[i] = redactField(field)
+	}
+	return redacted
+}
+
+// redactField redacts a single field. Fields other than strings, e.g. errors or values
+// logged with zap.Any, are encoded first and only replaced if they contain a secret.
+func redactField(field zapcore.Field) zapcore.Field {
+	switch field.Type {
+	case zapcore.StringType:
+		field.String = secrets.Redact(field.String)
+		return field
+	case zapcore.ByteStringType, zapcore.ErrorType, zapcore.StringerType, zapcore.ReflectType,
+		zapcore.ArrayMarshalerType, zapcore.ObjectMarshalerType:
+	default:
+		return field // Numbers, booleans, times and namespaces cannot contain secrets
+	}
+
+	encoder := zapcore.NewMapObjectEncoder()
+	field.AddTo(encoder)
+	value, ok := encoder.Fields[field.Key]
+	if !ok {
+		return field
+	}
+	if redacted, changed := redactValue(value); changed {
+		return zap.Any(field.Key, redacted)
+	}
+	return field
+}
+
+// redactValue redacts the strings in an encoded field value and reports whether any changed
+func redactValue(value interface{}) (interface{}, bool) {
+	switch v := value.(type) {
+	case string:
+		redacted := secrets.Redact(v)
+		return redacted, redacted != v
+	case map[string]interface{}:
+		changed := false
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			var itemChanged bool
+			result[key], itemChanged = redactValue(item)
+			changed = changed || itemChanged
+		}
+		return result, changed
+	case []interface{}:
+		changed := false
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			var itemChanged bool
+			result[i], itemChanged = redactValue(item)
+			changed = changed || itemChanged
+		}
+		return result, changed
+	case bool, float64, int64, nil:
+		return value, false
+	default:
+		// Values logged with zap.Any are kept as is by the encoder, e.g. map[string]string
+		data, err := json.Marshal(v)
+		if err != nil {
+			return value, false
+		}
+		var generic interface{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return value, false
+		}
+		switch generic.(type) {
+		case string, map[string]interface{}, []interface{}:
+			if redacted, changed := redactValue(generic); changed {
+				return redacted, true
+			}
+		}
+		return value, false
+	}
+}
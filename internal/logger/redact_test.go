@@ -0,0 +1,57 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"autoteam/internal/secrets"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedactingCore(t *testing.T) {
+	secrets.Register("logger_token", "logger-secret-value")
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	log := zap.New(newRedactingCore(core)).With(zap.String("auth", "Bearer logger-secret-value"))
+
+	log.Info("using logger-secret-value",
+		zap.String("token", "logger-secret-value"),
+		zap.Error(errors.New("request with logger-secret-value failed")),
+		zap.Any("env", map[string]string{"GITHUB_TOKEN": "logger-secret-value", "LOG_LEVEL": "debug"}),
+		zap.Strings("args", []string{"--token", "logger-secret-value"}),
+		zap.Int("attempt", 2),
+	)
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 log entry, got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.Message != "using [REDACTED:logger_token]" {
+		t.Errorf("Expected redacted message, got %q", entry.Message)
+	}
+
+	fields := entry.ContextMap()
+	expected := map[string]interface{}{
+		"auth":  "Bearer [REDACTED:logger_token]",
+		"token": "[REDACTED:logger_token]",
+		"error": "request with [REDACTED:logger_token] failed",
+	}
+	for key, want := range expected {
+		if fields[key] != want {
+			t.Errorf("Field %s = %v, want %v", key, fields[key], want)
+		}
+	}
+	if env := fields["env"].(map[string]interface{}); env["GITHUB_TOKEN"] != "[REDACTED:logger_token]" || env["LOG_LEVEL"] != "debug" {
+		t.Errorf("Field env = %v", env)
+	}
+	if args := fields["args"].([]interface{}); args[1] != "[REDACTED:logger_token]" {
+		t.Errorf("Field args = %v", args)
+	}
+	if fields["attempt"] != int64(2) {
+		t.Errorf("Field attempt = %v, want 2", fields["attempt"])
+	}
+}
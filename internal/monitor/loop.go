@@ -7,6 +7,7 @@ import (
 
 	"autoteam/internal/flow"
 	"autoteam/internal/logger"
+	"autoteam/internal/schedule"
 	"autoteam/internal/task"
 	"autoteam/internal/worker"
 
@@ -15,7 +16,9 @@ import (
 
 // Config contains configuration for the monitor
 type Config struct {
-	SleepDuration time.Duration // Sleep duration between flow execution cycles
+	SleepDuration time.Duration      // Sleep duration between flow execution cycles
+	Schedule      *schedule.Schedule // When cycles start (default: every SleepDuration)
+	FlowTimeout   time.Duration      // Deadline for a single flow cycle (0 = no limit)
 	TeamName      string
 }
 
@@ -32,6 +35,7 @@ type Monitor struct {
 	flowExecutor  *flow.FlowExecutor // Dynamic flow executor
 	flowSteps     []worker.FlowStep  // Flow configuration
 	config        Config
+	schedule      *schedule.Schedule    // Decides when flow cycles start
 	worker        *worker.Worker        // Worker configuration
 	workerRuntime *worker.WorkerRuntime // Worker runtime for statistics tracking
 	settings      worker.WorkerSettings // Effective settings
@@ -53,11 +57,21 @@ func New(workerRuntime *worker.WorkerRuntime, monitorConfig Config) *Monitor {
 
 	// Set worker runtime for step tracking
 	flowExecutor.SetWorkerRuntime(workerRuntime)
+	flowExecutor.FlowTimeout = monitorConfig.FlowTimeout
+	flowExecutor.MaxParallel = settings.GetMaxParallel()
+	flowExecutor.FailFast = settings.GetFailFast()
+	flowExecutor.StrictTemplates = settings.GetStrictTemplates()
+
+	sched := monitorConfig.Schedule
+	if sched == nil {
+		sched = schedule.Every(monitorConfig.SleepDuration)
+	}
 
 	return &Monitor{
 		flowExecutor:  flowExecutor,
 		flowSteps:     settings.Flow,
 		config:        monitorConfig,
+		schedule:      sched,
 		worker:        w,
 		workerRuntime: workerRuntime,
 		settings:      settings,
@@ -78,7 +92,10 @@ func (m *Monitor) Start(ctx context.Context) error {
 		zap.Duration("cycle_interval", m.config.SleepDuration),
 		zap.Int("flow_steps", len(m.flowSteps)))
 
-	// Start continuous flow processing loop with sleep-based intervals
+	defer m.workerRuntime.SetNextRunTime(nil)
+
+	// Start continuous flow processing loop on the configured schedule
+	next := m.schedule.First(time.Now())
 	for {
 		// Check for cancellation before starting cycle
 		select {
@@ -88,30 +105,93 @@ func (m *Monitor) Start(ctx context.Context) error {
 		default:
 		}
 
+		if m.workerRuntime.IsPaused() {
+			// Idle while paused; a manual trigger still runs a single cycle
+			m.workerRuntime.SetNextRunTime(nil)
+			triggered, ok := m.waitWhilePaused(ctx)
+			if !ok {
+				lgr.Info("Monitor shutting down gracefully")
+				return ctx.Err()
+			}
+			if !triggered {
+				// Resumed: pick the schedule up again from now
+				next = m.schedule.First(time.Now())
+				continue
+			}
+		} else {
+			triggered, ok := m.waitForNextRun(ctx, next)
+			if !ok {
+				lgr.Info("Monitor shutting down gracefully")
+				return ctx.Err()
+			}
+			if !triggered && m.workerRuntime.IsPaused() {
+				continue
+			}
+		}
+		m.workerRuntime.SetNextRunTime(nil)
+
 		// Execute flow processing cycle
 		cycleStart := time.Now()
 		if err := m.processFlowCycle(ctx); err != nil {
 			lgr.Error("Flow cycle failed", zap.Error(err), zap.String("error_type", fmt.Sprintf("%T", err)))
 		}
 		cycleEnd := time.Now()
-		executionDuration := cycleEnd.Sub(cycleStart)
+		next = m.schedule.Next(cycleEnd)
 
 		// Log execution timing for monitoring
 		lgr.Debug("Flow cycle completed",
-			zap.Duration("execution_time", executionDuration),
-			zap.Duration("sleep_duration", m.config.SleepDuration))
+			zap.Duration("execution_time", cycleEnd.Sub(cycleStart)),
+			zap.Time("next_run_time", next))
+	}
+}
 
-		// Sleep for the configured duration, with context cancellation check
-		lgr.Debug("Sleeping before next flow cycle", zap.Duration("sleep_duration", m.config.SleepDuration))
+// waitForNextRun blocks until the next scheduled run. It returns triggered=true when a
+// manual trigger cut the wait short and ok=false if ctx is done.
+func (m *Monitor) waitForNextRun(ctx context.Context, next time.Time) (triggered bool, ok bool) {
+	lgr := logger.FromContext(ctx)
+
+	m.workerRuntime.SetNextRunTime(&next)
+
+	wait := time.Until(next)
+	if wait <= 0 {
+		return false, true
+	}
+	lgr.Debug("Waiting for next flow cycle", zap.Time("next_run_time", next), zap.Duration("wait", wait))
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false, false
+	case <-timer.C:
+		return false, true
+	case <-m.workerRuntime.FlowTriggers():
+		lgr.Info("Flow cycle triggered manually, skipping wait")
+		return true, true
+	}
+}
 
+// waitWhilePaused blocks while the worker is paused. It returns triggered=true when a
+// manual trigger should run a cycle, triggered=false once resumed and ok=false if ctx is done.
+func (m *Monitor) waitWhilePaused(ctx context.Context) (triggered bool, ok bool) {
+	lgr := logger.FromContext(ctx)
+	lgr.Info("Worker paused, waiting for resume or trigger")
+
+	for m.workerRuntime.IsPaused() {
 		select {
 		case <-ctx.Done():
-			lgr.Info("Monitor shutting down gracefully")
-			return ctx.Err()
-		case <-time.After(m.config.SleepDuration):
-			// Continue to next cycle after sleep
+			return false, false
+		case <-m.workerRuntime.FlowTriggers():
+			lgr.Info("Running manually triggered flow cycle while paused")
+			return true, true
+		case <-m.workerRuntime.FlowResumed():
+			// Re-check the paused flag, the signal may be stale
 		}
 	}
+
+	lgr.Info("Worker resumed")
+	return false, true
 }
 
 // processFlowCycle executes one cycle of the flow-based architecture
@@ -125,13 +205,44 @@ func (m *Monitor) processFlowCycle(ctx context.Context) error {
 		defer m.workerRuntime.SetRunning(false)
 	}
 
-	// Execute the flow
-	result, err := m.flowExecutor.Execute(ctx)
+	// Execute the flow in a cycle context that can be canceled through the API
+	cycleCtx, endCycle := m.workerRuntime.BeginCycle(ctx)
+	defer endCycle()
+
+	// Expose the payload of a pending event to the flow templates
+	if event, ok := m.workerRuntime.NextEvent(); ok {
+		lgr.Info("Running flow cycle for event",
+			zap.String("source", event.Source),
+			zap.Time("received_at", event.ReceivedAt))
+
+		eventCtx, err := flow.WithEvent(cycleCtx, event.Payload)
+		if err != nil {
+			lgr.Warn("Ignoring event with invalid payload", zap.String("source", event.Source), zap.Error(err))
+		} else {
+			cycleCtx = eventCtx
+		}
+	}
+
+	startTime := time.Now()
+	result, err := m.flowExecutor.Execute(cycleCtx)
+	if cycleCtx.Err() != nil && ctx.Err() == nil {
+		lgr.Warn("Flow cycle canceled on request")
+	}
 
 	// Record flow execution statistics
 	if m.workerRuntime != nil {
 		success := err == nil && result != nil && result.Success
-		m.workerRuntime.RecordFlowExecution(success)
+		m.workerRuntime.RecordFlowExecution(success, time.Since(startTime))
+	}
+
+	// A timed out cycle means a step is stuck, report it through the on_error hooks
+	if result != nil && result.TimedOut {
+		lgr.Error("Flow cycle timed out",
+			zap.Duration("flow_timeout", m.config.FlowTimeout),
+			zap.String("run_id", result.RunID))
+		if hookErr := worker.ExecuteHooks(ctx, m.settings.Hooks, "on_error"); hookErr != nil {
+			lgr.Error("Failed to execute on_error hooks", zap.Error(hookErr))
+		}
 	}
 
 	if err != nil {
@@ -0,0 +1,160 @@
+// Package schedule decides when flow cycles run based on cron expressions,
+// fixed intervals, time windows and jitter.
+package schedule
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+	_ "time/tzdata" // Worker images may not ship a timezone database
+
+	"autoteam/internal/worker"
+)
+
+// maxWindowSearch bounds how many cron matches are checked against the windows
+const maxWindowSearch = 10000
+
+// Schedule computes the start times of flow cycles
+type Schedule struct {
+	cron     *Cron
+	interval time.Duration
+	windows  []Window
+	location *time.Location
+	jitter   time.Duration
+	randFn   func(n int64) int64 // Random source for jitter (replaced in tests)
+}
+
+// Every returns a schedule that starts cycles a fixed interval apart
+func Every(interval time.Duration) *Schedule {
+	return &Schedule{
+		interval: interval,
+		location: time.Local,
+		randFn:   rand.Int63n,
+	}
+}
+
+// New builds a schedule from configuration. Without a cron expression, cycles start
+// interval apart. A nil configuration is equivalent to Every(interval).
+func New(cfg *worker.ScheduleConfig, interval time.Duration) (*Schedule, error) {
+	s := Every(interval)
+	if cfg == nil {
+		return s, nil
+	}
+
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule timezone %q: %w", cfg.Timezone, err)
+		}
+		s.location = loc
+	}
+
+	if cfg.Cron != "" {
+		cron, err := ParseCron(cfg.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule cron: %w", err)
+		}
+		s.cron = cron
+	}
+
+	for i, windowCfg := range cfg.Windows {
+		window, err := ParseWindow(windowCfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule window[%d]: %w", i, err)
+		}
+		s.windows = append(s.windows, window)
+	}
+
+	if cfg.Jitter < 0 {
+		return nil, fmt.Errorf("schedule jitter must not be negative")
+	}
+	s.jitter = time.Duration(cfg.Jitter) * time.Second
+
+	return s, nil
+}
+
+// First returns when the first cycle should start after the worker starts. Interval
+// schedules start immediately when inside a window; cron schedules wait for the next match.
+func (s *Schedule) First(now time.Time) time.Time {
+	if s.cron != nil {
+		return s.Next(now)
+	}
+
+	start := s.align(now.In(s.location))
+	if start.Equal(now) {
+		return now
+	}
+	return s.withJitter(start)
+}
+
+// Next returns when the cycle after one finishing at now should start
+func (s *Schedule) Next(now time.Time) time.Time {
+	now = now.In(s.location)
+
+	if s.cron == nil {
+		return s.withJitter(s.align(now.Add(s.interval)))
+	}
+
+	t := now
+	for i := 0; i < maxWindowSearch; i++ {
+		candidate := s.cron.Next(t)
+		if candidate.IsZero() {
+			break
+		}
+		if s.inWindow(candidate) {
+			return s.withJitter(candidate)
+		}
+
+		// Skip ahead to the next window instead of testing every cron match
+		t = s.align(candidate).Add(-time.Minute)
+		if !t.After(candidate) {
+			t = candidate
+		}
+	}
+
+	// No run time found; fall back to the interval so the worker keeps polling
+	return s.withJitter(now.Add(s.interval))
+}
+
+// inWindow reports whether t is inside any window (always true without windows)
+func (s *Schedule) inWindow(t time.Time) bool {
+	if len(s.windows) == 0 {
+		return true
+	}
+	for _, window := range s.windows {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// align returns t if it is inside a window, otherwise the next window start
+func (s *Schedule) align(t time.Time) time.Time {
+	if s.inWindow(t) {
+		return t
+	}
+
+	var earliest time.Time
+	for _, window := range s.windows {
+		start := window.nextStart(t)
+		if start.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || start.Before(earliest) {
+			earliest = start
+		}
+	}
+	if earliest.IsZero() {
+		return t
+	}
+	return earliest
+}
+
+// withJitter delays t by a random duration below the configured jitter
+func (s *Schedule) withJitter(t time.Time) time.Time {
+	if s.jitter <= 0 {
+		return t
+	}
+	return t.Add(time.Duration(s.randFn(int64(s.jitter))))
+}
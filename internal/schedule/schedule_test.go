@@ -0,0 +1,278 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"autoteam/internal/worker"
+)
+
+func TestParseCron_Errors(t *testing.T) {
+	tests := []string{
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * foo *",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := ParseCron(expr); err == nil {
+				t.Errorf("Expected error for %q", expr)
+			}
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	// Wednesday
+	base := time.Date(2025, 1, 15, 10, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{expr: "* * * * *", expected: time.Date(2025, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", expected: time.Date(2025, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{expr: "0 2 * * *", expected: time.Date(2025, 1, 16, 2, 0, 0, 0, time.UTC)},
+		{expr: "@daily", expected: time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{expr: "30 9 * * mon-fri", expected: time.Date(2025, 1, 16, 9, 30, 0, 0, time.UTC)},
+		{expr: "0 0 * * 0", expected: time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 * * 7", expected: time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 12 1 feb *", expected: time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC)},
+		{expr: "0 8,20 * * *", expected: time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Day of month and day of week are OR-ed when both are restricted
+		{expr: "0 0 20 * fri", expected: time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron failed: %v", err)
+			}
+			if next := cron.Next(base); !next.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, next)
+			}
+		})
+	}
+}
+
+func TestWindow_Contains(t *testing.T) {
+	businessHours, err := ParseWindow(worker.ScheduleWindow{Days: []string{"mon-fri"}, Start: "09:00", End: "18:00"})
+	if err != nil {
+		t.Fatalf("ParseWindow failed: %v", err)
+	}
+	overnight, err := ParseWindow(worker.ScheduleWindow{Days: []string{"fri"}, Start: "22:00", End: "02:00"})
+	if err != nil {
+		t.Fatalf("ParseWindow failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		window   Window
+		time     time.Time
+		expected bool
+	}{
+		{name: "weekday_inside", window: businessHours, time: time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC), expected: true},
+		{name: "weekday_end_exclusive", window: businessHours, time: time.Date(2025, 1, 15, 18, 0, 0, 0, time.UTC), expected: false},
+		{name: "weekend", window: businessHours, time: time.Date(2025, 1, 18, 12, 0, 0, 0, time.UTC), expected: false},
+		{name: "overnight_before_midnight", window: overnight, time: time.Date(2025, 1, 17, 23, 0, 0, 0, time.UTC), expected: true},
+		{name: "overnight_after_midnight", window: overnight, time: time.Date(2025, 1, 18, 1, 0, 0, 0, time.UTC), expected: true},
+		{name: "overnight_other_day", window: overnight, time: time.Date(2025, 1, 16, 1, 0, 0, 0, time.UTC), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Contains(tt.time); got != tt.expected {
+				t.Errorf("Contains(%v) = %v, want %v", tt.time, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  worker.ScheduleConfig
+	}{
+		{name: "bad_cron", cfg: worker.ScheduleConfig{Cron: "not a cron"}},
+		{name: "bad_timezone", cfg: worker.ScheduleConfig{Timezone: "Mars/Olympus"}},
+		{name: "bad_window_time", cfg: worker.ScheduleConfig{Windows: []worker.ScheduleWindow{{Start: "9am", End: "18:00"}}}},
+		{name: "bad_window_day", cfg: worker.ScheduleConfig{Windows: []worker.ScheduleWindow{{Days: []string{"someday"}, Start: "09:00", End: "18:00"}}}},
+		{name: "negative_jitter", cfg: worker.ScheduleConfig{Jitter: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(&tt.cfg, time.Minute); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestSchedule_Interval(t *testing.T) {
+	s, err := New(nil, time.Minute)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	now := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+	if first := s.First(now); !first.Equal(now) {
+		t.Errorf("Expected immediate first run, got %v", first)
+	}
+	if next := s.Next(now); !next.Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected next run a minute later, got %v", next)
+	}
+}
+
+func TestSchedule_IntervalWithWindow(t *testing.T) {
+	s, err := New(&worker.ScheduleConfig{
+		Timezone: "Europe/Berlin",
+		Windows:  []worker.ScheduleWindow{{Days: []string{"mon-fri"}, Start: "09:00", End: "18:00"}},
+	}, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	// Friday 17:55 in Berlin: the next interval falls outside the window, wait for Monday 09:00
+	now := time.Date(2025, 1, 17, 17, 55, 0, 0, berlin)
+	expected := time.Date(2025, 1, 20, 9, 0, 0, 0, berlin)
+	if next := s.Next(now); !next.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, next)
+	}
+
+	// Starting on Saturday waits for the window as well
+	saturday := time.Date(2025, 1, 18, 12, 0, 0, 0, berlin)
+	if first := s.First(saturday); !first.Equal(expected) {
+		t.Errorf("Expected first run %v, got %v", expected, first)
+	}
+
+	// Inside the window the worker starts right away
+	inside := time.Date(2025, 1, 20, 10, 0, 0, 0, berlin)
+	if first := s.First(inside); !first.Equal(inside) {
+		t.Errorf("Expected immediate first run, got %v", first)
+	}
+}
+
+func TestSchedule_CronWithWindow(t *testing.T) {
+	s, err := New(&worker.ScheduleConfig{
+		Cron:    "*/30 * * * *",
+		Windows: []worker.ScheduleWindow{{Days: []string{"mon-fri"}, Start: "09:00", End: "18:00"}},
+	}, time.Minute)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	s.location = time.UTC
+
+	now := time.Date(2025, 1, 17, 17, 40, 0, 0, time.UTC)
+	expected := time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+	if next := s.Next(now); !next.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, next)
+	}
+	if first := s.First(now); !first.Equal(expected) {
+		t.Errorf("Expected cron schedules to wait for the first match, got %v", first)
+	}
+}
+
+func TestSchedule_Jitter(t *testing.T) {
+	s, err := New(&worker.ScheduleConfig{Cron: "0 2 * * *", Timezone: "UTC", Jitter: 300}, time.Minute)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	var requested int64
+	s.randFn = func(n int64) int64 {
+		requested = n
+		return int64(90 * time.Second)
+	}
+
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+	expected := time.Date(2025, 1, 16, 2, 1, 30, 0, time.UTC)
+	if next := s.Next(now); !next.Equal(expected) {
+		t.Errorf("Expected %v, got %v", expected, next)
+	}
+	if requested != int64(5*time.Minute) {
+		t.Errorf("Expected jitter bound of 5m, got %v", time.Duration(requested))
+	}
+}
+
+func TestForStep(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    worker.FlowStep
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "unscheduled", step: worker.FlowStep{Name: "a"}, wantNil: true},
+		{name: "every", step: worker.FlowStep{Name: "a", Every: "10m"}},
+		{name: "cron_with_skip", step: worker.FlowStep{Name: "a", Cron: "@weekly", NotDuePolicy: worker.NotDuePolicySkip}},
+		{name: "both", step: worker.FlowStep{Name: "a", Every: "10m", Cron: "@daily"}, wantErr: true},
+		{name: "bad_every", step: worker.FlowStep{Name: "a", Every: "weekly"}, wantErr: true},
+		{name: "negative_every", step: worker.FlowStep{Name: "a", Every: "-1h"}, wantErr: true},
+		{name: "bad_policy", step: worker.FlowStep{Name: "a", Every: "1h", NotDuePolicy: "wait"}, wantErr: true},
+		{name: "policy_without_schedule", step: worker.FlowStep{Name: "a", NotDuePolicy: worker.NotDuePolicySkip}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ForStep(tt.step)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ForStep failed: %v", err)
+			}
+			if (s == nil) != tt.wantNil {
+				t.Errorf("Expected nil schedule = %v, got %v", tt.wantNil, s)
+			}
+		})
+	}
+}
+
+func TestStepSchedule_IsDue(t *testing.T) {
+	every, err := ForStep(worker.FlowStep{Name: "collector", Every: "1h"})
+	if err != nil {
+		t.Fatalf("ForStep failed: %v", err)
+	}
+	weekly, err := ForStep(worker.FlowStep{Name: "summary", Cron: "0 9 * * mon"})
+	if err != nil {
+		t.Fatalf("ForStep failed: %v", err)
+	}
+
+	// Wednesday
+	lastRun := time.Date(2025, 1, 15, 10, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		schedule *StepSchedule
+		lastRun  time.Time
+		now      time.Time
+		expected bool
+	}{
+		{name: "never_ran", schedule: every, now: lastRun, expected: true},
+		{name: "every_not_due", schedule: every, lastRun: lastRun, now: lastRun.Add(59 * time.Minute), expected: false},
+		{name: "every_due", schedule: every, lastRun: lastRun, now: lastRun.Add(time.Hour), expected: true},
+		{name: "cron_not_due", schedule: weekly, lastRun: lastRun, now: time.Date(2025, 1, 20, 8, 59, 0, 0, time.Local), expected: false},
+		{name: "cron_due", schedule: weekly, lastRun: lastRun, now: time.Date(2025, 1, 20, 9, 0, 0, 0, time.Local), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.IsDue(tt.lastRun, tt.now); got != tt.expected {
+				t.Errorf("IsDue() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
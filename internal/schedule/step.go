@@ -0,0 +1,71 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"autoteam/internal/worker"
+)
+
+// StepSchedule decides whether a flow step with an every or cron field is due
+type StepSchedule struct {
+	every time.Duration
+	cron  *Cron
+}
+
+// ForStep parses the schedule fields of a flow step. It returns nil for steps
+// that run every cycle.
+func ForStep(step worker.FlowStep) (*StepSchedule, error) {
+	if step.Every == "" && step.Cron == "" {
+		if step.NotDuePolicy != "" {
+			return nil, fmt.Errorf("not_due_policy requires every or cron")
+		}
+		return nil, nil
+	}
+	if step.Every != "" && step.Cron != "" {
+		return nil, fmt.Errorf("every and cron are mutually exclusive")
+	}
+
+	switch step.NotDuePolicy {
+	case "", worker.NotDuePolicyReuse, worker.NotDuePolicySkip:
+	default:
+		return nil, fmt.Errorf("invalid not_due_policy %q (expected %q or %q)", step.NotDuePolicy, worker.NotDuePolicyReuse, worker.NotDuePolicySkip)
+	}
+
+	s := &StepSchedule{}
+	if step.Every != "" {
+		every, err := time.ParseDuration(step.Every)
+		if err != nil {
+			return nil, fmt.Errorf("invalid every %q: %w", step.Every, err)
+		}
+		if every <= 0 {
+			return nil, fmt.Errorf("every must be positive")
+		}
+		s.every = every
+	} else {
+		cron, err := ParseCron(step.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron: %w", err)
+		}
+		s.cron = cron
+	}
+	return s, nil
+}
+
+// NextRun returns when a step that last ran at lastRun is due again. Cron
+// expressions are evaluated in local time.
+func (s *StepSchedule) NextRun(lastRun time.Time) time.Time {
+	if s.cron != nil {
+		return s.cron.Next(lastRun.Local())
+	}
+	return lastRun.Add(s.every)
+}
+
+// IsDue reports whether the step should run at now. Steps that never ran are always due.
+func (s *StepSchedule) IsDue(lastRun, now time.Time) bool {
+	if lastRun.IsZero() {
+		return true
+	}
+	next := s.NextRun(lastRun)
+	return !next.IsZero() && !now.Before(next)
+}
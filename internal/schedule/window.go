@@ -0,0 +1,114 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"autoteam/internal/worker"
+)
+
+// Window is a daily time range on selected weekdays
+type Window struct {
+	days  uint8 // Weekday bit set; 0 means every day
+	start int   // Minutes after midnight, inclusive
+	end   int   // Minutes after midnight, exclusive
+}
+
+// ParseWindow converts a configured window into a Window
+func ParseWindow(cfg worker.ScheduleWindow) (Window, error) {
+	var w Window
+	var err error
+
+	if w.start, err = parseClock(cfg.Start); err != nil {
+		return Window{}, fmt.Errorf("invalid window start: %w", err)
+	}
+	if w.end, err = parseClock(cfg.End); err != nil {
+		return Window{}, fmt.Errorf("invalid window end: %w", err)
+	}
+
+	for _, day := range cfg.Days {
+		bits, err := parseDays(day)
+		if err != nil {
+			return Window{}, err
+		}
+		w.days |= bits
+	}
+
+	return w, nil
+}
+
+// parseClock parses an HH:MM time of day into minutes after midnight
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a HH:MM time", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// parseDays parses a day name or a range of day names (e.g. mon-fri) into a weekday bit set
+func parseDays(value string) (uint8, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	from, to, isRange := strings.Cut(value, "-")
+	start, ok := weekdayNames[from]
+	if !ok {
+		return 0, fmt.Errorf("invalid day %q", from)
+	}
+	end := start
+	if isRange {
+		if end, ok = weekdayNames[to]; !ok {
+			return 0, fmt.Errorf("invalid day %q", to)
+		}
+	}
+
+	// Ranges may wrap around the week (e.g. fri-mon)
+	var bits uint8
+	for d := start; ; d = (d + 1) % 7 {
+		bits |= 1 << uint(d)
+		if d == end {
+			break
+		}
+	}
+	return bits, nil
+}
+
+// onDay reports whether the window applies to the given weekday
+func (w Window) onDay(day time.Weekday) bool {
+	return w.days == 0 || w.days&(1<<uint(day)) != 0
+}
+
+// Contains reports whether t falls inside the window, in t's location
+func (w Window) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+
+	switch {
+	case w.start == w.end:
+		// Whole day
+		return w.onDay(t.Weekday())
+	case w.start < w.end:
+		return w.onDay(t.Weekday()) && minute >= w.start && minute < w.end
+	default:
+		// Spans midnight: the part after midnight belongs to the previous day's window
+		if minute >= w.start {
+			return w.onDay(t.Weekday())
+		}
+		return minute < w.end && w.onDay(t.AddDate(0, 0, -1).Weekday())
+	}
+}
+
+// nextStart returns the first window start at or after t, in t's location
+func (w Window) nextStart(t time.Time) time.Time {
+	loc := t.Location()
+	for offset := 0; offset <= 7; offset++ {
+		start := time.Date(t.Year(), t.Month(), t.Day()+offset, w.start/60, w.start%60, 0, 0, loc)
+		if !w.onDay(start.Weekday()) {
+			continue
+		}
+		if !start.Before(t) {
+			return start
+		}
+	}
+	return time.Time{}
+}
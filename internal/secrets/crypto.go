@@ -0,0 +1,135 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+)
+
+// Environment variables holding the key of encrypted secret files
+const (
+	KeyEnv     = "AUTOTEAM_SECRETS_KEY"      // The key itself
+	KeyFileEnv = "AUTOTEAM_SECRETS_KEY_FILE" // Path of a key file
+)
+
+// ErrNoKey is returned when an encrypted secret is resolved without a key
+var ErrNoKey = errors.New("no key for encrypted secrets (set key_file, " + KeyFileEnv + " or " + KeyEnv + ")")
+
+// GenerateKey returns a new age X25519 identity in the format of age-keygen, so key files
+// can be used with the age CLI as well
+func GenerateKey() (string, error) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	return fmt.Sprintf("# created: %s\n# public key: %s\n%s\n",
+		time.Now().UTC().Format(time.RFC3339), identity.Recipient(), identity), nil
+}
+
+// LoadKey reads the key of encrypted secrets from keyFile, or from the environment if
+// keyFile is empty. Relative key file paths are resolved against baseDir.
+func LoadKey(keyFile, baseDir string) (string, error) {
+	if keyFile == "" {
+		keyFile = os.Getenv(KeyFileEnv)
+	}
+	if keyFile == "" {
+		if key := os.Getenv(KeyEnv); key != "" {
+			return key, nil
+		}
+		return "", ErrNoKey
+	}
+
+	data, err := os.ReadFile(resolvePath(keyFile, baseDir))
+	if err != nil {
+		return "", fmt.Errorf("failed to read key file: %w", err)
+	}
+	return string(data), nil
+}
+
+// parseKey parses the age X25519 identities of a key, ignoring comment lines starting with #
+func parseKey(key string) ([]*age.X25519Identity, error) {
+	parsed, err := age.ParseIdentities(strings.NewReader(key))
+	if err != nil {
+		return nil, fmt.Errorf("invalid key (expected an age identity as created by age-keygen): %w", err)
+	}
+
+	identities := make([]*age.X25519Identity, 0, len(parsed))
+	for _, identity := range parsed {
+		if x25519, ok := identity.(*age.X25519Identity); ok {
+			identities = append(identities, x25519)
+		}
+	}
+	if len(identities) == 0 {
+		return nil, fmt.Errorf("invalid key (expected an age X25519 identity)")
+	}
+	return identities, nil
+}
+
+// Encrypt encrypts a secret to the age identities of a key. The result is an armored age
+// file that can be committed and is decrypted by the encrypted_file provider or with
+// age --decrypt.
+func Encrypt(key string, plaintext []byte) ([]byte, error) {
+	identities, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+	recipients := make([]age.Recipient, 0, len(identities))
+	for _, identity := range identities {
+		recipients = append(recipients, identity.Recipient())
+	}
+
+	var buf bytes.Buffer
+	armored := armor.NewWriter(&buf)
+	writer, err := age.Encrypt(armored, recipients...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(plaintext); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	if err := armored.Close(); err != nil {
+		return nil, err
+	}
+	return append(buf.Bytes(), '\n'), nil
+}
+
+// Decrypt decrypts an age file, armored or binary, such as the files created by Encrypt
+func Decrypt(key string, data []byte) ([]byte, error) {
+	identities, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+	ageIdentities := make([]age.Identity, 0, len(identities))
+	for _, identity := range identities {
+		ageIdentities = append(ageIdentities, identity)
+	}
+
+	var src io.Reader = bytes.NewReader(data)
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(armor.Header)) {
+		src = armor.NewReader(bytes.NewReader(bytes.TrimSpace(data)))
+	}
+
+	reader, err := age.Decrypt(src, ageIdentities...)
+	var noMatch *age.NoIdentityMatchError
+	if errors.As(err, &noMatch) {
+		return nil, fmt.Errorf("wrong key")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("not an age encrypted file: %w", err)
+	}
+	plaintext, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("corrupted file: %w", err)
+	}
+	return plaintext, nil
+}
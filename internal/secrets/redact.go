@@ -0,0 +1,134 @@
+package secrets
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// minRedactLength is the length below which secret values are not redacted, since
+// replacing every occurrence of very short values would make logs unreadable
+const minRedactLength = 4
+
+// Redactor replaces known secret values in text
+type Redactor struct {
+	mu       sync.RWMutex
+	values   map[string]string // Secret names by value
+	redacter *strings.Replacer // Replaces values with [REDACTED:name]
+	envRefs  *strings.Replacer // Replaces values with ${AUTOTEAM_SECRET_NAME}
+}
+
+// defaultRedactor holds the secrets resolved by this process
+var defaultRedactor = &Redactor{}
+
+// Add registers the value of a secret
+func (r *Redactor) Add(name, value string) {
+	if len(value) < minRedactLength {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.values == nil {
+		r.values = make(map[string]string)
+	}
+	r.values[value] = name
+
+	// Longer values first so a secret containing another one is replaced as a whole
+	values := slices.Collect(maps.Keys(r.values))
+	slices.SortFunc(values, func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), strings.Compare(a, b))
+	})
+
+	redactions := make([]string, 0, 2*len(values))
+	references := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		redactions = append(redactions, value, "[REDACTED:"+r.values[value]+"]")
+		references = append(references, value, "${"+EnvName(r.values[value])+"}")
+	}
+	r.redacter = strings.NewReplacer(redactions...)
+	r.envRefs = strings.NewReplacer(references...)
+}
+
+// Redact replaces every registered secret value in s with [REDACTED:name]
+func (r *Redactor) Redact(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.redacter == nil {
+		return s
+	}
+	return r.redacter.Replace(s)
+}
+
+// EnvReference replaces every registered secret value in s with a reference to the
+// environment variable holding it, e.g. ${AUTOTEAM_SECRET_GITHUB_TOKEN}
+func (r *Redactor) EnvReference(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.envRefs == nil {
+		return s
+	}
+	return r.envRefs.Replace(s)
+}
+
+// Environ returns the registered secrets as NAME=value entries of their environment
+// variables, sorted by name
+func (r *Redactor) Environ() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	env := make([]string, 0, len(r.values))
+	for value, name := range r.values {
+		env = append(env, EnvName(name)+"="+value)
+	}
+	slices.Sort(env)
+	return env
+}
+
+// Empty reports whether no secret is registered
+func (r *Redactor) Empty() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.values) == 0
+}
+
+// Register registers the value of a secret for redaction in this process
+func Register(name, value string) {
+	defaultRedactor.Add(name, value)
+}
+
+// Redact replaces every secret value registered in this process with [REDACTED:name]
+func Redact(s string) string {
+	return defaultRedactor.Redact(s)
+}
+
+// Registered reports whether any secret is registered in this process
+func Registered() bool {
+	return !defaultRedactor.Empty()
+}
+
+// EnvReference replaces every secret value registered in this process with a reference
+// to the environment variable returned by Environ
+func EnvReference(s string) string {
+	return defaultRedactor.EnvReference(s)
+}
+
+// EnvReferences applies EnvReference to the values of an environment map, e.g. the env of
+// an MCP server written to an agent configuration file
+func EnvReferences(env map[string]string) map[string]string {
+	if env == nil {
+		return nil
+	}
+	result := make(map[string]string, len(env))
+	for key, value := range env {
+		result[key] = EnvReference(value)
+	}
+	return result
+}
+
+// Environ returns the secrets registered in this process as environment entries for
+// agent processes, which resolve the references of EnvReference
+func Environ() []string {
+	return defaultRedactor.Environ()
+}
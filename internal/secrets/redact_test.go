@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRedactor(t *testing.T) {
+	var r Redactor
+	if got := r.Redact("nothing registered"); got != "nothing registered" {
+		t.Errorf("Redact() = %q without secrets", got)
+	}
+
+	r.Add("github_token", "ghp_abc123")
+	r.Add("github_app", "ghp_abc123-app")
+	r.Add("pin", "123") // Too short to redact
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"single", "token ghp_abc123 used", "token [REDACTED:github_token] used"},
+		{"repeated", "ghp_abc123:ghp_abc123", "[REDACTED:github_token]:[REDACTED:github_token]"},
+		{"longer secret wins", "key=ghp_abc123-app", "key=[REDACTED:github_app]"},
+		{"short values kept", "pin 123", "pin 123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Redact(tt.text); got != tt.want {
+				t.Errorf("Redact(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	if got := r.EnvReference("Bearer ghp_abc123"); got != "Bearer ${AUTOTEAM_SECRET_GITHUB_TOKEN}" {
+		t.Errorf("EnvReference() = %q", got)
+	}
+
+	want := []string{"AUTOTEAM_SECRET_GITHUB_APP=ghp_abc123-app", "AUTOTEAM_SECRET_GITHUB_TOKEN=ghp_abc123"}
+	if got := r.Environ(); !slices.Equal(got, want) {
+		t.Errorf("Environ() = %v, want %v", got, want)
+	}
+}
+
+func TestEnvReferences(t *testing.T) {
+	Register("env_references_test", "env-references-secret")
+
+	got := EnvReferences(map[string]string{
+		"TOKEN": "env-references-secret",
+		"URL":   "https://api.example.com",
+	})
+	if got["TOKEN"] != "${AUTOTEAM_SECRET_ENV_REFERENCES_TEST}" || got["URL"] != "https://api.example.com" {
+		t.Errorf("EnvReferences() = %v", got)
+	}
+	if !slices.Contains(Environ(), "AUTOTEAM_SECRET_ENV_REFERENCES_TEST=env-references-secret") {
+		t.Errorf("Environ() = %v, missing the registered secret", Environ())
+	}
+	if EnvReferences(nil) != nil {
+		t.Errorf("EnvReferences(nil) != nil")
+	}
+}
@@ -0,0 +1,264 @@
+package secrets
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Secret providers
+const (
+	ProviderEnv           = "env"
+	ProviderFile          = "file"
+	ProviderEncryptedFile = "encrypted_file"
+)
+
+// Providers lists the supported secret providers
+var Providers = []string{ProviderEnv, ProviderFile, ProviderEncryptedFile}
+
+// namePattern matches valid secret names
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Secret is the definition of a secret in the secrets section of autoteam.yaml
+type Secret struct {
+	Provider string `yaml:"provider"`           // env, file or encrypted_file
+	Env      string `yaml:"env,omitempty"`      // Environment variable of the env provider (defaults to the secret name)
+	Path     string `yaml:"path,omitempty"`     // File of the file and encrypted_file providers
+	KeyFile  string `yaml:"key_file,omitempty"` // Key file of the encrypted_file provider (defaults to $AUTOTEAM_SECRETS_KEY_FILE or $AUTOTEAM_SECRETS_KEY)
+}
+
+// ValidateName checks that a secret name can be referenced as ${secret:NAME}
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q (expected letters, digits and underscores)", name)
+	}
+	return nil
+}
+
+// Validate checks the provider settings of a secret
+func (s Secret) Validate() error {
+	switch s.Provider {
+	case ProviderEnv:
+		if s.Path != "" || s.KeyFile != "" {
+			return fmt.Errorf("path and key_file are not supported by the env provider")
+		}
+	case ProviderFile:
+		if s.Path == "" {
+			return fmt.Errorf("path is required for the file provider")
+		}
+		if s.KeyFile != "" {
+			return fmt.Errorf("key_file is only supported by the encrypted_file provider")
+		}
+	case ProviderEncryptedFile:
+		if s.Path == "" {
+			return fmt.Errorf("path is required for the encrypted_file provider")
+		}
+	case "":
+		return fmt.Errorf("provider is required (expected %s)", strings.Join(Providers, ", "))
+	default:
+		return fmt.Errorf("unknown provider %q (expected %s)", s.Provider, strings.Join(Providers, ", "))
+	}
+	if s.Env != "" && s.Provider != ProviderEnv {
+		return fmt.Errorf("env is only supported by the env provider")
+	}
+	return nil
+}
+
+// Resolve returns the value of a secret. Relative paths are resolved against baseDir and
+// trailing newlines of files are removed.
+func (s Secret) Resolve(name, baseDir string) (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+
+	switch s.Provider {
+	case ProviderEnv:
+		env := cmp.Or(s.Env, name)
+		value, ok := os.LookupEnv(env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", env)
+		}
+		return value, nil
+	case ProviderFile:
+		data, err := os.ReadFile(resolvePath(s.Path, baseDir))
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret file: %w", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	default:
+		key, err := LoadKey(s.KeyFile, baseDir)
+		if err != nil {
+			return "", err
+		}
+		data, err := os.ReadFile(resolvePath(s.Path, baseDir))
+		if err != nil {
+			return "", fmt.Errorf("failed to read encrypted secret file: %w", err)
+		}
+		plaintext, err := Decrypt(key, data)
+		if err != nil {
+			return "", fmt.Errorf("failed to decrypt %s: %w", s.Path, err)
+		}
+		return strings.TrimRight(string(plaintext), "\r\n"), nil
+	}
+}
+
+// resolvePath returns path relative to baseDir unless it is absolute
+func resolvePath(path, baseDir string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
+
+// EnvName returns the environment variable a secret is passed to agent processes in,
+// e.g. AUTOTEAM_SECRET_GITHUB_TOKEN
+func EnvName(name string) string {
+	return envPrefix + strings.ToUpper(name)
+}
+
+// EnvFile returns the name of the env file holding the secrets of a worker service. It is
+// passed to the container as env_file and kept out of the worker directory, which is
+// mounted into the container.
+func EnvFile(serviceName string) string {
+	return serviceName + ".env"
+}
+
+// envPrefix is the prefix of the environment variables returned by EnvName
+const envPrefix = "AUTOTEAM_SECRET_"
+
+// referencePattern matches references to the environment variables returned by EnvName
+var referencePattern = regexp.MustCompile(`\$\{(` + envPrefix + `[A-Za-z0-9_]+)\}`)
+
+// envFileEscaper escapes values for double-quoted env_file entries, which Docker Compose
+// would otherwise interpolate
+var envFileEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "\n", `\n`, "\r", `\r`)
+
+// WriteEnvFile writes secret values to a Docker Compose env_file as the environment
+// variables returned by EnvName. The file is only readable by its owner.
+func WriteEnvFile(path string, values map[string]string) error {
+	names := slices.Sorted(maps.Keys(values))
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s=\"%s\"\n", EnvName(name), envFileEscaper.Replace(values[name]))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create secrets directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write secrets file %s: %w", path, err)
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := os.Chmod(path, 0600); err != nil {
+		return fmt.Errorf("failed to write secrets file %s: %w", path, err)
+	}
+	return nil
+}
+
+// RegisterEnviron registers the secrets passed to this process as environment variables
+// returned by EnvName for redaction
+func RegisterEnviron() {
+	for _, entry := range os.Environ() {
+		key, value, _ := strings.Cut(entry, "=")
+		if name, ok := strings.CutPrefix(key, envPrefix); ok && name != "" {
+			Register(strings.ToLower(name), value)
+		}
+	}
+}
+
+// ReferenceTag marks the scalars of a worker config that hold references to secrets
+const ReferenceTag = "!secret"
+
+// Reference is a value of a YAML document that was resolved from ${secret:NAME} references
+type Reference struct {
+	Path      []string // Mapping keys and sequence indexes leading to the value
+	Value     string   // The resolved value
+	Reference string   // The value with references to the environment variables returned by EnvName in place of the secrets
+	Secrets   []string // Names of the secrets in the value
+}
+
+// ReferenceNode replaces the resolved values at the paths of references in a YAML node
+// with their reference form and tags them with ReferenceTag. Values that no longer hold
+// the resolved value, e.g. settings overridden by a worker, are left alone. It returns
+// the names of the secrets referenced, sorted.
+func ReferenceNode(node *yaml.Node, references []Reference) []string {
+	var names []string
+	for _, reference := range references {
+		target := nodeAt(node, reference.Path)
+		if target == nil || target.Kind != yaml.ScalarNode || !strings.Contains(target.Value, reference.Value) {
+			continue
+		}
+		target.Value = strings.Replace(target.Value, reference.Value, reference.Reference, 1)
+		target.Tag = ReferenceTag
+		names = append(names, reference.Secrets...)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// ExpandNode replaces the references in the scalars tagged by ReferenceNode with the
+// values of the environment variables they reference. Other scalars are left untouched.
+func ExpandNode(node *yaml.Node) error {
+	if node == nil {
+		return nil
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == ReferenceTag {
+		var err error
+		node.Value = referencePattern.ReplaceAllStringFunc(node.Value, func(reference string) string {
+			env := referencePattern.FindStringSubmatch(reference)[1]
+			value, ok := os.LookupEnv(env)
+			if !ok && err == nil {
+				err = fmt.Errorf("environment variable %s of secret reference at line %d is not set", env, node.Line)
+			}
+			return value
+		})
+		node.Tag = "!!str"
+		return err
+	}
+	for _, child := range node.Content {
+		if err := ExpandNode(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeAt returns the node at a path of mapping keys and sequence indexes, or nil
+func nodeAt(node *yaml.Node, path []string) *yaml.Node {
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	for _, key := range path {
+		switch node.Kind {
+		case yaml.MappingNode:
+			var value *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == key {
+					value = node.Content[i+1]
+					break
+				}
+			}
+			if value == nil {
+				return nil
+			}
+			node = value
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node.Content) {
+				return nil
+			}
+			node = node.Content[index]
+		default:
+			return nil
+		}
+	}
+	return node
+}
@@ -0,0 +1,241 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"autoteam/internal/testutil"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestSecretValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  Secret
+		wantErr string
+	}{
+		{"env", Secret{Provider: ProviderEnv, Env: "GITHUB_TOKEN"}, ""},
+		{"env defaults to name", Secret{Provider: ProviderEnv}, ""},
+		{"file", Secret{Provider: ProviderFile, Path: "token.txt"}, ""},
+		{"encrypted file", Secret{Provider: ProviderEncryptedFile, Path: "token.enc", KeyFile: "key.txt"}, ""},
+		{"missing provider", Secret{}, "provider is required"},
+		{"unknown provider", Secret{Provider: "vault"}, `unknown provider "vault"`},
+		{"file without path", Secret{Provider: ProviderFile}, "path is required for the file provider"},
+		{"encrypted file without path", Secret{Provider: ProviderEncryptedFile}, "path is required for the encrypted_file provider"},
+		{"env with path", Secret{Provider: ProviderEnv, Path: "token.txt"}, "not supported by the env provider"},
+		{"file with key", Secret{Provider: ProviderFile, Path: "token.txt", KeyFile: "key.txt"}, "key_file is only supported"},
+		{"file with env", Secret{Provider: ProviderFile, Path: "token.txt", Env: "TOKEN"}, "env is only supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.secret.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSecretResolve(t *testing.T) {
+	dir := testutil.CreateTempDir(t)
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	encrypted, err := Encrypt(key, []byte("encrypted-token\n"))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	testutil.CreateTempFile(t, dir, "token.txt", "file-token\n")
+	testutil.CreateTempFile(t, dir, "token.enc", string(encrypted))
+	testutil.CreateTempFile(t, dir, "key.txt", key)
+
+	t.Setenv("SECRETS_TEST_TOKEN", "env-token")
+	t.Setenv("github_token", "named-token")
+
+	tests := []struct {
+		name   string
+		secret Secret
+		want   string
+	}{
+		{"env", Secret{Provider: ProviderEnv, Env: "SECRETS_TEST_TOKEN"}, "env-token"},
+		{"env defaults to name", Secret{Provider: ProviderEnv}, "named-token"},
+		{"file", Secret{Provider: ProviderFile, Path: "token.txt"}, "file-token"},
+		{"absolute file", Secret{Provider: ProviderFile, Path: filepath.Join(dir, "token.txt")}, "file-token"},
+		{"encrypted file", Secret{Provider: ProviderEncryptedFile, Path: "token.enc", KeyFile: "key.txt"}, "encrypted-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.secret.Resolve("github_token", dir)
+			if err != nil {
+				t.Fatalf("Resolve() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("key from environment", func(t *testing.T) {
+		t.Setenv(KeyFileEnv, "")
+		t.Setenv(KeyEnv, key)
+		got, err := Secret{Provider: ProviderEncryptedFile, Path: "token.enc"}.Resolve("github_token", dir)
+		if err != nil || got != "encrypted-token" {
+			t.Errorf("Resolve() = %q, %v, want encrypted-token", got, err)
+		}
+	})
+
+	t.Run("missing env", func(t *testing.T) {
+		_, err := Secret{Provider: ProviderEnv, Env: "SECRETS_TEST_UNSET"}.Resolve("github_token", dir)
+		if err == nil || !strings.Contains(err.Error(), "environment variable SECRETS_TEST_UNSET is not set") {
+			t.Errorf("Resolve() error = %v", err)
+		}
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		t.Setenv(KeyFileEnv, "")
+		t.Setenv(KeyEnv, "")
+		_, err := Secret{Provider: ProviderEncryptedFile, Path: "token.enc"}.Resolve("github_token", dir)
+		if err != ErrNoKey {
+			t.Errorf("Resolve() error = %v, want %v", err, ErrNoKey)
+		}
+	})
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, _ := GenerateKey()
+	otherKey, _ := GenerateKey()
+	if !strings.Contains(key, "AGE-SECRET-KEY-") {
+		t.Errorf("GenerateKey() = %q, want an age identity", key)
+	}
+
+	encrypted, err := Encrypt(key, []byte("s3cr3t"))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if strings.Contains(string(encrypted), "s3cr3t") {
+		t.Errorf("Encrypt() output contains the plaintext")
+	}
+
+	decrypted, err := Decrypt(key, encrypted)
+	if err != nil || string(decrypted) != "s3cr3t" {
+		t.Errorf("Decrypt() = %q, %v, want s3cr3t", decrypted, err)
+	}
+	if _, err := Decrypt(otherKey, encrypted); err == nil {
+		t.Errorf("Decrypt() with another key succeeded")
+	}
+	if _, err := Decrypt(key, []byte("s3cr3t\n")); err == nil {
+		t.Errorf("Decrypt() of a plain file succeeded")
+	}
+	if _, err := Encrypt("not a key", []byte("s3cr3t")); err == nil {
+		t.Errorf("Encrypt() with an invalid key succeeded")
+	}
+}
+
+func TestWriteEnvFile(t *testing.T) {
+	path := filepath.Join(testutil.CreateTempDir(t), "secrets", EnvFile("dev1"))
+	values := map[string]string{"github_token": "ghp_token", "quoted": "a \"b\" $HOME\\n\nline"}
+	if err := WriteEnvFile(path, values); err != nil {
+		t.Fatalf("WriteEnvFile() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("WriteEnvFile() mode = %v, want 0600", info.Mode().Perm())
+	}
+	want := `AUTOTEAM_SECRET_GITHUB_TOKEN="ghp_token"
+AUTOTEAM_SECRET_QUOTED="a \"b\" \$HOME\\n\nline"
+`
+	if got := testutil.ReadFile(t, path); got != want {
+		t.Errorf("WriteEnvFile() wrote %q, want %q", got, want)
+	}
+}
+
+func TestReferenceExpandNode(t *testing.T) {
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(`name: main
+env:
+  HEADER: "Bearer main"
+  BRANCH: main
+  OTHER: main
+steps:
+  - input: 'echo "${AUTOTEAM_SECRET_BRANCH}" main'
+`), &node); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	references := []Reference{
+		{Path: []string{"env", "HEADER"}, Value: "Bearer main", Reference: "Bearer ${AUTOTEAM_SECRET_BRANCH}", Secrets: []string{"branch"}},
+		{Path: []string{"env", "BRANCH"}, Value: "main", Reference: "${AUTOTEAM_SECRET_BRANCH}", Secrets: []string{"branch"}},
+		{Path: []string{"env", "MISSING"}, Value: "main", Reference: "${AUTOTEAM_SECRET_UNUSED}", Secrets: []string{"unused"}},
+		{Path: []string{"steps", "3"}, Value: "main", Reference: "${AUTOTEAM_SECRET_UNUSED}", Secrets: []string{"unused"}},
+	}
+	if got := ReferenceNode(&node, references); !slices.Equal(got, []string{"branch"}) {
+		t.Errorf("ReferenceNode() = %v, want [branch]", got)
+	}
+	data, err := yaml.Marshal(&node)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	// Only the referenced paths are rewritten, other values equal to the secret are kept
+	want := `name: main
+env:
+    HEADER: !secret "Bearer ${AUTOTEAM_SECRET_BRANCH}"
+    BRANCH: !secret ${AUTOTEAM_SECRET_BRANCH}
+    OTHER: main
+steps:
+    - input: 'echo "${AUTOTEAM_SECRET_BRANCH}" main'
+`
+	if string(data) != want {
+		t.Errorf("ReferenceNode() result:\n%s\nwant:\n%s", data, want)
+	}
+
+	// The worker expands the tagged references from its environment
+	if err := ExpandNode(&node); err == nil || !strings.Contains(err.Error(), "AUTOTEAM_SECRET_BRANCH") {
+		t.Errorf("ExpandNode() without environment error = %v", err)
+	}
+	t.Setenv("AUTOTEAM_SECRET_BRANCH", "main")
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if err := ExpandNode(&node); err != nil {
+		t.Fatalf("ExpandNode() error = %v", err)
+	}
+	var config struct {
+		Env   map[string]string   `yaml:"env"`
+		Steps []map[string]string `yaml:"steps"`
+	}
+	if err := node.Decode(&config); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if config.Env["HEADER"] != "Bearer main" || config.Env["BRANCH"] != "main" || config.Env["OTHER"] != "main" {
+		t.Errorf("ExpandNode() env = %v", config.Env)
+	}
+	if got := config.Steps[0]["input"]; got != `echo "${AUTOTEAM_SECRET_BRANCH}" main` {
+		t.Errorf("ExpandNode() expanded an untagged value: %q", got)
+	}
+}
+
+func TestRegisterEnviron(t *testing.T) {
+	t.Setenv("AUTOTEAM_SECRET_ENVIRON_TEST", "environ-test-secret")
+	RegisterEnviron()
+	if got := Redact("token=environ-test-secret"); got != "token=[REDACTED:environ_test]" {
+		t.Errorf("Redact() = %q after RegisterEnviron", got)
+	}
+}
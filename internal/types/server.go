@@ -31,6 +31,8 @@ type StatusResponse struct {
 	Timestamp time.Time  `json:"timestamp"`
 	Agent     WorkerInfo `json:"agent"`
 	Uptime    string     `json:"uptime,omitempty"`
+	// NextRunTime is when the next scheduled flow cycle starts (unset while paused or running)
+	NextRunTime *time.Time `json:"next_run_time,omitempty"`
 }
 
 // LogsResponse represents list of log files
@@ -46,6 +48,89 @@ type MetricsResponse struct {
 	Timestamp time.Time     `json:"timestamp"`
 }
 
+// ControlResponse represents the result of a worker control action
+type ControlResponse struct {
+	Accepted  bool      `json:"accepted"`
+	Message   string    `json:"message"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// StepEnabledRequest enables or disables a flow step at runtime
+type StepEnabledRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// StepEnabledResponse represents the runtime state of a flow step after a toggle
+type StepEnabledResponse struct {
+	StepName  string    `json:"step_name"`
+	Enabled   bool      `json:"enabled"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// RunsResponse represents a page of flow run history, newest first
+type RunsResponse struct {
+	Runs      []RunSummary `json:"runs"`
+	Total     int          `json:"total"`
+	Timestamp time.Time    `json:"timestamp"`
+}
+
+// RunSummary describes a flow run without step details
+type RunSummary struct {
+	ID        string    `json:"id"`
+	Start     time.Time `json:"start"`
+	End       time.Time `json:"end"`
+	Duration  string    `json:"duration"`
+	Status    string    `json:"status"`
+	Error     *string   `json:"error,omitempty"`
+	StepCount int       `json:"step_count"`
+}
+
+// RunResponse represents a single flow run with step details
+type RunResponse struct {
+	Run       RunRecord `json:"run"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// RunRecord is the full record of a flow run
+type RunRecord struct {
+	ID       string       `json:"id"`
+	Start    time.Time    `json:"start"`
+	End      time.Time    `json:"end"`
+	Duration string       `json:"duration"`
+	Status   string       `json:"status"`
+	Error    *string      `json:"error,omitempty"`
+	Steps    []StepRecord `json:"steps"`
+}
+
+// StepRecord is the record of a single step within a flow run
+type StepRecord struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Prompt     *string `json:"prompt,omitempty"`
+	Stdout     *string `json:"stdout,omitempty"`
+	Stderr     *string `json:"stderr,omitempty"`
+	Attempts   int     `json:"attempts"`
+	SkipReason *string `json:"skip_reason,omitempty"`
+	Duration   string  `json:"duration"`
+
+	Iterations []IterationRecord `json:"iterations,omitempty"`
+}
+
+// IterationRecord is the record of a single iteration of a loop step
+type IterationRecord struct {
+	Iteration int     `json:"iteration"`
+	Prompt    *string `json:"prompt,omitempty"`
+	Stdout    *string `json:"stdout,omitempty"`
+	Stderr    *string `json:"stderr,omitempty"`
+	ExitCode  *int    `json:"exit_code,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	Attempts  int     `json:"attempts"`
+	Duration  string  `json:"duration"`
+
+	Steps []StepRecord `json:"steps,omitempty"`
+}
+
 // ConfigResponse represents sanitized agent configuration
 type ConfigResponse struct {
 	Config    WorkerConfig `json:"config"`
@@ -70,9 +155,33 @@ type LogFile struct {
 
 // WorkerMetrics represents worker performance metrics
 type WorkerMetrics struct {
-	Uptime           *string    `json:"uptime,omitempty"`
-	AvgExecutionTime *string    `json:"avg_execution_time,omitempty"`
-	LastActivity     *time.Time `json:"last_activity,omitempty"`
+	Uptime                  *string       `json:"uptime,omitempty"`
+	AvgExecutionTime        *string       `json:"avg_execution_time,omitempty"`
+	LastActivity            *time.Time    `json:"last_activity,omitempty"`
+	FlowExecutionCount      int           `json:"flow_execution_count"`
+	FlowSuccessCount        int           `json:"flow_success_count"`
+	LastExecutionTime       *string       `json:"last_execution_time,omitempty"`
+	ActiveSteps             []string      `json:"active_steps,omitempty"`
+	Steps                   []StepMetrics `json:"steps,omitempty"`
+	RecentExecutionTimes    []string      `json:"recent_execution_times,omitempty"`     // Durations of the most recent flow executions, oldest first
+	RollingAvgExecutionTime *string       `json:"rolling_avg_execution_time,omitempty"` // Average of RecentExecutionTimes
+}
+
+// StepMetrics represents execution metrics for a single flow step
+type StepMetrics struct {
+	Name               string     `json:"name"`
+	Active             bool       `json:"active"`
+	ExecutionCount     int        `json:"execution_count"`
+	SuccessCount       int        `json:"success_count"`
+	FailureCount       int        `json:"failure_count"`
+	RetryCount         int        `json:"retry_count"`
+	TimeoutCount       int        `json:"timeout_count"`
+	IterationCount     int        `json:"iteration_count"`
+	LastDuration       *string    `json:"last_duration,omitempty"`
+	AvgDuration        *string    `json:"avg_duration,omitempty"`
+	RecentDurations    []string   `json:"recent_durations,omitempty"`     // Durations of the most recent executions, oldest first
+	RollingAvgDuration *string    `json:"rolling_avg_duration,omitempty"` // Average of RecentDurations
+	LastExecution      *time.Time `json:"last_execution,omitempty"`
 }
 
 // WorkerConfig represents sanitized worker configuration
@@ -142,6 +251,7 @@ const (
 	WorkerStatusIdle    = "idle"
 	WorkerStatusRunning = "running"
 	WorkerStatusError   = "error"
+	WorkerStatusPaused  = "paused"
 )
 
 // Worker mode constants
@@ -5,6 +5,7 @@ import (
 	"maps"
 	"os"
 
+	"autoteam/internal/secrets"
 	"autoteam/internal/util"
 
 	"gopkg.in/yaml.v3"
@@ -17,8 +18,18 @@ func LoadWorkerFromFile(configPath string) (*Worker, error) {
 		return nil, fmt.Errorf("failed to read config file %s: %w", configPath, err)
 	}
 
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", configPath, err)
+	}
+
+	// The generator replaces secret values with references to the container environment
+	if err := secrets.ExpandNode(&node); err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets of config file %s: %w", configPath, err)
+	}
+
 	var worker Worker
-	if err := yaml.Unmarshal(data, &worker); err != nil {
+	if err := node.Decode(&worker); err != nil {
 		return nil, fmt.Errorf("failed to parse config file %s: %w", configPath, err)
 	}
 
@@ -46,6 +57,9 @@ func (w *Worker) GetEffectiveSettings(globalSettings WorkerSettings) WorkerSetti
 	if w.Settings.SleepDuration != nil {
 		effective.SleepDuration = w.Settings.SleepDuration
 	}
+	if w.Settings.Schedule != nil {
+		effective.Schedule = copyScheduleConfig(w.Settings.Schedule)
+	}
 	if w.Settings.TeamName != nil {
 		effective.TeamName = w.Settings.TeamName
 	}
@@ -58,6 +72,21 @@ func (w *Worker) GetEffectiveSettings(globalSettings WorkerSettings) WorkerSetti
 	if w.Settings.MaxAttempts != nil {
 		effective.MaxAttempts = w.Settings.MaxAttempts
 	}
+	if w.Settings.FlowTimeout != nil {
+		effective.FlowTimeout = w.Settings.FlowTimeout
+	}
+	if w.Settings.MaxParallel != nil {
+		effective.MaxParallel = w.Settings.MaxParallel
+	}
+	if w.Settings.FailFast != nil {
+		effective.FailFast = w.Settings.FailFast
+	}
+	if w.Settings.StrictTemplates != nil {
+		effective.StrictTemplates = w.Settings.StrictTemplates
+	}
+	if w.Settings.History != nil {
+		effective.History = copyHistoryConfig(w.Settings.History)
+	}
 
 	// Merge service configurations
 	if len(w.Settings.Service) > 0 {
@@ -411,6 +440,36 @@ func mergeHookConfigs(global, workerLevel *HookConfig) *HookConfig {
 	return nil
 }
 
+// copyScheduleConfig creates a deep copy of a ScheduleConfig
+func copyScheduleConfig(source *ScheduleConfig) *ScheduleConfig {
+	if source == nil {
+		return nil
+	}
+
+	copied := *source
+	if source.Windows != nil {
+		copied.Windows = make([]ScheduleWindow, len(source.Windows))
+		for i, window := range source.Windows {
+			copied.Windows[i] = window
+			copied.Windows[i].Days = append([]string(nil), window.Days...)
+		}
+	}
+	return &copied
+}
+
+// copyHistoryConfig creates a deep copy of a HistoryConfig
+func copyHistoryConfig(source *HistoryConfig) *HistoryConfig {
+	if source == nil {
+		return nil
+	}
+
+	copied := *source
+	if source.MaxRuns != nil {
+		copied.MaxRuns = util.IntPtr(*source.MaxRuns)
+	}
+	return &copied
+}
+
 // copyWorkerSettings creates a deep copy of a WorkerSettings
 func copyWorkerSettings(source WorkerSettings) WorkerSettings {
 	copied := WorkerSettings{}
@@ -418,6 +477,7 @@ func copyWorkerSettings(source WorkerSettings) WorkerSettings {
 	if source.SleepDuration != nil {
 		copied.SleepDuration = util.IntPtr(*source.SleepDuration)
 	}
+	copied.Schedule = copyScheduleConfig(source.Schedule)
 	if source.TeamName != nil {
 		copied.TeamName = util.StringPtr(*source.TeamName)
 	}
@@ -430,6 +490,19 @@ func copyWorkerSettings(source WorkerSettings) WorkerSettings {
 	if source.MaxAttempts != nil {
 		copied.MaxAttempts = util.IntPtr(*source.MaxAttempts)
 	}
+	if source.FlowTimeout != nil {
+		copied.FlowTimeout = util.StringPtr(*source.FlowTimeout)
+	}
+	if source.MaxParallel != nil {
+		copied.MaxParallel = util.IntPtr(*source.MaxParallel)
+	}
+	if source.FailFast != nil {
+		copied.FailFast = util.BoolPtr(*source.FailFast)
+	}
+	if source.StrictTemplates != nil {
+		copied.StrictTemplates = util.BoolPtr(*source.StrictTemplates)
+	}
+	copied.History = copyHistoryConfig(source.History)
 
 	// Copy service configuration
 	if source.Service != nil {
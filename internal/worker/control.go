@@ -0,0 +1,176 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// maxPendingEvents bounds how many events can wait for a flow cycle
+const maxPendingEvents = 100
+
+// ErrEventQueueFull is returned when too many events are waiting for a flow cycle
+var ErrEventQueueFull = errors.New("event queue is full")
+
+// FlowEvent is an external event (e.g. a webhook delivery) that triggers a flow cycle
+type FlowEvent struct {
+	Source     string          // Where the event came from, for logging
+	Payload    json.RawMessage // JSON payload exposed to templates as .event
+	ReceivedAt time.Time
+}
+
+// flowControl holds operator requests that steer the monitor loop
+type flowControl struct {
+	mu          sync.Mutex
+	paused      bool
+	triggers    chan struct{}      // Pending manual trigger (buffered, at most one)
+	resumed     chan struct{}      // Wakes an idle monitor after resume (buffered, at most one)
+	cycleCancel context.CancelFunc // Cancels the flow cycle currently executing
+	nextRun     *time.Time         // When the monitor plans to start the next cycle
+	events      []FlowEvent        // Events waiting for a flow cycle, oldest first
+}
+
+func newFlowControl() *flowControl {
+	return &flowControl{
+		triggers: make(chan struct{}, 1),
+		resumed:  make(chan struct{}, 1),
+	}
+}
+
+// TriggerFlow requests an immediate flow cycle, skipping the remaining sleep.
+// Multiple triggers before the monitor picks them up collapse into one.
+func (rs *WorkerRuntimeState) TriggerFlow() {
+	select {
+	case rs.control.triggers <- struct{}{}:
+	default:
+	}
+}
+
+// FlowTriggers returns the channel that receives manual flow triggers
+func (rs *WorkerRuntimeState) FlowTriggers() <-chan struct{} {
+	return rs.control.triggers
+}
+
+// TriggerEvent queues an event and requests a flow cycle for it. Each event runs
+// in its own cycle, in the order received.
+func (rs *WorkerRuntimeState) TriggerEvent(event FlowEvent) error {
+	rs.control.mu.Lock()
+	if len(rs.control.events) >= maxPendingEvents {
+		rs.control.mu.Unlock()
+		return ErrEventQueueFull
+	}
+	rs.control.events = append(rs.control.events, event)
+	rs.control.mu.Unlock()
+
+	rs.TriggerFlow()
+	return nil
+}
+
+// NextEvent removes the oldest pending event. When more events are waiting,
+// another flow cycle is requested for them.
+func (rs *WorkerRuntimeState) NextEvent() (FlowEvent, bool) {
+	rs.control.mu.Lock()
+	if len(rs.control.events) == 0 {
+		rs.control.mu.Unlock()
+		return FlowEvent{}, false
+	}
+	event := rs.control.events[0]
+	rs.control.events = rs.control.events[1:]
+	remaining := len(rs.control.events)
+	rs.control.mu.Unlock()
+
+	if remaining > 0 {
+		rs.TriggerFlow()
+	}
+	return event, true
+}
+
+// PauseFlow stops the monitor from starting new cycles. It returns false if already paused.
+func (rs *WorkerRuntimeState) PauseFlow() bool {
+	rs.control.mu.Lock()
+	defer rs.control.mu.Unlock()
+
+	if rs.control.paused {
+		return false
+	}
+	rs.control.paused = true
+	return true
+}
+
+// ResumeFlow lets the monitor start new cycles again. It returns false if not paused.
+func (rs *WorkerRuntimeState) ResumeFlow() bool {
+	rs.control.mu.Lock()
+	defer rs.control.mu.Unlock()
+
+	if !rs.control.paused {
+		return false
+	}
+	rs.control.paused = false
+
+	select {
+	case rs.control.resumed <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// IsPaused reports whether the monitor loop is paused
+func (rs *WorkerRuntimeState) IsPaused() bool {
+	rs.control.mu.Lock()
+	defer rs.control.mu.Unlock()
+
+	return rs.control.paused
+}
+
+// FlowResumed returns the channel that is signalled when the worker is resumed
+func (rs *WorkerRuntimeState) FlowResumed() <-chan struct{} {
+	return rs.control.resumed
+}
+
+// BeginCycle derives a cancellable context for a flow cycle so that it can be
+// stopped through CancelCurrentCycle. The returned function must be called when
+// the cycle ends.
+func (rs *WorkerRuntimeState) BeginCycle(ctx context.Context) (context.Context, context.CancelFunc) {
+	cycleCtx, cancel := context.WithCancel(ctx)
+
+	rs.control.mu.Lock()
+	rs.control.cycleCancel = cancel
+	rs.control.mu.Unlock()
+
+	return cycleCtx, func() {
+		rs.control.mu.Lock()
+		rs.control.cycleCancel = nil
+		rs.control.mu.Unlock()
+		cancel()
+	}
+}
+
+// CancelCurrentCycle cancels the flow cycle in progress. It returns false if no cycle is running.
+func (rs *WorkerRuntimeState) CancelCurrentCycle() bool {
+	rs.control.mu.Lock()
+	defer rs.control.mu.Unlock()
+
+	if rs.control.cycleCancel == nil {
+		return false
+	}
+	rs.control.cycleCancel()
+	return true
+}
+
+// SetNextRunTime records when the monitor plans to start the next cycle (nil when unknown)
+func (rs *WorkerRuntimeState) SetNextRunTime(next *time.Time) {
+	rs.control.mu.Lock()
+	defer rs.control.mu.Unlock()
+
+	rs.control.nextRun = next
+}
+
+// GetNextRunTime returns when the next cycle is planned, or nil if none is scheduled
+func (rs *WorkerRuntimeState) GetNextRunTime() *time.Time {
+	rs.control.mu.Lock()
+	defer rs.control.mu.Unlock()
+
+	return rs.control.nextRun
+}
@@ -2,16 +2,22 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/history"
 	"autoteam/internal/types"
+	"autoteam/internal/worker"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -61,26 +67,16 @@ func (s *Server) GetStatus(ctx context.Context, req *emptypb.Empty) (*workerv1.S
 	// Calculate actual uptime
 	uptime := s.runtime.GetUptime().String()
 
-	// Determine actual worker status
-	workerStatus := types.WorkerStatusIdle
-	if s.runtime.IsRunning() {
-		// Check if any step is currently active
-		stepStats := s.runtime.GetAllStepStats()
-		for _, stats := range stepStats {
-			if stats.Active {
-				workerStatus = types.WorkerStatusRunning
-				break
-			}
-		}
-	}
-
 	response := &workerv1.StatusResponse{
-		Status:    workerStatus,
+		Status:    s.workerStatus(),
 		Mode:      types.WorkerModeBoth,
 		Timestamp: timestamppb.Now(),
 		Agent:     agentInfo,
 		Uptime:    &uptime,
 	}
+	if next := s.runtime.GetNextRunTime(); next != nil {
+		response.NextRunTime = timestamppb.New(*next)
+	}
 
 	return response, nil
 }
@@ -156,12 +152,9 @@ func (s *Server) ListLogs(ctx context.Context, req *workerv1.ListLogsRequest) (*
 
 // GetLogFile implements the get log file RPC
 func (s *Server) GetLogFile(ctx context.Context, req *workerv1.GetLogFileRequest) (*workerv1.LogFileResponse, error) {
-	workingDir := s.runtime.GetWorkingDir()
-	logPath := filepath.Join(workingDir, "logs", req.Filename)
-
-	// Security check: ensure the path is within logs directory
-	if !strings.HasPrefix(filepath.Clean(logPath), filepath.Join(workingDir, "logs")) {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid log file path")
+	logPath, err := s.resolveLogPath(req.Filename)
+	if err != nil {
+		return nil, err
 	}
 
 	// Read file content
@@ -177,11 +170,7 @@ func (s *Server) GetLogFile(ctx context.Context, req *workerv1.GetLogFileRequest
 
 	// Apply tail if specified
 	if req.Tail != nil && *req.Tail > 0 {
-		lines := strings.Split(fileContent, "\n")
-		if len(lines) > int(*req.Tail) {
-			lines = lines[len(lines)-int(*req.Tail):]
-		}
-		fileContent = strings.Join(lines, "\n")
+		fileContent = tailLines(fileContent, int(*req.Tail))
 	}
 
 	response := &workerv1.LogFileResponse{
@@ -191,11 +180,62 @@ func (s *Server) GetLogFile(ctx context.Context, req *workerv1.GetLogFileRequest
 	return response, nil
 }
 
-// StreamLogs implements the stream logs RPC
+// StreamLogs implements the stream logs RPC by following the log file until the client disconnects
 func (s *Server) StreamLogs(req *workerv1.StreamLogsRequest, stream workerv1.WorkerService_StreamLogsServer) error {
-	// For now, return a simple implementation
-	// TODO: Implement actual log streaming
-	return status.Errorf(codes.Unimplemented, "log streaming not yet implemented")
+	logPath, err := s.resolveLogPath(req.Filename)
+	if err != nil {
+		return err
+	}
+
+	follower, err := openLogFollower(logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return status.Errorf(codes.NotFound, "log file not found: %s", req.Filename)
+		}
+		return status.Errorf(codes.Internal, "failed to open log file: %v", err)
+	}
+	defer follower.Close()
+
+	// Signal that the stream is established before waiting for content
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	// Send the last N lines first
+	tail := defaultStreamTailLines
+	if req.Tail != nil {
+		tail = int(*req.Tail)
+	}
+	initial, err := follower.Tail(tail)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read log file: %v", err)
+	}
+	if initial != "" {
+		if err := stream.Send(&workerv1.LogChunk{Content: initial, Timestamp: timestamppb.Now()}); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(logStreamPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+			content, err := follower.Poll()
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to follow log file: %v", err)
+			}
+			if content == "" {
+				continue
+			}
+			if err := stream.Send(&workerv1.LogChunk{Content: content, Timestamp: timestamppb.Now()}); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 // GetFlow implements the get flow RPC
@@ -203,9 +243,16 @@ func (s *Server) GetFlow(ctx context.Context, req *emptypb.Empty) (*workerv1.Flo
 	// Get flow steps from worker settings
 	flowSteps := s.runtime.GetSettings().Flow
 
+	enabledSteps := 0
+	for _, step := range flowSteps {
+		if s.runtime.IsStepEnabled(step.Name) {
+			enabledSteps++
+		}
+	}
+
 	flowInfo := &workerv1.FlowInfo{
 		TotalSteps:   int32(len(flowSteps)),
-		EnabledSteps: int32(len(flowSteps)), // TODO: Count only enabled steps
+		EnabledSteps: int32(enabledSteps),
 	}
 
 	// TODO: Add execution statistics
@@ -262,7 +309,7 @@ func (s *Server) GetFlowSteps(ctx context.Context, req *emptypb.Empty) (*workerv
 		}
 
 		// Add runtime statistics
-		enabled := true
+		enabled := s.runtime.IsStepEnabled(step.Name)
 		stepInfo.Enabled = &enabled
 
 		// Get step statistics from worker runtime
@@ -299,34 +346,108 @@ func (s *Server) GetFlowSteps(ctx context.Context, req *emptypb.Empty) (*workerv
 	return response, nil
 }
 
-// GetMetrics implements the get metrics RPC
-func (s *Server) GetMetrics(ctx context.Context, req *emptypb.Empty) (*workerv1.MetricsResponse, error) {
-	// Create basic metrics
-	metrics := &workerv1.WorkerMetrics{}
+// SetStepEnabled implements the set step enabled RPC
+func (s *Server) SetStepEnabled(ctx context.Context, req *workerv1.SetStepEnabledRequest) (*workerv1.SetStepEnabledResponse, error) {
+	if req.StepName == "" {
+		return nil, status.Error(codes.InvalidArgument, "step name is required")
+	}
 
-	// TODO: Get actual metrics from worker runtime
-	uptime := "1h30m"
-	metrics.Uptime = &uptime
+	if err := s.runtime.SetStepEnabled(req.StepName, req.Enabled); err != nil {
+		if errors.Is(err, worker.ErrStepNotFound) {
+			return nil, status.Errorf(codes.NotFound, "step not found: %s", req.StepName)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update step: %v", err)
+	}
+
+	return &workerv1.SetStepEnabledResponse{
+		StepName:  req.StepName,
+		Enabled:   req.Enabled,
+		Timestamp: timestamppb.Now(),
+	}, nil
+}
+
+// ListRuns implements the list runs RPC
+func (s *Server) ListRuns(ctx context.Context, req *workerv1.ListRunsRequest) (*workerv1.ListRunsResponse, error) {
+	limit := defaultRunsLimit
+	if req.Limit != nil && *req.Limit > 0 {
+		limit = int(*req.Limit)
+	}
+	offset := 0
+	if req.Offset != nil && *req.Offset > 0 {
+		offset = int(*req.Offset)
+	}
 
-	avgExecTime := "2.5s"
-	metrics.AvgExecutionTime = &avgExecTime
+	records, total, err := s.runHistory().List(limit, offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read run history: %v", err)
+	}
 
-	lastActivity := timestamppb.Now()
-	metrics.LastActivity = lastActivity
+	runs := make([]*workerv1.RunSummary, 0, len(records))
+	for _, record := range records {
+		runs = append(runs, toRunSummary(record))
+	}
 
+	response := &workerv1.ListRunsResponse{
+		Runs:      runs,
+		Total:     int32(total),
+		Timestamp: timestamppb.Now(),
+	}
+
+	return response, nil
+}
+
+// GetRun implements the get run RPC
+func (s *Server) GetRun(ctx context.Context, req *workerv1.GetRunRequest) (*workerv1.RunResponse, error) {
+	if req.RunId == "" {
+		return nil, status.Error(codes.InvalidArgument, "run ID is required")
+	}
+
+	record, err := s.runHistory().Get(req.RunId)
+	if err != nil {
+		if errors.Is(err, history.ErrRunNotFound) {
+			return nil, status.Errorf(codes.NotFound, "run not found: %s", req.RunId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to read run history: %v", err)
+	}
+
+	response := &workerv1.RunResponse{
+		Run:       toRunRecord(*record),
+		Timestamp: timestamppb.Now(),
+	}
+
+	return response, nil
+}
+
+// GetMetrics implements the get metrics RPC
+func (s *Server) GetMetrics(ctx context.Context, req *emptypb.Empty) (*workerv1.MetricsResponse, error) {
 	response := &workerv1.MetricsResponse{
-		Metrics:   metrics,
+		Metrics:   s.buildWorkerMetrics(),
 		Timestamp: timestamppb.Now(),
 	}
 
 	return response, nil
 }
 
-// StreamMetrics implements the stream metrics RPC
+// StreamMetrics implements the stream metrics RPC by sending a snapshot every interval
 func (s *Server) StreamMetrics(req *workerv1.StreamMetricsRequest, stream workerv1.WorkerService_StreamMetricsServer) error {
-	// For now, return a simple implementation
-	// TODO: Implement actual metrics streaming
-	return status.Errorf(codes.Unimplemented, "metrics streaming not yet implemented")
+	ticker := time.NewTicker(metricsInterval(req.IntervalSeconds))
+	defer ticker.Stop()
+
+	for {
+		update := &workerv1.MetricsUpdate{
+			Metrics:   s.buildWorkerMetrics(),
+			Timestamp: timestamppb.Now(),
+		}
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
 }
 
 // GetConfig implements the get config RPC
@@ -364,6 +485,85 @@ func (s *Server) GetConfig(ctx context.Context, req *emptypb.Empty) (*workerv1.C
 	return response, nil
 }
 
+// TriggerFlow implements the trigger flow RPC by skipping the monitor's sleep
+func (s *Server) TriggerFlow(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	s.runtime.TriggerFlow()
+	return s.controlResponse(true, "flow cycle triggered"), nil
+}
+
+// TriggerEvent implements the trigger event RPC by queueing the payload for the next flow cycle
+func (s *Server) TriggerEvent(ctx context.Context, req *workerv1.TriggerEventRequest) (*workerv1.ControlResponse, error) {
+	if !json.Valid(req.Payload) {
+		return nil, status.Error(codes.InvalidArgument, "payload must be valid JSON")
+	}
+
+	event := worker.FlowEvent{
+		Source:     req.GetSource(),
+		Payload:    req.Payload,
+		ReceivedAt: time.Now(),
+	}
+	if err := s.runtime.TriggerEvent(event); err != nil {
+		if errors.Is(err, worker.ErrEventQueueFull) {
+			return nil, status.Error(codes.ResourceExhausted, "event queue is full, try again later")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to queue event: %v", err)
+	}
+	return s.controlResponse(true, "flow cycle triggered by event"), nil
+}
+
+// PauseWorker implements the pause worker RPC; the running cycle is allowed to finish
+func (s *Server) PauseWorker(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if !s.runtime.PauseFlow() {
+		return s.controlResponse(false, "worker is already paused"), nil
+	}
+	return s.controlResponse(true, "worker paused"), nil
+}
+
+// ResumeWorker implements the resume worker RPC
+func (s *Server) ResumeWorker(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if !s.runtime.ResumeFlow() {
+		return s.controlResponse(false, "worker is not paused"), nil
+	}
+	return s.controlResponse(true, "worker resumed"), nil
+}
+
+// CancelCurrentCycle implements the cancel current cycle RPC
+func (s *Server) CancelCurrentCycle(ctx context.Context, req *emptypb.Empty) (*workerv1.ControlResponse, error) {
+	if !s.runtime.CancelCurrentCycle() {
+		return s.controlResponse(false, "no flow cycle is running"), nil
+	}
+	return s.controlResponse(true, "flow cycle canceled"), nil
+}
+
+// controlResponse builds a control RPC response with the current worker status
+func (s *Server) controlResponse(accepted bool, message string) *workerv1.ControlResponse {
+	return &workerv1.ControlResponse{
+		Accepted:  accepted,
+		Message:   message,
+		Status:    s.workerStatus(),
+		Timestamp: timestamppb.Now(),
+	}
+}
+
+// workerStatus determines the current worker status from runtime state
+func (s *Server) workerStatus() string {
+	if s.runtime.IsRunning() {
+		// Check if any step is currently active
+		stepStats := s.runtime.SnapshotStepStats()
+		for _, stats := range stepStats {
+			if stats.Active {
+				return types.WorkerStatusRunning
+			}
+		}
+	}
+
+	if s.runtime.IsPaused() {
+		return types.WorkerStatusPaused
+	}
+
+	return types.WorkerStatusIdle
+}
+
 // Helper function to determine log role from filename
 func determineLogRole(filename string) string {
 	filename = strings.ToLower(filename)
@@ -5,16 +5,19 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
 	"autoteam/internal/types"
 	"autoteam/internal/worker"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestServer_GetHealth(t *testing.T) {
-	mockRuntime := createMockWorkerRuntimeForHandlers()
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
 	server := &Server{runtime: mockRuntime}
 
 	ctx := context.Background()
@@ -89,7 +92,7 @@ func TestServer_GetStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRuntime := createMockWorkerRuntimeForHandlers()
+			mockRuntime := createMockWorkerRuntimeForHandlers(t)
 
 			// Set up runtime state
 			mockRuntime.SetRunning(tt.isRunning)
@@ -127,8 +130,32 @@ func TestServer_GetStatus(t *testing.T) {
 	}
 }
 
+func TestServer_GetStatus_NextRunTime(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: mockRuntime}
+
+	response, err := server.GetStatus(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetStatus failed: %v", err)
+	}
+	if response.NextRunTime != nil {
+		t.Errorf("Expected no next run time, got %v", response.NextRunTime.AsTime())
+	}
+
+	next := time.Date(2025, 1, 20, 9, 0, 0, 0, time.UTC)
+	mockRuntime.SetNextRunTime(&next)
+
+	response, err = server.GetStatus(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetStatus failed: %v", err)
+	}
+	if response.NextRunTime == nil || !response.NextRunTime.AsTime().Equal(next) {
+		t.Errorf("Expected next run time %v, got %v", next, response.NextRunTime)
+	}
+}
+
 func TestServer_GetFlowSteps(t *testing.T) {
-	mockRuntime := createMockWorkerRuntimeWithFlowSteps()
+	mockRuntime := createMockWorkerRuntimeWithFlowSteps(t)
 	server := &Server{runtime: mockRuntime}
 
 	ctx := context.Background()
@@ -178,12 +205,57 @@ func TestServer_GetFlowSteps(t *testing.T) {
 	}
 }
 
+func TestServer_SetStepEnabled(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeWithFlowSteps(t)
+	server := &Server{runtime: mockRuntime}
+	ctx := context.Background()
+
+	response, err := server.SetStepEnabled(ctx, &workerv1.SetStepEnabledRequest{StepName: "step2", Enabled: false})
+	if err != nil {
+		t.Fatalf("SetStepEnabled failed: %v", err)
+	}
+	if response.StepName != "step2" || response.Enabled {
+		t.Errorf("Unexpected response: %+v", response)
+	}
+
+	steps, err := server.GetFlowSteps(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetFlowSteps failed: %v", err)
+	}
+	if enabled := steps.Steps[1].Enabled; enabled == nil || *enabled {
+		t.Error("Expected step2 to be reported as disabled")
+	}
+
+	flow, err := server.GetFlow(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetFlow failed: %v", err)
+	}
+	if flow.Flow.EnabledSteps != 2 {
+		t.Errorf("Expected 2 enabled steps, got %d", flow.Flow.EnabledSteps)
+	}
+
+	tests := []struct {
+		stepName     string
+		expectedCode codes.Code
+	}{
+		{stepName: "", expectedCode: codes.InvalidArgument},
+		{stepName: "missing", expectedCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		_, err := server.SetStepEnabled(ctx, &workerv1.SetStepEnabledRequest{StepName: tt.stepName})
+		if status.Code(err) != tt.expectedCode {
+			t.Errorf("Expected code %v for step %q, got %v", tt.expectedCode, tt.stepName, err)
+		}
+	}
+}
+
 func TestServer_GetFlowSteps_WithRuntimeStats(t *testing.T) {
-	mockRuntime := createMockWorkerRuntimeWithFlowSteps()
+	mockRuntime := createMockWorkerRuntimeWithFlowSteps(t)
 
 	// Add runtime statistics
 	mockRuntime.SetStepActive("step1", true)
-	mockRuntime.RecordStepExecution("step1", true, func() *string { s := "test output"; return &s }(), nil)
+	mockRuntime.RecordStepExecution("step1", true, func() *string { s := "test output"; return &s }(), nil, 2*time.Second)
 
 	server := &Server{runtime: mockRuntime}
 	ctx := context.Background()
@@ -422,7 +494,7 @@ func TestServer_GetLogFile(t *testing.T) {
 }
 
 func TestServer_GetConfig(t *testing.T) {
-	mockRuntime := createMockWorkerRuntimeForHandlers()
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
 	server := &Server{runtime: mockRuntime}
 
 	ctx := context.Background()
@@ -487,8 +559,158 @@ func TestDetermineLogRole(t *testing.T) {
 	}
 }
 
+func TestServer_PauseResume(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: mockRuntime}
+
+	tests := []struct {
+		name             string
+		action           string
+		expectedAccepted bool
+		expectedStatus   string
+	}{
+		{name: "resume_when_not_paused", action: "resume", expectedAccepted: false, expectedStatus: types.WorkerStatusIdle},
+		{name: "pause", action: "pause", expectedAccepted: true, expectedStatus: types.WorkerStatusPaused},
+		{name: "pause_again", action: "pause", expectedAccepted: false, expectedStatus: types.WorkerStatusPaused},
+		{name: "resume", action: "resume", expectedAccepted: true, expectedStatus: types.WorkerStatusIdle},
+	}
+
+	// Cases run in order, each building on the previous state
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var response *workerv1.ControlResponse
+			var err error
+			if tt.action == "pause" {
+				response, err = server.PauseWorker(context.Background(), &emptypb.Empty{})
+			} else {
+				response, err = server.ResumeWorker(context.Background(), &emptypb.Empty{})
+			}
+
+			if err != nil {
+				t.Fatalf("Control RPC failed: %v", err)
+			}
+			if response.Accepted != tt.expectedAccepted {
+				t.Errorf("Expected accepted=%v, got %v (%s)", tt.expectedAccepted, response.Accepted, response.Message)
+			}
+			if response.Status != tt.expectedStatus {
+				t.Errorf("Expected status '%s', got '%s'", tt.expectedStatus, response.Status)
+			}
+		})
+	}
+}
+
+func TestServer_TriggerFlow(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: mockRuntime}
+
+	// Repeated triggers collapse into a single pending trigger
+	for i := 0; i < 2; i++ {
+		response, err := server.TriggerFlow(context.Background(), &emptypb.Empty{})
+		if err != nil {
+			t.Fatalf("TriggerFlow failed: %v", err)
+		}
+		if !response.Accepted {
+			t.Errorf("Expected trigger to be accepted")
+		}
+	}
+
+	select {
+	case <-mockRuntime.FlowTriggers():
+	default:
+		t.Fatal("Expected a pending flow trigger")
+	}
+
+	select {
+	case <-mockRuntime.FlowTriggers():
+		t.Error("Expected only one pending flow trigger")
+	default:
+	}
+}
+
+func TestServer_TriggerEvent(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: mockRuntime}
+
+	_, err := server.TriggerEvent(context.Background(), &workerv1.TriggerEventRequest{Payload: []byte("not json")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for invalid payload, got %v", err)
+	}
+
+	source := "issues"
+	for _, payload := range []string{`{"action":"opened"}`, `{"action":"closed"}`} {
+		response, err := server.TriggerEvent(context.Background(), &workerv1.TriggerEventRequest{Payload: []byte(payload), Source: &source})
+		if err != nil {
+			t.Fatalf("TriggerEvent failed: %v", err)
+		}
+		if !response.Accepted {
+			t.Errorf("Expected event to be accepted")
+		}
+	}
+
+	// Events are handed out in order; the first one leaves a trigger for the second
+	<-mockRuntime.FlowTriggers()
+	event, ok := mockRuntime.NextEvent()
+	if !ok || string(event.Payload) != `{"action":"opened"}` || event.Source != "issues" {
+		t.Errorf("Unexpected first event: %+v (ok=%v)", event, ok)
+	}
+	select {
+	case <-mockRuntime.FlowTriggers():
+	default:
+		t.Error("Expected a flow trigger for the remaining event")
+	}
+	event, ok = mockRuntime.NextEvent()
+	if !ok || string(event.Payload) != `{"action":"closed"}` {
+		t.Errorf("Unexpected second event: %+v (ok=%v)", event, ok)
+	}
+	if _, ok := mockRuntime.NextEvent(); ok {
+		t.Error("Expected no more events")
+	}
+
+	// A full queue refuses further events until cycles pick them up
+	for i := 0; ; i++ {
+		_, err := server.TriggerEvent(context.Background(), &workerv1.TriggerEventRequest{Payload: []byte(`{}`)})
+		if err == nil {
+			continue
+		}
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("Expected ResourceExhausted for a full queue, got %v", err)
+		}
+		if i == 0 {
+			t.Error("Expected the first event to be queued")
+		}
+		break
+	}
+}
+
+func TestServer_CancelCurrentCycle(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: mockRuntime}
+
+	response, err := server.CancelCurrentCycle(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("CancelCurrentCycle failed: %v", err)
+	}
+	if response.Accepted {
+		t.Error("Expected cancel to be rejected without a running cycle")
+	}
+
+	cycleCtx, endCycle := mockRuntime.BeginCycle(context.Background())
+	defer endCycle()
+
+	response, err = server.CancelCurrentCycle(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("CancelCurrentCycle failed: %v", err)
+	}
+	if !response.Accepted {
+		t.Error("Expected cancel to be accepted while a cycle is running")
+	}
+	if cycleCtx.Err() != context.Canceled {
+		t.Errorf("Expected cycle context to be canceled, got %v", cycleCtx.Err())
+	}
+}
+
 // createMockWorkerRuntimeForHandlers creates a mock worker runtime for handler testing
-func createMockWorkerRuntimeForHandlers() *worker.WorkerRuntime {
+func createMockWorkerRuntimeForHandlers(t *testing.T) *worker.WorkerRuntime {
 	w := &worker.Worker{
 		Name:   "Test Worker",
 		Prompt: "Test prompt",
@@ -503,13 +725,13 @@ func createMockWorkerRuntimeForHandlers() *worker.WorkerRuntime {
 		},
 	}
 
-	runtime := worker.NewWorkerRuntime(w, settings)
+	runtime := worker.NewWorkerRuntimeInDir(w, settings, t.TempDir())
 	runtime.Name = "Test Worker" // Ensure name is set
 	return runtime
 }
 
 // createMockWorkerRuntimeWithFlowSteps creates a mock runtime with multiple flow steps
-func createMockWorkerRuntimeWithFlowSteps() *worker.WorkerRuntime {
+func createMockWorkerRuntimeWithFlowSteps(t *testing.T) *worker.WorkerRuntime {
 	w := &worker.Worker{
 		Name:   "Test Worker",
 		Prompt: "Test prompt",
@@ -541,7 +763,7 @@ func createMockWorkerRuntimeWithFlowSteps() *worker.WorkerRuntime {
 		},
 	}
 
-	runtime := worker.NewWorkerRuntime(w, settings)
+	runtime := worker.NewWorkerRuntimeInDir(w, settings, t.TempDir())
 	runtime.Name = "Test Worker"
 	return runtime
 }
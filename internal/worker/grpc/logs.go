@@ -0,0 +1,197 @@
+package grpc
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultStreamTailLines is the number of existing lines sent when a log stream starts
+	defaultStreamTailLines = 100
+	// logStreamPollInterval is how often followed log files are checked for new data
+	logStreamPollInterval = 500 * time.Millisecond
+	// tailReadBlockSize is the block size used when scanning a log file backwards for tail lines
+	tailReadBlockSize = 32 * 1024
+	// followReadLimit caps the amount of data returned by a single poll of a followed log file
+	followReadLimit = 256 * 1024
+)
+
+// resolveLogPath returns the absolute path of a log file, ensuring it stays within the logs directory
+func (s *Server) resolveLogPath(filename string) (string, error) {
+	logsDir := filepath.Join(s.runtime.GetWorkingDir(), "logs")
+	logPath := filepath.Join(logsDir, filename)
+
+	// Security check: ensure the path is within logs directory
+	if !strings.HasPrefix(filepath.Clean(logPath), logsDir+string(filepath.Separator)) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid log file path")
+	}
+
+	return logPath, nil
+}
+
+// tailLines returns the last n lines of content
+func tailLines(content string, n int) string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// logFollower follows a log file like `tail -F`, surviving truncation and rotation
+type logFollower struct {
+	path   string
+	file   *os.File
+	info   os.FileInfo
+	offset int64
+}
+
+// openLogFollower opens the log file at path and positions the follower at its end
+func openLogFollower(path string) (*logFollower, error) {
+	f := &logFollower{path: path}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	f.offset = f.info.Size()
+	return f, nil
+}
+
+func (f *logFollower) open() error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.info = info
+	f.offset = 0
+	return nil
+}
+
+// Close releases the underlying file handle
+func (f *logFollower) Close() error {
+	if f.file == nil {
+		return nil
+	}
+	return f.file.Close()
+}
+
+// Tail returns the last n lines written before the follower's current offset
+func (f *logFollower) Tail(n int) (string, error) {
+	if n <= 0 || f.offset == 0 {
+		return "", nil
+	}
+
+	var buf []byte
+	pos := f.offset
+	for pos > 0 {
+		size := int64(tailReadBlockSize)
+		if pos < size {
+			size = pos
+		}
+		pos -= size
+
+		block := make([]byte, size)
+		if _, err := f.file.ReadAt(block, pos); err != nil && err != io.EOF {
+			return "", err
+		}
+		buf = append(block, buf...)
+
+		// One extra newline is needed because the content usually ends with one
+		if bytes.Count(buf, []byte("\n")) > n {
+			break
+		}
+	}
+
+	content := string(buf)
+	if strings.HasSuffix(content, "\n") {
+		return tailLines(strings.TrimSuffix(content, "\n"), n) + "\n", nil
+	}
+	return tailLines(content, n), nil
+}
+
+// Poll returns data appended since the previous call. Truncated files are re-read
+// from the beginning; rotated files are drained and then replaced by the new file.
+func (f *logFollower) Poll() (string, error) {
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			if os.IsNotExist(err) {
+				return "", nil
+			}
+			return "", err
+		}
+	}
+
+	info, err := f.file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	// Truncation: the file shrank below what we already read
+	if info.Size() < f.offset {
+		f.offset = 0
+	}
+
+	content, err := f.readAvailable(info.Size())
+	if err != nil {
+		return "", err
+	}
+
+	// Rotation: the path now points to a different file
+	current, err := os.Stat(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Rotation in progress, keep the old handle until the new file appears
+			return content, nil
+		}
+		return content, err
+	}
+	if os.SameFile(f.info, current) || len(content) >= followReadLimit {
+		return content, nil
+	}
+
+	f.file.Close()
+	if err := f.open(); err != nil {
+		f.file = nil
+		if os.IsNotExist(err) {
+			return content, nil
+		}
+		return content, err
+	}
+
+	more, err := f.readAvailable(f.info.Size())
+	return content + more, err
+}
+
+func (f *logFollower) readAvailable(size int64) (string, error) {
+	if size <= f.offset {
+		return "", nil
+	}
+
+	length := size - f.offset
+	if length > followReadLimit {
+		length = followReadLimit
+	}
+
+	buf := make([]byte, length)
+	read, err := f.file.ReadAt(buf, f.offset)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	f.offset += int64(read)
+
+	return string(buf[:read]), nil
+}
@@ -0,0 +1,212 @@
+package grpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogFollower_Tail(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		lines    int
+		expected string
+	}{
+		{name: "fewer_lines_than_requested", content: "a\nb\n", lines: 5, expected: "a\nb\n"},
+		{name: "exact_tail", content: "a\nb\nc\nd\n", lines: 2, expected: "c\nd\n"},
+		{name: "no_trailing_newline", content: "a\nb\nc", lines: 2, expected: "b\nc"},
+		{name: "zero_lines", content: "a\nb\n", lines: 0, expected: ""},
+		{name: "empty_file", content: "", lines: 3, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "test.log")
+			writeLogFile(t, path, tt.content)
+
+			follower, err := openLogFollower(path)
+			if err != nil {
+				t.Fatalf("openLogFollower failed: %v", err)
+			}
+			defer follower.Close()
+
+			tail, err := follower.Tail(tt.lines)
+			if err != nil {
+				t.Fatalf("Tail failed: %v", err)
+			}
+			if tail != tt.expected {
+				t.Errorf("Expected tail %q, got %q", tt.expected, tail)
+			}
+		})
+	}
+}
+
+func TestLogFollower_Poll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	writeLogFile(t, path, "first\n")
+
+	follower, err := openLogFollower(path)
+	if err != nil {
+		t.Fatalf("openLogFollower failed: %v", err)
+	}
+	defer follower.Close()
+
+	// Nothing new yet
+	assertPoll(t, follower, "")
+
+	// Appended data
+	appendLogFile(t, path, "second\n")
+	assertPoll(t, follower, "second\n")
+
+	// Truncation restarts from the beginning
+	writeLogFile(t, path, "new\n")
+	assertPoll(t, follower, "new\n")
+
+	// Rotation drains the old file and switches to the new one
+	appendLogFile(t, path, "tail of old\n")
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatalf("Failed to rotate log: %v", err)
+	}
+	assertPoll(t, follower, "tail of old\n")
+
+	writeLogFile(t, path, "rotated\n")
+	assertPoll(t, follower, "rotated\n")
+
+	appendLogFile(t, path, "more\n")
+	assertPoll(t, follower, "more\n")
+}
+
+func TestServer_StreamLogs(t *testing.T) {
+	runtime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: runtime}
+
+	logsDir := filepath.Join(runtime.GetWorkingDir(), "logs")
+	if err := os.MkdirAll(logsDir, 0755); err != nil {
+		t.Fatalf("Failed to create logs dir: %v", err)
+	}
+	logPath := filepath.Join(logsDir, "worker.log")
+	writeLogFile(t, logPath, "line1\nline2\nline3\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockLogStream{
+		mockServerStream: mockServerStream{ctx: ctx},
+		chunks:           make(chan *workerv1.LogChunk, 10),
+	}
+
+	tail := int32(2)
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StreamLogs(&workerv1.StreamLogsRequest{Filename: "worker.log", Tail: &tail}, stream)
+	}()
+
+	if chunk := receiveChunk(t, stream); chunk.Content != "line2\nline3\n" {
+		t.Errorf("Expected initial tail %q, got %q", "line2\nline3\n", chunk.Content)
+	}
+
+	appendLogFile(t, logPath, "line4\n")
+	if chunk := receiveChunk(t, stream); chunk.Content != "line4\n" {
+		t.Errorf("Expected appended chunk %q, got %q", "line4\n", chunk.Content)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected stream to end cleanly, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("StreamLogs did not return after context cancellation")
+	}
+}
+
+func TestServer_StreamLogs_Errors(t *testing.T) {
+	runtime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: runtime}
+
+	stream := &mockLogStream{
+		mockServerStream: mockServerStream{ctx: context.Background()},
+		chunks:           make(chan *workerv1.LogChunk, 1),
+	}
+
+	tests := []struct {
+		filename     string
+		expectedCode codes.Code
+	}{
+		{filename: "../../etc/passwd", expectedCode: codes.InvalidArgument},
+		{filename: "missing.log", expectedCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			err := server.StreamLogs(&workerv1.StreamLogsRequest{Filename: tt.filename}, stream)
+			if status.Code(err) != tt.expectedCode {
+				t.Errorf("Expected code %v, got %v", tt.expectedCode, err)
+			}
+		})
+	}
+}
+
+// mockLogStream captures chunks sent by StreamLogs
+type mockLogStream struct {
+	mockServerStream
+	chunks chan *workerv1.LogChunk
+}
+
+func (m *mockLogStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (m *mockLogStream) Send(chunk *workerv1.LogChunk) error {
+	m.chunks <- chunk
+	return nil
+}
+
+func receiveChunk(t *testing.T, stream *mockLogStream) *workerv1.LogChunk {
+	t.Helper()
+	select {
+	case chunk := <-stream.chunks:
+		return chunk
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for log chunk")
+		return nil
+	}
+}
+
+func assertPoll(t *testing.T, follower *logFollower, expected string) {
+	t.Helper()
+	content, err := follower.Poll()
+	if err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if content != expected {
+		t.Errorf("Expected poll content %q, got %q", expected, content)
+	}
+}
+
+func writeLogFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write log file: %v", err)
+	}
+}
+
+func appendLogFile(t *testing.T, path, content string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("Failed to open log file: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatalf("Failed to append to log file: %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"sort"
+	"time"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultMetricsInterval is used when a metrics stream does not request an interval
+const defaultMetricsInterval = 5 * time.Second
+
+// buildWorkerMetrics collects current metrics from the worker runtime
+func (s *Server) buildWorkerMetrics() *workerv1.WorkerMetrics {
+	metrics := &workerv1.WorkerMetrics{}
+
+	uptime := formatDuration(s.runtime.GetUptime())
+	metrics.Uptime = &uptime
+
+	flowStats := s.runtime.GetFlowStats()
+	metrics.FlowExecutionCount = int32(flowStats.ExecutionCount)
+	metrics.FlowSuccessCount = int32(flowStats.SuccessCount)
+	if flowStats.ExecutionCount > 0 {
+		avgExecTime := formatDuration(flowStats.AverageDuration())
+		metrics.AvgExecutionTime = &avgExecTime
+
+		lastExecTime := formatDuration(flowStats.LastDuration)
+		metrics.LastExecutionTime = &lastExecTime
+
+		rollingAvg := formatDuration(flowStats.RecentDurations.Average())
+		metrics.RollingAvgExecutionTime = &rollingAvg
+		metrics.RecentExecutionTimes = formatDurations(flowStats.RecentDurations.Values())
+	}
+
+	if lastActivity := s.runtime.GetLastActivity(); lastActivity != nil {
+		metrics.LastActivity = timestamppb.New(*lastActivity)
+	}
+
+	// Report steps in flow order
+	stepStats := s.runtime.SnapshotStepStats()
+	for _, step := range s.runtime.GetSettings().Flow {
+		stats, exists := stepStats[step.Name]
+		if !exists {
+			continue
+		}
+
+		stepMetrics := &workerv1.StepMetrics{
+			Name:           step.Name,
+			Active:         stats.Active,
+			ExecutionCount: int32(stats.ExecutionCount),
+			SuccessCount:   int32(stats.SuccessCount),
+			FailureCount:   int32(stats.FailureCount),
+			RetryCount:     int32(stats.TotalRetries),
+			TimeoutCount:   int32(stats.TimeoutCount),
+			IterationCount: int32(stats.TotalIterations),
+		}
+
+		if stats.ExecutionCount > 0 {
+			lastDuration := formatDuration(stats.LastDuration)
+			stepMetrics.LastDuration = &lastDuration
+
+			avgDuration := formatDuration(stats.AverageDuration())
+			stepMetrics.AvgDuration = &avgDuration
+
+			rollingAvg := formatDuration(stats.RecentDurations.Average())
+			stepMetrics.RollingAvgDuration = &rollingAvg
+			stepMetrics.RecentDurations = formatDurations(stats.RecentDurations.Values())
+		}
+
+		if stats.LastExecution != nil {
+			stepMetrics.LastExecution = timestamppb.New(*stats.LastExecution)
+		}
+
+		if stats.Active {
+			metrics.ActiveSteps = append(metrics.ActiveSteps, step.Name)
+		}
+
+		metrics.Steps = append(metrics.Steps, stepMetrics)
+	}
+	sort.Strings(metrics.ActiveSteps)
+
+	return metrics
+}
+
+// metricsInterval converts a requested interval into a streaming interval
+func metricsInterval(intervalSeconds *int32) time.Duration {
+	if intervalSeconds == nil || *intervalSeconds <= 0 {
+		return defaultMetricsInterval
+	}
+	return time.Duration(*intervalSeconds) * time.Second
+}
+
+// formatDuration renders a duration with millisecond precision
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+// formatDurations renders a list of durations with formatDuration
+func formatDurations(durations []time.Duration) []string {
+	formatted := make([]string, len(durations))
+	for i, d := range durations {
+		formatted[i] = formatDuration(d)
+	}
+	return formatted
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestServer_GetMetrics(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeWithFlowSteps(t)
+	server := &Server{runtime: mockRuntime}
+
+	errMsg := "failed"
+	mockRuntime.RecordStepAttempt("step1", 1)
+	mockRuntime.RecordStepAttempt("step1", 2)
+	mockRuntime.RecordStepExecution("step1", true, nil, nil, 2*time.Second)
+	mockRuntime.RecordStepExecution("step2", false, nil, &errMsg, 4*time.Second)
+	mockRuntime.SetStepActive("step3", true)
+	mockRuntime.RecordFlowExecution(true, 6*time.Second)
+	mockRuntime.RecordFlowExecution(false, 2*time.Second)
+
+	response, err := server.GetMetrics(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetMetrics failed: %v", err)
+	}
+
+	metrics := response.Metrics
+	if metrics.GetAvgExecutionTime() != "4s" {
+		t.Errorf("Expected avg execution time '4s', got '%s'", metrics.GetAvgExecutionTime())
+	}
+	if metrics.GetLastExecutionTime() != "2s" {
+		t.Errorf("Expected last execution time '2s', got '%s'", metrics.GetLastExecutionTime())
+	}
+	if metrics.GetRollingAvgExecutionTime() != "4s" || len(metrics.RecentExecutionTimes) != 2 || metrics.RecentExecutionTimes[1] != "2s" {
+		t.Errorf("Unexpected rolling execution times: avg %s, recent %v", metrics.GetRollingAvgExecutionTime(), metrics.RecentExecutionTimes)
+	}
+	if metrics.FlowExecutionCount != 2 || metrics.FlowSuccessCount != 1 {
+		t.Errorf("Unexpected flow counts: %d executions, %d successes", metrics.FlowExecutionCount, metrics.FlowSuccessCount)
+	}
+	if len(metrics.ActiveSteps) != 1 || metrics.ActiveSteps[0] != "step3" {
+		t.Errorf("Expected active steps [step3], got %v", metrics.ActiveSteps)
+	}
+	if len(metrics.Steps) != 3 {
+		t.Fatalf("Expected 3 step metrics, got %d", len(metrics.Steps))
+	}
+
+	step1 := metrics.Steps[0]
+	if step1.Name != "step1" || step1.SuccessCount != 1 || step1.RetryCount != 1 || step1.GetAvgDuration() != "2s" {
+		t.Errorf("Unexpected step1 metrics: %+v", step1)
+	}
+
+	if len(step1.RecentDurations) != 1 || step1.RecentDurations[0] != "2s" || step1.GetRollingAvgDuration() != "2s" {
+		t.Errorf("Unexpected step1 rolling durations: %v", step1.RecentDurations)
+	}
+
+	step2 := metrics.Steps[1]
+	if step2.FailureCount != 1 || step2.GetLastDuration() != "4s" {
+		t.Errorf("Unexpected step2 metrics: %+v", step2)
+	}
+
+	step3 := metrics.Steps[2]
+	if !step3.Active || step3.AvgDuration != nil {
+		t.Errorf("Unexpected step3 metrics: %+v", step3)
+	}
+}
+
+func TestServer_StreamMetrics(t *testing.T) {
+	mockRuntime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: mockRuntime}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockMetricsStream{
+		mockServerStream: mockServerStream{ctx: ctx},
+		updates:          make(chan *workerv1.MetricsUpdate, 10),
+	}
+
+	interval := int32(1)
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StreamMetrics(&workerv1.StreamMetricsRequest{IntervalSeconds: &interval}, stream)
+	}()
+
+	// The first update is sent immediately, the next one after the interval
+	for i := 0; i < 2; i++ {
+		select {
+		case update := <-stream.updates:
+			if update.Metrics == nil || update.Timestamp == nil {
+				t.Errorf("Expected metrics and timestamp in update %d", i)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("Timed out waiting for metrics update %d", i)
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected stream to end cleanly, got %v", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("StreamMetrics did not return after context cancellation")
+	}
+}
+
+func TestMetricsInterval(t *testing.T) {
+	zero := int32(0)
+	one := int32(1)
+	ten := int32(10)
+
+	tests := []struct {
+		name     string
+		interval *int32
+		expected time.Duration
+	}{
+		{name: "unset", interval: nil, expected: defaultMetricsInterval},
+		{name: "zero", interval: &zero, expected: defaultMetricsInterval},
+		{name: "one_second", interval: &one, expected: time.Second},
+		{name: "ten_seconds", interval: &ten, expected: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := metricsInterval(tt.interval); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// mockMetricsStream captures updates sent by StreamMetrics
+type mockMetricsStream struct {
+	mockServerStream
+	updates chan *workerv1.MetricsUpdate
+}
+
+func (m *mockMetricsStream) Send(update *workerv1.MetricsUpdate) error {
+	m.updates <- update
+	return nil
+}
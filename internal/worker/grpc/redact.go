@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+
+	"autoteam/internal/secrets"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactUnaryInterceptor removes secret values from unary responses, e.g. step outputs of
+// the run history or log file contents
+func redactUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if message, ok := resp.(proto.Message); ok && message != nil {
+		redactMessage(message.ProtoReflect())
+	}
+	return resp, err
+}
+
+// redactStreamInterceptor removes secret values from every streamed message
+func redactStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &redactingServerStream{ServerStream: ss})
+}
+
+// redactingServerStream redacts messages before sending them
+type redactingServerStream struct {
+	grpc.ServerStream
+}
+
+// SendMsg redacts and sends a message
+func (s *redactingServerStream) SendMsg(m interface{}) error {
+	if message, ok := m.(proto.Message); ok {
+		redactMessage(message.ProtoReflect())
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// redactMessage redacts every string field of a message, including nested messages, lists
+// and maps
+func redactMessage(message protoreflect.Message) {
+	if !message.IsValid() {
+		return
+	}
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				if redacted, ok := redactValue(field, list.Get(i)); ok {
+					list.Set(i, redacted)
+				}
+			}
+		case field.IsMap():
+			entries := value.Map()
+			entries.Range(func(key protoreflect.MapKey, item protoreflect.Value) bool {
+				if redacted, ok := redactValue(field.MapValue(), item); ok {
+					entries.Set(key, redacted)
+				}
+				return true
+			})
+		default:
+			if redacted, ok := redactValue(field, value); ok {
+				message.Set(field, redacted)
+			}
+		}
+		return true
+	})
+}
+
+// redactValue redacts a single string or message value and reports whether it has to be
+// stored again
+func redactValue(field protoreflect.FieldDescriptor, value protoreflect.Value) (protoreflect.Value, bool) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		redacted := secrets.Redact(value.String())
+		return protoreflect.ValueOfString(redacted), redacted != value.String()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		redactMessage(value.Message()) // Modified in place
+	}
+	return value, false
+}
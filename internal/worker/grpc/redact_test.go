@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/secrets"
+
+	"google.golang.org/grpc"
+)
+
+func TestRedactUnaryInterceptor(t *testing.T) {
+	secrets.Register("grpc_token", "grpc-secret-value")
+
+	prompt := "call the API with grpc-secret-value"
+	stdout := "ok"
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &workerv1.RunResponse{
+			Run: &workerv1.RunRecord{
+				Id: "run-1",
+				Steps: []*workerv1.StepRecord{
+					{Name: "call", Prompt: &prompt, Stdout: &stdout},
+				},
+			},
+		}, nil
+	}
+
+	resp, err := redactUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	if err != nil {
+		t.Fatalf("Interceptor failed: %v", err)
+	}
+	step := resp.(*workerv1.RunResponse).Run.Steps[0]
+	if step.GetPrompt() != "call the API with [REDACTED:grpc_token]" {
+		t.Errorf("Expected redacted prompt, got %q", step.GetPrompt())
+	}
+	if step.GetStdout() != "ok" || step.Name != "call" {
+		t.Errorf("Unexpected step: %+v", step)
+	}
+
+	// Errors are passed through without a response
+	failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return (*workerv1.RunResponse)(nil), context.Canceled
+	}
+	if _, err := redactUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, failing); err != context.Canceled {
+		t.Errorf("Expected handler error, got %v", err)
+	}
+}
+
+func TestRedactingServerStream(t *testing.T) {
+	secrets.Register("grpc_stream_token", "grpc-stream-secret")
+
+	chunk := &workerv1.LogChunk{Content: "token=grpc-stream-secret\n"}
+	redactMessage(chunk.ProtoReflect())
+	if chunk.Content != "token=[REDACTED:grpc_stream_token]\n" {
+		t.Errorf("Expected redacted log chunk, got %q", chunk.Content)
+	}
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/history"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultRunsLimit is used when ListRuns does not request a limit
+const defaultRunsLimit = 50
+
+// runHistory returns the run history store of the worker directory
+func (s *Server) runHistory() *history.Store {
+	return s.runtime.GetRunHistory()
+}
+
+// toRunSummary converts a run record into a summary without step details
+func toRunSummary(record history.RunRecord) *workerv1.RunSummary {
+	summary := &workerv1.RunSummary{
+		Id:        record.ID,
+		Start:     timestamppb.New(record.Start),
+		End:       timestamppb.New(record.End),
+		Duration:  formatDuration(record.Duration),
+		Status:    record.Status,
+		StepCount: int32(len(record.Steps)),
+	}
+	if record.Error != "" {
+		summary.Error = &record.Error
+	}
+	return summary
+}
+
+// toRunRecord converts a run record including its steps
+func toRunRecord(record history.RunRecord) *workerv1.RunRecord {
+	run := &workerv1.RunRecord{
+		Id:       record.ID,
+		Start:    timestamppb.New(record.Start),
+		End:      timestamppb.New(record.End),
+		Duration: formatDuration(record.Duration),
+		Status:   record.Status,
+	}
+	if record.Error != "" {
+		run.Error = &record.Error
+	}
+
+	for _, step := range record.Steps {
+		run.Steps = append(run.Steps, toStepRecord(step))
+	}
+
+	return run
+}
+
+// toStepRecord converts a step of a run
+func toStepRecord(step history.StepRecord) *workerv1.StepRecord {
+	record := &workerv1.StepRecord{
+		Name:     step.Name,
+		Status:   step.Status,
+		Attempts: int32(step.Attempts),
+		Duration: formatDuration(step.Duration),
+	}
+	if step.Prompt != "" {
+		record.Prompt = &step.Prompt
+	}
+	if step.Stdout != "" {
+		record.Stdout = &step.Stdout
+	}
+	if step.Stderr != "" {
+		record.Stderr = &step.Stderr
+	}
+	if step.SkipReason != "" {
+		record.SkipReason = &step.SkipReason
+	}
+	for _, iteration := range step.Iterations {
+		record.Iterations = append(record.Iterations, toIterationRecord(iteration))
+	}
+	return record
+}
+
+// toIterationRecord converts an iteration of a loop step
+func toIterationRecord(iteration history.IterationRecord) *workerv1.IterationRecord {
+	record := &workerv1.IterationRecord{
+		Iteration: int32(iteration.Iteration),
+		Attempts:  int32(iteration.Attempts),
+		Duration:  formatDuration(iteration.Duration),
+	}
+	if iteration.Prompt != "" {
+		record.Prompt = &iteration.Prompt
+	}
+	if iteration.Stdout != "" {
+		record.Stdout = &iteration.Stdout
+	}
+	if iteration.Stderr != "" {
+		record.Stderr = &iteration.Stderr
+	}
+	if iteration.ExitCode != 0 {
+		exitCode := int32(iteration.ExitCode)
+		record.ExitCode = &exitCode
+	}
+	if iteration.Error != "" {
+		record.Error = &iteration.Error
+	}
+	for _, step := range iteration.Steps {
+		record.Steps = append(record.Steps, toStepRecord(step))
+	}
+	return record
+}
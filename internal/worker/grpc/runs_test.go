@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	workerv1 "autoteam/internal/grpc/gen/proto/autoteam/worker/v1"
+	"autoteam/internal/history"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ListRunsGetRun(t *testing.T) {
+	runtime := createMockWorkerRuntimeForHandlers(t)
+	server := &Server{runtime: runtime}
+	ctx := context.Background()
+
+	start := time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC)
+	store := history.NewStore(runtime.GetWorkingDir(), history.Retention{})
+	for i, runStatus := range []string{history.RunStatusSuccess, history.RunStatusFailed, history.RunStatusSuccess} {
+		record := history.RunRecord{
+			ID:       history.NewRunID(start.Add(time.Duration(i) * time.Hour)),
+			Start:    start.Add(time.Duration(i) * time.Hour),
+			End:      start.Add(time.Duration(i)*time.Hour + time.Minute),
+			Duration: time.Minute,
+			Status:   runStatus,
+			Steps: []history.StepRecord{
+				{Name: "collect", Status: history.StepStatusSuccess, Prompt: "collect", Stdout: "ok", Attempts: 1, Duration: time.Second},
+				{Name: "report", Status: history.StepStatusSkipped, SkipReason: "step is disabled"},
+			},
+		}
+		if err := store.Append(record); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	limit := int32(2)
+	list, err := server.ListRuns(ctx, &workerv1.ListRunsRequest{Limit: &limit})
+	if err != nil {
+		t.Fatalf("ListRuns failed: %v", err)
+	}
+	if list.Total != 3 || len(list.Runs) != 2 {
+		t.Fatalf("Expected 2 of 3 runs, got %d of %d", len(list.Runs), list.Total)
+	}
+	if list.Runs[1].Status != history.RunStatusFailed || list.Runs[1].StepCount != 2 {
+		t.Errorf("Unexpected run summary: %+v", list.Runs[1])
+	}
+
+	run, err := server.GetRun(ctx, &workerv1.GetRunRequest{RunId: list.Runs[1].Id})
+	if err != nil {
+		t.Fatalf("GetRun failed: %v", err)
+	}
+	if len(run.Run.Steps) != 2 {
+		t.Fatalf("Expected 2 steps, got %d", len(run.Run.Steps))
+	}
+	if step := run.Run.Steps[0]; step.GetPrompt() != "collect" || step.GetStdout() != "ok" || step.Attempts != 1 || step.Duration != "1s" {
+		t.Errorf("Unexpected step record: %+v", step)
+	}
+	if step := run.Run.Steps[1]; step.GetSkipReason() != "step is disabled" {
+		t.Errorf("Expected skip reason, got %+v", step)
+	}
+
+	tests := []struct {
+		runID        string
+		expectedCode codes.Code
+	}{
+		{runID: "", expectedCode: codes.InvalidArgument},
+		{runID: "missing", expectedCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		_, err := server.GetRun(ctx, &workerv1.GetRunRequest{RunId: tt.runID})
+		if status.Code(err) != tt.expectedCode {
+			t.Errorf("Expected code %v for run %q, got %v", tt.expectedCode, tt.runID, err)
+		}
+	}
+}
@@ -60,14 +60,18 @@ func (s *Server) Start(ctx context.Context) error {
 		return err
 	}
 
-	// Create gRPC server with interceptors
-	opts := []grpc.ServerOption{}
+	// Create gRPC server with interceptors; secrets are redacted from every response
+	unaryInterceptors := []grpc.UnaryServerInterceptor{redactUnaryInterceptor}
+	streamInterceptors := []grpc.StreamServerInterceptor{redactStreamInterceptor}
 	if s.apiKey != "" {
-		opts = append(opts, grpc.UnaryInterceptor(s.authUnaryInterceptor))
-		opts = append(opts, grpc.StreamInterceptor(s.authStreamInterceptor))
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{s.authUnaryInterceptor}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{s.authStreamInterceptor}, streamInterceptors...)
 	}
 
-	s.server = grpc.NewServer(opts...)
+	s.server = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
 	// Register the service
 	workerv1.RegisterWorkerServiceServer(s.server, s)
@@ -33,18 +33,18 @@ func ExecuteHooks(ctx context.Context, hookConfig *HookConfig, hookType string)
 				zap.Error(err))
 
 			// Check continue_on setting
-			continueOn := "error" // default
+			continueOn := ContinueOnError // default
 			if hook.ContinueOn != nil {
 				continueOn = *hook.ContinueOn
 			}
 
 			switch continueOn {
-			case "always":
+			case ContinueOnAlways:
 				lgr.Debug("Continuing despite hook failure (continue_on: always)")
 				continue
-			case "success":
+			case ContinueOnSuccess:
 				return fmt.Errorf("hook %d failed and continue_on is 'success': %w", i+1, err)
-			case "error":
+			case ContinueOnError:
 				lgr.Debug("Continuing after hook failure (continue_on: error)")
 				continue
 			default:
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// outputSchemaURL identifies the output_schema of a step while it is compiled. Schemas
+// cannot load other documents, so the URL only appears in $ref resolution errors.
+const outputSchemaURL = "urn:autoteam:output_schema"
+
+// CompileOutputSchema compiles the output_schema of a step as JSON Schema (draft 2020-12
+// unless the schema declares another $schema), or returns nil if the step has none
+func (s *FlowStep) CompileOutputSchema() (*jsonschema.Schema, error) {
+	if len(s.OutputSchema) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(s.OutputSchema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output_schema: %w", err)
+	}
+	document, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid output_schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.UseLoader(jsonschema.SchemeURLLoader{}) // No files or URLs; only the embedded metaschemas
+	if err := compiler.AddResource(outputSchemaURL, document); err != nil {
+		return nil, fmt.Errorf("invalid output_schema: %w", err)
+	}
+	schema, err := compiler.Compile(outputSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output_schema: %w", err)
+	}
+	return schema, nil
+}
@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// StepStateFile is the file in the worker directory that persists runtime step toggles
+const StepStateFile = "step_state.json"
+
+// ErrStepNotFound is returned when a step name is not part of the worker flow
+var ErrStepNotFound = errors.New("step not found")
+
+// stepState is the on-disk representation of runtime step toggles
+type stepState struct {
+	Disabled []string `json:"disabled"`
+}
+
+// IsStepEnabled reports whether a step is enabled. Unknown steps are considered enabled.
+func (rs *WorkerRuntimeState) IsStepEnabled(stepName string) bool {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	if stats, exists := rs.stepStats[stepName]; exists {
+		return stats.Enabled
+	}
+	return true
+}
+
+// SetStepEnabled enables or disables a step and persists the change to the worker directory
+func (rs *WorkerRuntimeState) SetStepEnabled(stepName string, enabled bool) error {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	stats, exists := rs.stepStats[stepName]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrStepNotFound, stepName)
+	}
+
+	previous := stats.Enabled
+	stats.Enabled = enabled
+	if err := rs.saveStepState(); err != nil {
+		stats.Enabled = previous
+		return err
+	}
+	return nil
+}
+
+// LoadStepState restores step toggles from the worker directory. A missing file leaves
+// all steps enabled; steps that are no longer part of the flow are ignored.
+func (rs *WorkerRuntimeState) LoadStepState() error {
+	data, err := os.ReadFile(filepath.Join(rs.workingDir, StepStateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read step state: %w", err)
+	}
+
+	var state stepState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("failed to parse step state: %w", err)
+	}
+
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	for _, stats := range rs.stepStats {
+		stats.Enabled = true
+	}
+	for _, stepName := range state.Disabled {
+		if stats, exists := rs.stepStats[stepName]; exists {
+			stats.Enabled = false
+		}
+	}
+	return nil
+}
+
+// saveStepState writes the current step toggles atomically. Caller must hold stepStatsMutex.
+func (rs *WorkerRuntimeState) saveStepState() error {
+	state := stepState{Disabled: []string{}}
+	for _, step := range rs.effectiveSettings.Flow {
+		if stats, exists := rs.stepStats[step.Name]; exists && !stats.Enabled {
+			state.Disabled = append(state.Disabled, step.Name)
+		}
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode step state: %w", err)
+	}
+
+	if err := os.MkdirAll(rs.workingDir, 0755); err != nil {
+		return fmt.Errorf("failed to create worker directory: %w", err)
+	}
+
+	path := filepath.Join(rs.workingDir, StepStateFile)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write step state: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to save step state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+// TemplateFuncs returns the functions available in step templates: the Sprig functions
+// and shellquote
+func TemplateFuncs() template.FuncMap {
+	funcs := sprig.FuncMap()
+	funcs["shellquote"] = ShellQuote
+	return funcs
+}
+
+// ShellQuote quotes a value as a single word for sh, so that upstream data can be used in
+// a shell script without being interpreted by the shell
+func ShellQuote(value interface{}) string {
+	return "'" + strings.ReplaceAll(fmt.Sprint(value), "'", `'\''`) + "'"
+}
+
+// ValidateTemplates parses the input, output and skip_when templates of a step with the
+// functions available at runtime, so that syntax errors surface at config load
+func (s *FlowStep) ValidateTemplates() error {
+	templates := []struct {
+		field string
+		text  string
+	}{
+		{"input", s.Input},
+		{"output", s.Output},
+		{"skip_when", s.SkipWhen},
+	}
+	for _, t := range templates {
+		if t.text == "" {
+			continue
+		}
+		if _, err := template.New(t.field).Funcs(TemplateFuncs()).Parse(t.text); err != nil {
+			return fmt.Errorf("invalid %s template: %w", t.field, err)
+		}
+	}
+	return nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"time"
+
+	"autoteam/internal/history"
 )
 
 // Worker represents a worker configuration
@@ -19,20 +22,48 @@ type Worker struct {
 
 // WorkerSettings represents worker-specific settings and configuration
 type WorkerSettings struct {
-	SleepDuration *int                   `yaml:"sleep_duration,omitempty"`
-	TeamName      *string                `yaml:"team_name,omitempty"`
-	InstallDeps   *bool                  `yaml:"install_deps,omitempty"`
-	CommonPrompt  *string                `yaml:"common_prompt,omitempty"`
-	MaxAttempts   *int                   `yaml:"max_attempts,omitempty"`
-	Service       map[string]interface{} `yaml:"service,omitempty"`
-	MCPServers    map[string]MCPServer   `yaml:"mcp_servers,omitempty"`
-	Hooks         *HookConfig            `yaml:"hooks,omitempty"`
-	Debug         *bool                  `yaml:"debug,omitempty"`
-	Meta          map[string]interface{} `yaml:"meta,omitempty"`
+	SleepDuration   *int                   `yaml:"sleep_duration,omitempty"`
+	Schedule        *ScheduleConfig        `yaml:"schedule,omitempty"`
+	TeamName        *string                `yaml:"team_name,omitempty"`
+	InstallDeps     *bool                  `yaml:"install_deps,omitempty"`
+	CommonPrompt    *string                `yaml:"common_prompt,omitempty"`
+	MaxAttempts     *int                   `yaml:"max_attempts,omitempty"`
+	FlowTimeout     *string                `yaml:"flow_timeout,omitempty"`     // Bounds a whole flow cycle, e.g. "45m"
+	MaxParallel     *int                   `yaml:"max_parallel,omitempty"`     // Max flow steps running at once (0 = no limit)
+	FailFast        *bool                  `yaml:"fail_fast,omitempty"`        // Stop the flow when a step without dependents fails (default: true)
+	StrictTemplates *bool                  `yaml:"strict_templates,omitempty"` // Fail steps whose input, output or skip_when template fails
+	History         *HistoryConfig         `yaml:"history,omitempty"`          // Retention of the run history
+	Service         map[string]interface{} `yaml:"service,omitempty"`
+	MCPServers      map[string]MCPServer   `yaml:"mcp_servers,omitempty"`
+	Hooks           *HookConfig            `yaml:"hooks,omitempty"`
+	Debug           *bool                  `yaml:"debug,omitempty"`
+	Meta            map[string]interface{} `yaml:"meta,omitempty"`
 	// Dynamic Flow Configuration
 	Flow []FlowStep `yaml:"flow"`
 }
 
+// ScheduleConfig defines when a worker starts flow cycles. Without a cron expression,
+// cycles start sleep_duration seconds apart.
+type ScheduleConfig struct {
+	Cron     string           `yaml:"cron,omitempty" json:"cron,omitempty"`         // Five-field cron expression or macro (@daily, @hourly, ...)
+	Timezone string           `yaml:"timezone,omitempty" json:"timezone,omitempty"` // IANA timezone for cron and windows (default: local time)
+	Windows  []ScheduleWindow `yaml:"windows,omitempty" json:"windows,omitempty"`   // Cycles only start inside one of these windows
+	Jitter   int              `yaml:"jitter,omitempty" json:"jitter,omitempty"`     // Max random delay in seconds added to each run
+}
+
+// HistoryConfig bounds the run history kept in the worker directory
+type HistoryConfig struct {
+	MaxRuns *int   `yaml:"max_runs,omitempty" json:"max_runs,omitempty"` // Newest runs to keep (default 1000, 0 = no limit)
+	MaxAge  string `yaml:"max_age,omitempty" json:"max_age,omitempty"`   // Runs that started longer ago are removed, e.g. "720h" (default: no limit)
+}
+
+// ScheduleWindow is a daily time range in which flow cycles may start
+type ScheduleWindow struct {
+	Days  []string `yaml:"days,omitempty" json:"days,omitempty"` // mon, tue, ... or ranges like mon-fri (default: every day)
+	Start string   `yaml:"start" json:"start"`                   // HH:MM, inclusive
+	End   string   `yaml:"end" json:"end"`                       // HH:MM, exclusive; earlier than start spans midnight
+}
+
 // RetryConfig defines retry behavior for a flow step
 type RetryConfig struct {
 	MaxAttempts int    `yaml:"max_attempts,omitempty" json:"max_attempts,omitempty"` // Default: 1 (no retry)
@@ -43,18 +74,87 @@ type RetryConfig struct {
 
 // FlowStep represents a single step in a dynamic flow configuration
 type FlowStep struct {
-	Name             string            `yaml:"name" json:"name"`                                               // Unique step name
-	Type             string            `yaml:"type" json:"type"`                                               // Agent type (claude, gemini, qwen)
-	Args             []string          `yaml:"args,omitempty" json:"args,omitempty"`                           // Agent-specific arguments
-	Env              map[string]string `yaml:"env,omitempty" json:"env,omitempty"`                             // Environment variables
-	DependsOn        []string          `yaml:"depends_on,omitempty" json:"depends_on,omitempty"`               // Step dependencies
-	Input            string            `yaml:"input,omitempty" json:"input,omitempty"`                         // Agent input prompt (supports templates)
-	Output           string            `yaml:"output,omitempty" json:"output,omitempty"`                       // Output transformation template (Sprig)
-	SkipWhen         string            `yaml:"skip_when,omitempty" json:"skip_when,omitempty"`                 // Skip condition template (if evaluates to "true")
-	DependencyPolicy string            `yaml:"dependency_policy,omitempty" json:"dependency_policy,omitempty"` // "fail_fast", "all_success", "all_complete", "any_success"
-	Retry            *RetryConfig      `yaml:"retry,omitempty" json:"retry,omitempty"`                         // Retry configuration
+	Name             string                 `yaml:"name" json:"name"`                                               // Unique step name
+	Type             string                 `yaml:"type" json:"type"`                                               // Step type: an agent (claude, gemini, qwen, debug), shell, http, mcp_call, router or loop; for_each fans any agent step out over items
+	Args             []string               `yaml:"args,omitempty" json:"args,omitempty"`                           // Agent-specific arguments
+	Env              map[string]string      `yaml:"env,omitempty" json:"env,omitempty"`                             // Environment variables
+	DependsOn        []string               `yaml:"depends_on,omitempty" json:"depends_on,omitempty"`               // Step dependencies
+	Input            string                 `yaml:"input,omitempty" json:"input,omitempty"`                         // Agent input prompt (supports templates)
+	Output           string                 `yaml:"output,omitempty" json:"output,omitempty"`                       // Output transformation template (Sprig)
+	SkipWhen         string                 `yaml:"skip_when,omitempty" json:"skip_when,omitempty"`                 // Skip condition template (if evaluates to "true")
+	DependencyPolicy string                 `yaml:"dependency_policy,omitempty" json:"dependency_policy,omitempty"` // "fail_fast", "all_success", "all_complete", "any_success"
+	Retry            *RetryConfig           `yaml:"retry,omitempty" json:"retry,omitempty"`                         // Retry configuration
+	Every            string                 `yaml:"every,omitempty" json:"every,omitempty"`                         // Minimum interval between runs (e.g. "10m", "24h")
+	Cron             string                 `yaml:"cron,omitempty" json:"cron,omitempty"`                           // Cron expression the step runs on (local time)
+	NotDuePolicy     string                 `yaml:"not_due_policy,omitempty" json:"not_due_policy,omitempty"`       // "reuse" (default) or "skip" when the step is not due
+	Timeout          string                 `yaml:"timeout,omitempty" json:"timeout,omitempty"`                     // Limit per attempt (e.g. "10m"); the agent is killed on expiry
+	When             string                 `yaml:"when,omitempty" json:"when,omitempty"`                           // Router: template rendering the selected route name(s)
+	Routes           map[string][]string    `yaml:"routes,omitempty" json:"routes,omitempty"`                       // Router: route name -> steps activated by the route
+	ForEach          string                 `yaml:"for_each,omitempty" json:"for_each,omitempty"`                   // Template rendering a JSON array; the agent runs once per item
+	Concurrency      int                    `yaml:"concurrency,omitempty" json:"concurrency,omitempty"`             // Max for_each items running at once (default: 1)
+	Until            string                 `yaml:"until,omitempty" json:"until,omitempty"`                         // Loop: re-run the agent until this template over its output renders "true"
+	MaxIterations    int                    `yaml:"max_iterations,omitempty" json:"max_iterations,omitempty"`       // Loop: iteration limit (default: 5)
+	Steps            []FlowStep             `yaml:"steps,omitempty" json:"steps,omitempty"`                         // Loop step: steps run in order in each iteration
+	HTTP             *HTTPRequest           `yaml:"http,omitempty" json:"http,omitempty"`                           // HTTP step: request to perform
+	MCP              *MCPCall               `yaml:"mcp,omitempty" json:"mcp,omitempty"`                             // MCP call step: tool to call
+	OutputSchema     map[string]interface{} `yaml:"output_schema,omitempty" json:"output_schema,omitempty"`         // JSON Schema the JSON in the agent output must match
 }
 
+// MCPCall defines the tool call of an mcp_call step
+type MCPCall struct {
+	Server    string `yaml:"server" json:"server"`                           // Name of a stdio server in mcp_servers
+	Tool      string `yaml:"tool" json:"tool"`                               // Tool to call
+	Arguments string `yaml:"arguments,omitempty" json:"arguments,omitempty"` // Template rendering the JSON object of tool arguments
+}
+
+// HTTPRequest defines the request of an http step. Method, URL, header values and body are templates.
+type HTTPRequest struct {
+	Method        string            `yaml:"method,omitempty" json:"method,omitempty"`                 // Default: GET
+	URL           string            `yaml:"url" json:"url"`                                           // Request URL
+	Headers       map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`               // Request headers
+	Body          string            `yaml:"body,omitempty" json:"body,omitempty"`                     // Request body
+	SuccessStatus []int             `yaml:"success_status,omitempty" json:"success_status,omitempty"` // Status codes that count as success (default: any 2xx)
+}
+
+// StepTypeRouter is the step type that activates downstream branches instead of running an agent
+const StepTypeRouter = "router"
+
+// StepTypeLoop is the step type that runs a sequence of steps per iteration instead of a single agent
+const StepTypeLoop = "loop"
+
+// StepTypeHTTP is the step type that performs an HTTP request instead of running an LLM
+const StepTypeHTTP = "http"
+
+// StepTypeMCPCall is the step type that calls an MCP tool directly instead of through an LLM
+const StepTypeMCPCall = "mcp_call"
+
+// DefaultRoute is taken by a router whose when template renders no route name
+const DefaultRoute = "default"
+
+// DefaultMaxIterations limits loop steps that set until without max_iterations
+const DefaultMaxIterations = 5
+
+// Dependency policies decide whether a step runs given the outcome of its dependencies
+const (
+	DependencyPolicyFailFast    = "fail_fast"    // Run if no dependency failed; a failure stops the flow (default)
+	DependencyPolicyAllSuccess  = "all_success"  // Run if every dependency succeeded, otherwise skip
+	DependencyPolicyAllComplete = "all_complete" // Run once every dependency finished, whatever the outcome
+	DependencyPolicyAnySuccess  = "any_success"  // Run if at least one dependency succeeded, otherwise skip
+)
+
+// Backoff strategies for the delay between retries of a step
+const (
+	BackoffFixed       = "fixed"       // The same delay before every retry (default)
+	BackoffExponential = "exponential" // The delay doubles with every retry
+	BackoffLinear      = "linear"      // The delay grows by the base delay with every retry
+)
+
+// Not-due policies for steps with an every or cron schedule
+const (
+	NotDuePolicyReuse = "reuse" // Reuse the output of the last successful run
+	NotDuePolicySkip  = "skip"  // Mark the step as skipped
+)
+
 // MCPServer represents a Model Context Protocol server configuration
 type MCPServer struct {
 	Command string            `yaml:"command"`
@@ -81,6 +181,13 @@ type HookCommand struct {
 	Description *string           `yaml:"description,omitempty"`
 }
 
+// Hook continue_on values decide whether a failed hook stops the worker
+const (
+	ContinueOnSuccess = "success" // Stop on failure: continue only if the hook succeeded
+	ContinueOnError   = "error"   // Continue after a failure (default)
+	ContinueOnAlways  = "always"  // Continue whatever the outcome
+)
+
 // WorkerWithSettings combines a worker with its effective settings
 type WorkerWithSettings struct {
 	Worker   Worker
@@ -199,6 +306,27 @@ func (s *WorkerSettings) GetMaxAttempts() int {
 	return 3 // default
 }
 
+func (s *WorkerSettings) GetMaxParallel() int {
+	if s.MaxParallel != nil {
+		return *s.MaxParallel
+	}
+	return 0 // default: no limit
+}
+
+func (s *WorkerSettings) GetFailFast() bool {
+	if s.FailFast != nil {
+		return *s.FailFast
+	}
+	return true // default: a failed final step cancels the running branches
+}
+
+func (s *WorkerSettings) GetStrictTemplates() bool {
+	if s.StrictTemplates != nil {
+		return *s.StrictTemplates
+	}
+	return false // default: log template errors and use the raw template or output
+}
+
 func (s *WorkerSettings) GetDebug() bool {
 	if s.Debug != nil {
 		return *s.Debug
@@ -206,27 +334,407 @@ func (s *WorkerSettings) GetDebug() bool {
 	return false // default
 }
 
+// GetFlowTimeout parses the deadline of a whole flow cycle (0 = no limit)
+func (s *WorkerSettings) GetFlowTimeout() (time.Duration, error) {
+	if s.FlowTimeout == nil || *s.FlowTimeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(*s.FlowTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid flow_timeout %q: %w", *s.FlowTimeout, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("flow_timeout must be positive")
+	}
+	return timeout, nil
+}
+
+// GetHistoryRetention returns the retention of the run history
+func (s *WorkerSettings) GetHistoryRetention() (history.Retention, error) {
+	retention := history.Retention{MaxRuns: history.DefaultMaxRuns}
+	if s.History == nil {
+		return retention, nil
+	}
+	if s.History.MaxRuns != nil {
+		if *s.History.MaxRuns < 0 {
+			return retention, fmt.Errorf("history.max_runs must not be negative")
+		}
+		retention.MaxRuns = *s.History.MaxRuns
+	}
+	if s.History.MaxAge != "" {
+		maxAge, err := time.ParseDuration(s.History.MaxAge)
+		if err != nil {
+			return retention, fmt.Errorf("invalid history.max_age %q: %w", s.History.MaxAge, err)
+		}
+		if maxAge <= 0 {
+			return retention, fmt.Errorf("history.max_age must be positive")
+		}
+		retention.MaxAge = maxAge
+	}
+	return retention, nil
+}
+
+// ValidateRoutes checks the when and routes fields against the flow. Every step a route
+// activates must depend on the router.
+func (s *FlowStep) ValidateRoutes(flow []FlowStep) error {
+	if s.Type != StepTypeRouter {
+		if s.When != "" || len(s.Routes) > 0 {
+			return fmt.Errorf("when and routes are only supported by %s steps", StepTypeRouter)
+		}
+		return nil
+	}
+
+	if s.When == "" {
+		return fmt.Errorf("router step requires when")
+	}
+	if len(s.Routes) == 0 {
+		return fmt.Errorf("router step requires at least one route")
+	}
+
+	for route, targets := range s.Routes {
+		if len(targets) == 0 {
+			return fmt.Errorf("route %s activates no steps", route)
+		}
+		for _, target := range targets {
+			var found *FlowStep
+			for i := range flow {
+				if flow[i].Name == target {
+					found = &flow[i]
+					break
+				}
+			}
+			if found == nil {
+				return fmt.Errorf("route %s references non-existent step: %s", route, target)
+			}
+			if !slices.Contains(found.DependsOn, s.Name) {
+				return fmt.Errorf("route %s activates step %s, which must depend on %s", route, target, s.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// GetMaxIterations returns the iteration limit of a loop step
+func (s *FlowStep) GetMaxIterations() int {
+	if s.MaxIterations > 0 {
+		return s.MaxIterations
+	}
+	return DefaultMaxIterations
+}
+
+// ValidateLoop checks the until, max_iterations and steps fields. The steps of a loop step
+// run one after another within an iteration, so they cannot have dependencies, schedules
+// or loops of their own.
+func (s *FlowStep) ValidateLoop(mcpServers map[string]MCPServer) error {
+	if s.MaxIterations < 0 {
+		return fmt.Errorf("max_iterations must not be negative")
+	}
+	if s.Type != StepTypeLoop && len(s.Steps) > 0 {
+		return fmt.Errorf("steps are only supported by %s steps", StepTypeLoop)
+	}
+	if s.Until == "" {
+		if s.Type == StepTypeLoop {
+			return fmt.Errorf("%s step requires until", StepTypeLoop)
+		}
+		if s.MaxIterations > 0 {
+			return fmt.Errorf("max_iterations requires until")
+		}
+		return nil
+	}
+	if s.ForEach != "" || s.Type == StepTypeRouter {
+		return fmt.Errorf("until cannot be combined with for_each or router steps")
+	}
+	if s.Type != StepTypeLoop {
+		return nil
+	}
+
+	if len(s.Steps) == 0 {
+		return fmt.Errorf("%s step requires at least one step", StepTypeLoop)
+	}
+	names := make(map[string]bool)
+	for i, sub := range s.Steps {
+		if sub.Name == "" {
+			return fmt.Errorf("steps[%d].name is required", i)
+		}
+		if sub.Type == "" {
+			return fmt.Errorf("steps[%d].type is required", i)
+		}
+		if names[sub.Name] {
+			return fmt.Errorf("duplicate step name: %s", sub.Name)
+		}
+		names[sub.Name] = true
+
+		if err := sub.validateLoopStep(mcpServers); err != nil {
+			return fmt.Errorf("step %s: %w", sub.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateLoopStep checks a step within a loop step
+func (s *FlowStep) validateLoopStep(mcpServers map[string]MCPServer) error {
+	switch {
+	case s.Type == StepTypeRouter || s.Type == StepTypeLoop:
+		return fmt.Errorf("%s steps cannot run within a loop", s.Type)
+	case len(s.DependsOn) > 0 || s.DependencyPolicy != "":
+		return fmt.Errorf("depends_on and dependency_policy are not supported within a loop; steps run in order")
+	case s.Every != "" || s.Cron != "" || s.NotDuePolicy != "":
+		return fmt.Errorf("every, cron and not_due_policy are not supported within a loop")
+	case s.SkipWhen != "" || s.ForEach != "" || s.Until != "" || s.MaxIterations != 0:
+		return fmt.Errorf("skip_when, for_each, until and max_iterations are not supported within a loop")
+	}
+
+	if _, err := s.GetTimeout(); err != nil {
+		return err
+	}
+	if err := s.ValidateRoutes(nil); err != nil {
+		return err
+	}
+	if err := s.ValidateHTTP(); err != nil {
+		return err
+	}
+	if err := s.ValidateMCPCall(mcpServers); err != nil {
+		return err
+	}
+	if _, err := s.CompileOutputSchema(); err != nil {
+		return err
+	}
+	if err := s.ValidateTemplates(); err != nil {
+		return err
+	}
+	if s.Retry != nil {
+		return s.Retry.Validate()
+	}
+	return nil
+}
+
+// ValidateDependencyPolicy checks the dependency_policy of a step
+func (s *FlowStep) ValidateDependencyPolicy() error {
+	switch s.DependencyPolicy {
+	case "", DependencyPolicyFailFast, DependencyPolicyAllSuccess, DependencyPolicyAllComplete, DependencyPolicyAnySuccess:
+		return nil
+	default:
+		return fmt.Errorf("invalid dependency_policy %q (expected %s, %s, %s or %s)", s.DependencyPolicy,
+			DependencyPolicyFailFast, DependencyPolicyAllSuccess, DependencyPolicyAllComplete, DependencyPolicyAnySuccess)
+	}
+}
+
+// Validate checks the backoff strategy and the limits of a retry configuration
+func (r *RetryConfig) Validate() error {
+	switch r.Backoff {
+	case "", BackoffFixed, BackoffExponential, BackoffLinear:
+	default:
+		return fmt.Errorf("invalid retry backoff %q (expected %s, %s or %s)", r.Backoff, BackoffFixed, BackoffExponential, BackoffLinear)
+	}
+	if r.MaxAttempts < 0 || r.Delay < 0 || r.MaxDelay < 0 {
+		return fmt.Errorf("retry max_attempts, delay and max_delay must not be negative")
+	}
+	return nil
+}
+
+// Validate checks the command and continue_on value of a hook
+func (h *HookCommand) Validate() error {
+	if h.Command == "" {
+		return fmt.Errorf("hook command is required")
+	}
+	if h.ContinueOn == nil {
+		return nil
+	}
+	switch *h.ContinueOn {
+	case ContinueOnSuccess, ContinueOnError, ContinueOnAlways:
+		return nil
+	default:
+		return fmt.Errorf("invalid continue_on %q (expected %s, %s or %s)", *h.ContinueOn, ContinueOnSuccess, ContinueOnError, ContinueOnAlways)
+	}
+}
+
+// FindDependencyCycle returns the steps of a dependency cycle in the flow, starting and ending
+// with the same step, or nil if the flow is acyclic. Unknown dependencies are ignored.
+func FindDependencyCycle(flow []FlowStep) []string {
+	dependsOn := make(map[string][]string, len(flow))
+	for _, step := range flow {
+		dependsOn[step.Name] = step.DependsOn
+	}
+
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int, len(flow))
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			return append(slices.Clone(path[start:]), name)
+		case done:
+			return nil
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range dependsOn[name] {
+			if _, exists := dependsOn[dep]; !exists {
+				continue
+			}
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+
+	for _, step := range flow {
+		if cycle := visit(step.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// ValidateHTTP checks the http request of a step
+func (s *FlowStep) ValidateHTTP() error {
+	if s.Type != StepTypeHTTP {
+		if s.HTTP != nil {
+			return fmt.Errorf("http is only supported by http steps")
+		}
+		return nil
+	}
+	if s.HTTP == nil || s.HTTP.URL == "" {
+		return fmt.Errorf("http step requires http.url")
+	}
+	for _, status := range s.HTTP.SuccessStatus {
+		if status < 100 || status > 599 {
+			return fmt.Errorf("invalid success status %d", status)
+		}
+	}
+	return nil
+}
+
+// ValidateMCPCall checks the tool call of a step against the configured MCP servers
+func (s *FlowStep) ValidateMCPCall(servers map[string]MCPServer) error {
+	if s.Type != StepTypeMCPCall {
+		if s.MCP != nil {
+			return fmt.Errorf("mcp is only supported by mcp_call steps")
+		}
+		return nil
+	}
+	if s.MCP == nil || s.MCP.Server == "" || s.MCP.Tool == "" {
+		return fmt.Errorf("mcp_call step requires mcp.server and mcp.tool")
+	}
+	if _, ok := servers[s.MCP.Server]; !ok {
+		return fmt.Errorf("mcp server %s is not configured in mcp_servers", s.MCP.Server)
+	}
+	return nil
+}
+
+// GetTimeout parses the per-attempt timeout of a step (0 = no limit)
+func (s *FlowStep) GetTimeout() (time.Duration, error) {
+	if s.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(s.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q: %w", s.Timeout, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be positive")
+	}
+	return timeout, nil
+}
+
 // StepStats tracks execution statistics for a single flow step
 type StepStats struct {
-	Enabled              bool       `json:"enabled"`
-	Active               bool       `json:"active"`
-	LastExecution        *time.Time `json:"last_execution,omitempty"`
-	LastExecutionSuccess *bool      `json:"last_execution_success,omitempty"`
-	ExecutionCount       int        `json:"execution_count"`
-	SuccessCount         int        `json:"success_count"`
-	LastOutput           *string    `json:"last_output,omitempty"`
-	LastError            *string    `json:"last_error,omitempty"`
-	RetryAttempt         int        `json:"retry_attempt"` // Current retry attempt (0 = first try)
-	TotalRetries         int        `json:"total_retries"` // Total retry attempts made
-	LastRetryTime        *time.Time `json:"last_retry_time,omitempty"`
-	NextRetryTime        *time.Time `json:"next_retry_time,omitempty"` // When next retry will occur
+	Enabled              bool           `json:"enabled"`
+	Active               bool           `json:"active"`
+	LastExecution        *time.Time     `json:"last_execution,omitempty"`
+	LastExecutionSuccess *bool          `json:"last_execution_success,omitempty"`
+	ExecutionCount       int            `json:"execution_count"`
+	SuccessCount         int            `json:"success_count"`
+	FailureCount         int            `json:"failure_count"`
+	LastDuration         time.Duration  `json:"last_duration"`  // Duration of the most recent execution
+	TotalDuration        time.Duration  `json:"total_duration"` // Accumulated duration of all executions
+	RecentDurations      DurationWindow `json:"-"`              // Durations of the most recent executions
+	LastOutput           *string        `json:"last_output,omitempty"`
+	LastError            *string        `json:"last_error,omitempty"`
+	RetryAttempt         int            `json:"retry_attempt"` // Current retry attempt (0 = first try)
+	TotalRetries         int            `json:"total_retries"` // Total retry attempts made
+	LastRetryTime        *time.Time     `json:"last_retry_time,omitempty"`
+	NextRetryTime        *time.Time     `json:"next_retry_time,omitempty"` // When next retry will occur
+	TimeoutCount         int            `json:"timeout_count"`             // Attempts killed because they exceeded the step timeout
+	LastTimeout          *time.Time     `json:"last_timeout,omitempty"`
+	Iteration            int            `json:"iteration"`        // Current (or last) iteration of a loop step
+	TotalIterations      int            `json:"total_iterations"` // Loop iterations run across all executions
 }
 
 // FlowStats tracks overall flow execution statistics
 type FlowStats struct {
-	ExecutionCount int        `json:"execution_count"`
-	SuccessCount   int        `json:"success_count"`
-	LastExecution  *time.Time `json:"last_execution,omitempty"`
+	ExecutionCount  int            `json:"execution_count"`
+	SuccessCount    int            `json:"success_count"`
+	LastExecution   *time.Time     `json:"last_execution,omitempty"`
+	LastDuration    time.Duration  `json:"last_duration"`
+	TotalDuration   time.Duration  `json:"total_duration"`
+	RecentDurations DurationWindow `json:"-"` // Durations of the most recent executions
+}
+
+// DurationWindowSize is the number of recent executions kept for rolling duration metrics
+const DurationWindowSize = 20
+
+// DurationWindow is a ring buffer of the durations of the most recent executions. It is a
+// value type, so copies of the statistics are independent of later executions.
+type DurationWindow struct {
+	durations [DurationWindowSize]time.Duration
+	next      int // Index the next duration is written to
+	count     int // Number of durations in the window
+}
+
+// Add records a duration, replacing the oldest one once the window is full
+func (w *DurationWindow) Add(d time.Duration) {
+	w.durations[w.next] = d
+	w.next = (w.next + 1) % DurationWindowSize
+	if w.count < DurationWindowSize {
+		w.count++
+	}
+}
+
+// Values returns the durations in the window, oldest first
+func (w DurationWindow) Values() []time.Duration {
+	values := make([]time.Duration, 0, w.count)
+	start := (w.next - w.count + DurationWindowSize) % DurationWindowSize
+	for i := 0; i < w.count; i++ {
+		values = append(values, w.durations[(start+i)%DurationWindowSize])
+	}
+	return values
+}
+
+// Average returns the mean of the durations in the window
+func (w DurationWindow) Average() time.Duration {
+	if w.count == 0 {
+		return 0
+	}
+	var total time.Duration
+	for _, d := range w.Values() {
+		total += d
+	}
+	return total / time.Duration(w.count)
+}
+
+// AverageDuration returns the mean flow execution time
+func (fs FlowStats) AverageDuration() time.Duration {
+	if fs.ExecutionCount == 0 {
+		return 0
+	}
+	return fs.TotalDuration / time.Duration(fs.ExecutionCount)
+}
+
+// AverageDuration returns the mean step execution time
+func (ss StepStats) AverageDuration() time.Duration {
+	if ss.ExecutionCount == 0 {
+		return 0
+	}
+	return ss.TotalDuration / time.Duration(ss.ExecutionCount)
 }
 
 // Runtime state fields - these would typically be added when the worker is instantiated
@@ -237,12 +745,15 @@ type WorkerRuntimeState struct {
 	isRunning         bool
 	lastActivity      *time.Time
 	flowStats         FlowStats
+	flowStatsMutex    sync.Mutex // Protects flowStats, isRunning and lastActivity
 	stepStats         map[string]*StepStats
 	stepStatsMutex    sync.Mutex // Protects stepStats map and individual StepStats fields
+	control           *flowControl
+	runHistory        *history.Store // Shared by the flow executor and the API, so the index is built once
 }
 
 // Runtime methods for Worker - these operate on runtime state
-func (w *Worker) InitRuntime(effectiveSettings WorkerSettings) *WorkerRuntimeState {
+func (w *Worker) InitRuntime(effectiveSettings WorkerSettings, workingDir string) *WorkerRuntimeState {
 	stepStats := make(map[string]*StepStats)
 
 	// Initialize step stats for all flow steps
@@ -255,9 +766,12 @@ func (w *Worker) InitRuntime(effectiveSettings WorkerSettings) *WorkerRuntimeSta
 		}
 	}
 
+	// Invalid retention settings are rejected when the config is validated
+	retention, _ := effectiveSettings.GetHistoryRetention()
+
 	return &WorkerRuntimeState{
 		effectiveSettings: effectiveSettings,
-		workingDir:        w.GetWorkerDir(),
+		workingDir:        workingDir,
 		startTime:         time.Now(),
 		isRunning:         false,
 		lastActivity:      nil,
@@ -265,7 +779,9 @@ func (w *Worker) InitRuntime(effectiveSettings WorkerSettings) *WorkerRuntimeSta
 			ExecutionCount: 0,
 			SuccessCount:   0,
 		},
-		stepStats: stepStats,
+		stepStats:  stepStats,
+		control:    newFlowControl(),
+		runHistory: history.NewStore(workingDir, retention),
 	}
 }
 
@@ -296,6 +812,11 @@ func (rs *WorkerRuntimeState) GetWorkingDir() string {
 	return rs.workingDir
 }
 
+// GetRunHistory returns the run history store of the worker directory
+func (rs *WorkerRuntimeState) GetRunHistory() *history.Store {
+	return rs.runHistory
+}
+
 func (rs *WorkerRuntimeState) GetTeamName() string {
 	return rs.effectiveSettings.GetTeamName()
 }
@@ -305,14 +826,23 @@ func (rs *WorkerRuntimeState) GetUptime() time.Duration {
 }
 
 func (rs *WorkerRuntimeState) IsRunning() bool {
+	rs.flowStatsMutex.Lock()
+	defer rs.flowStatsMutex.Unlock()
+
 	return rs.isRunning
 }
 
 func (rs *WorkerRuntimeState) GetLastActivity() *time.Time {
+	rs.flowStatsMutex.Lock()
+	defer rs.flowStatsMutex.Unlock()
+
 	return rs.lastActivity
 }
 
 func (rs *WorkerRuntimeState) SetRunning(running bool) {
+	rs.flowStatsMutex.Lock()
+	defer rs.flowStatsMutex.Unlock()
+
 	rs.isRunning = running
 	if running {
 		now := time.Now()
@@ -321,12 +851,18 @@ func (rs *WorkerRuntimeState) SetRunning(running bool) {
 }
 
 func (rs *WorkerRuntimeState) UpdateLastActivity() {
+	rs.flowStatsMutex.Lock()
+	defer rs.flowStatsMutex.Unlock()
+
 	now := time.Now()
 	rs.lastActivity = &now
 }
 
 // Flow and step statistics access methods
 func (rs *WorkerRuntimeState) GetFlowStats() FlowStats {
+	rs.flowStatsMutex.Lock()
+	defer rs.flowStatsMutex.Unlock()
+
 	return rs.flowStats
 }
 
@@ -349,6 +885,18 @@ func (rs *WorkerRuntimeState) GetAllStepStats() map[string]*StepStats {
 	return result
 }
 
+// SnapshotStepStats returns copies of all step statistics that are safe to read concurrently
+func (rs *WorkerRuntimeState) SnapshotStepStats() map[string]StepStats {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	result := make(map[string]StepStats, len(rs.stepStats))
+	for k, v := range rs.stepStats {
+		result[k] = *v
+	}
+	return result
+}
+
 // Methods to update step statistics
 func (rs *WorkerRuntimeState) SetStepActive(stepName string, active bool) {
 	rs.stepStatsMutex.Lock()
@@ -359,7 +907,58 @@ func (rs *WorkerRuntimeState) SetStepActive(stepName string, active bool) {
 	}
 }
 
-func (rs *WorkerRuntimeState) RecordStepExecution(stepName string, success bool, output *string, errorMsg *string) {
+// RecordStepAttempt records the start of an execution attempt (1-based) for a step
+func (rs *WorkerRuntimeState) RecordStepAttempt(stepName string, attempt int) {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	if stats, exists := rs.stepStats[stepName]; exists {
+		stats.RetryAttempt = attempt - 1
+		stats.NextRetryTime = nil
+		if attempt > 1 {
+			now := time.Now()
+			stats.LastRetryTime = &now
+			stats.TotalRetries++
+		}
+	}
+}
+
+// RecordStepTimeout records an attempt that was killed because it exceeded the step timeout
+func (rs *WorkerRuntimeState) RecordStepTimeout(stepName string) {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	if stats, exists := rs.stepStats[stepName]; exists {
+		now := time.Now()
+		stats.TimeoutCount++
+		stats.LastTimeout = &now
+	}
+}
+
+// RecordStepIteration records a finished iteration of a loop step and its output
+func (rs *WorkerRuntimeState) RecordStepIteration(stepName string, iteration int, output string) {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	if stats, exists := rs.stepStats[stepName]; exists {
+		stats.Iteration = iteration
+		stats.TotalIterations++
+		truncated := truncateOutput(output)
+		stats.LastOutput = &truncated
+	}
+}
+
+// SetStepNextRetryTime records when the next retry of a step is scheduled
+func (rs *WorkerRuntimeState) SetStepNextRetryTime(stepName string, next time.Time) {
+	rs.stepStatsMutex.Lock()
+	defer rs.stepStatsMutex.Unlock()
+
+	if stats, exists := rs.stepStats[stepName]; exists {
+		stats.NextRetryTime = &next
+	}
+}
+
+func (rs *WorkerRuntimeState) RecordStepExecution(stepName string, success bool, output *string, errorMsg *string, duration time.Duration) {
 	rs.stepStatsMutex.Lock()
 	defer rs.stepStatsMutex.Unlock()
 
@@ -368,12 +967,16 @@ func (rs *WorkerRuntimeState) RecordStepExecution(stepName string, success bool,
 		stats.LastExecution = &now
 		stats.LastExecutionSuccess = &success
 		stats.ExecutionCount++
+		stats.LastDuration = duration
+		stats.TotalDuration += duration
+		stats.RecentDurations.Add(duration)
 
 		if success {
 			stats.SuccessCount++
 			// Clear last error on successful execution
 			stats.LastError = nil
 		} else {
+			stats.FailureCount++
 			// Only set error if execution failed
 			if errorMsg != nil {
 				stats.LastError = errorMsg
@@ -381,21 +984,31 @@ func (rs *WorkerRuntimeState) RecordStepExecution(stepName string, success bool,
 		}
 
 		if output != nil {
-			// Truncate output if too long
-			truncated := *output
-			if len(truncated) > 500 {
-				truncated = truncated[:500] + "..."
-			}
+			truncated := truncateOutput(*output)
 			stats.LastOutput = &truncated
 		}
 	}
 }
 
+// truncateOutput shortens step outputs kept in the statistics
+func truncateOutput(output string) string {
+	if len(output) > 500 {
+		return output[:500] + "..."
+	}
+	return output
+}
+
 // Method to update flow statistics
-func (rs *WorkerRuntimeState) RecordFlowExecution(success bool) {
+func (rs *WorkerRuntimeState) RecordFlowExecution(success bool, duration time.Duration) {
+	rs.flowStatsMutex.Lock()
+	defer rs.flowStatsMutex.Unlock()
+
 	now := time.Now()
 	rs.flowStats.LastExecution = &now
 	rs.flowStats.ExecutionCount++
+	rs.flowStats.LastDuration = duration
+	rs.flowStats.TotalDuration += duration
+	rs.flowStats.RecentDurations.Add(duration)
 	if success {
 		rs.flowStats.SuccessCount++
 	}
@@ -409,7 +1022,13 @@ type WorkerRuntime struct {
 
 // NewWorkerRuntime creates a new WorkerRuntime with runtime functionality
 func NewWorkerRuntime(w *Worker, settings WorkerSettings) *WorkerRuntime {
-	runtimeState := w.InitRuntime(settings)
+	return NewWorkerRuntimeInDir(w, settings, w.GetWorkerDir())
+}
+
+// NewWorkerRuntimeInDir creates a new WorkerRuntime whose state and logs live in workingDir
+// instead of the default worker directory
+func NewWorkerRuntimeInDir(w *Worker, settings WorkerSettings, workingDir string) *WorkerRuntime {
+	runtimeState := w.InitRuntime(settings, workingDir)
 	return &WorkerRuntime{
 		Worker:             w,
 		WorkerRuntimeState: runtimeState,
@@ -456,10 +1075,10 @@ func (wi *WorkerRuntime) SetStepActive(stepName string, active bool) {
 	wi.WorkerRuntimeState.SetStepActive(stepName, active)
 }
 
-func (wi *WorkerRuntime) RecordStepExecution(stepName string, success bool, output *string, errorMsg *string) {
-	wi.WorkerRuntimeState.RecordStepExecution(stepName, success, output, errorMsg)
+func (wi *WorkerRuntime) RecordStepExecution(stepName string, success bool, output *string, errorMsg *string, duration time.Duration) {
+	wi.WorkerRuntimeState.RecordStepExecution(stepName, success, output, errorMsg, duration)
 }
 
-func (wi *WorkerRuntime) RecordFlowExecution(success bool) {
-	wi.WorkerRuntimeState.RecordFlowExecution(success)
+func (wi *WorkerRuntime) RecordFlowExecution(success bool, duration time.Duration) {
+	wi.WorkerRuntimeState.RecordFlowExecution(success, duration)
 }
@@ -1,7 +1,11 @@
 package worker
 
 import (
+	"errors"
+	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
 	"autoteam/internal/util"
 )
@@ -140,3 +144,613 @@ func TestWorkerSettings_Defaults(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkerRuntimeState_StepStatistics(t *testing.T) {
+	w := &Worker{Name: "test"}
+	runtime := NewWorkerRuntime(w, WorkerSettings{Flow: []FlowStep{{Name: "step", Type: "debug"}}})
+
+	errMsg := "boom"
+	runtime.RecordStepAttempt("step", 1)
+	runtime.RecordStepAttempt("step", 2)
+	runtime.RecordStepExecution("step", false, nil, &errMsg, 3*time.Second)
+	runtime.RecordStepAttempt("step", 1)
+	runtime.RecordStepExecution("step", true, nil, nil, 1*time.Second)
+
+	stats := runtime.SnapshotStepStats()["step"]
+	if stats.ExecutionCount != 2 || stats.SuccessCount != 1 || stats.FailureCount != 1 {
+		t.Errorf("Unexpected counts: executions=%d successes=%d failures=%d", stats.ExecutionCount, stats.SuccessCount, stats.FailureCount)
+	}
+	if stats.TotalRetries != 1 {
+		t.Errorf("Expected 1 retry, got %d", stats.TotalRetries)
+	}
+	if stats.LastDuration != time.Second {
+		t.Errorf("Expected last duration 1s, got %v", stats.LastDuration)
+	}
+	if stats.AverageDuration() != 2*time.Second {
+		t.Errorf("Expected average duration 2s, got %v", stats.AverageDuration())
+	}
+}
+
+func TestDurationWindow(t *testing.T) {
+	var window DurationWindow
+	if window.Average() != 0 || len(window.Values()) != 0 {
+		t.Fatalf("Expected an empty window, got %v", window.Values())
+	}
+
+	for i := 1; i <= DurationWindowSize+2; i++ {
+		window.Add(time.Duration(i) * time.Second)
+	}
+
+	values := window.Values()
+	if len(values) != DurationWindowSize {
+		t.Fatalf("Expected %d durations, got %d", DurationWindowSize, len(values))
+	}
+	if values[0] != 3*time.Second || values[len(values)-1] != time.Duration(DurationWindowSize+2)*time.Second {
+		t.Errorf("Expected the oldest durations to be dropped, got %v", values)
+	}
+	if want := time.Duration(DurationWindowSize+5) * time.Second / 2; window.Average() != want {
+		t.Errorf("Expected average %v, got %v", want, window.Average())
+	}
+
+	// Copies are independent of later additions
+	snapshot := window
+	window.Add(time.Hour)
+	if snapshot.Values()[DurationWindowSize-1] == time.Hour {
+		t.Error("Expected the copy to be unaffected")
+	}
+}
+
+func TestWorkerRuntimeState_FlowStatistics(t *testing.T) {
+	w := &Worker{Name: "test"}
+	runtime := NewWorkerRuntime(w, WorkerSettings{})
+
+	if avg := runtime.GetFlowStats().AverageDuration(); avg != 0 {
+		t.Errorf("Expected zero average without executions, got %v", avg)
+	}
+
+	runtime.RecordFlowExecution(true, 4*time.Second)
+	runtime.RecordFlowExecution(false, 2*time.Second)
+
+	stats := runtime.GetFlowStats()
+	if stats.ExecutionCount != 2 || stats.SuccessCount != 1 {
+		t.Errorf("Unexpected counts: executions=%d successes=%d", stats.ExecutionCount, stats.SuccessCount)
+	}
+	if stats.AverageDuration() != 3*time.Second {
+		t.Errorf("Expected average duration 3s, got %v", stats.AverageDuration())
+	}
+}
+
+func TestWorkerRuntimeState_StepEnabled(t *testing.T) {
+	w := &Worker{Name: "test"}
+	settings := WorkerSettings{Flow: []FlowStep{{Name: "collect", Type: "debug"}, {Name: "report", Type: "debug"}}}
+	dir := t.TempDir()
+
+	runtime := NewWorkerRuntimeInDir(w, settings, dir)
+
+	if !runtime.IsStepEnabled("collect") {
+		t.Fatal("Expected steps to be enabled by default")
+	}
+	if err := runtime.SetStepEnabled("collect", false); err != nil {
+		t.Fatalf("SetStepEnabled failed: %v", err)
+	}
+	if runtime.IsStepEnabled("collect") {
+		t.Error("Expected collect to be disabled")
+	}
+	if err := runtime.SetStepEnabled("missing", false); !errors.Is(err, ErrStepNotFound) {
+		t.Errorf("Expected ErrStepNotFound, got %v", err)
+	}
+
+	// A restarted worker restores the toggle from the worker directory
+	restarted := NewWorkerRuntimeInDir(w, settings, dir)
+	if err := restarted.LoadStepState(); err != nil {
+		t.Fatalf("LoadStepState failed: %v", err)
+	}
+	if restarted.IsStepEnabled("collect") || !restarted.IsStepEnabled("report") {
+		t.Errorf("Unexpected restored state: collect=%v report=%v", restarted.IsStepEnabled("collect"), restarted.IsStepEnabled("report"))
+	}
+
+	if err := restarted.SetStepEnabled("collect", true); err != nil {
+		t.Fatalf("SetStepEnabled failed: %v", err)
+	}
+	if !restarted.SnapshotStepStats()["collect"].Enabled {
+		t.Error("Expected collect to be enabled again")
+	}
+}
+
+func TestWorkerRuntimeState_LoadStepState_MissingFile(t *testing.T) {
+	runtime := NewWorkerRuntimeInDir(&Worker{Name: "test"}, WorkerSettings{Flow: []FlowStep{{Name: "step", Type: "debug"}}}, filepath.Join(t.TempDir(), "absent"))
+
+	if err := runtime.LoadStepState(); err != nil {
+		t.Fatalf("Expected missing state file to be ignored, got %v", err)
+	}
+	if !runtime.IsStepEnabled("step") {
+		t.Error("Expected step to stay enabled")
+	}
+}
+
+func TestFlowStep_ValidateRoutes(t *testing.T) {
+	router := FlowStep{
+		Name:   "triage",
+		Type:   StepTypeRouter,
+		When:   "{{ index .inputs 0 }}",
+		Routes: map[string][]string{"bug": {"fix"}},
+	}
+	fix := FlowStep{Name: "fix", Type: "claude", DependsOn: []string{"triage"}}
+
+	tests := []struct {
+		name    string
+		step    FlowStep
+		flow    []FlowStep
+		wantErr string
+	}{
+		{name: "valid router", step: router, flow: []FlowStep{router, fix}},
+		{name: "plain step", step: fix, flow: []FlowStep{router, fix}},
+		{
+			name:    "missing when",
+			step:    FlowStep{Name: "triage", Type: StepTypeRouter, Routes: router.Routes},
+			flow:    []FlowStep{fix},
+			wantErr: "router step requires when",
+		},
+		{
+			name:    "missing routes",
+			step:    FlowStep{Name: "triage", Type: StepTypeRouter, When: "bug"},
+			wantErr: "router step requires at least one route",
+		},
+		{
+			name:    "unknown target",
+			step:    router,
+			flow:    []FlowStep{router},
+			wantErr: "route bug references non-existent step: fix",
+		},
+		{
+			name:    "target without dependency",
+			step:    router,
+			flow:    []FlowStep{router, {Name: "fix", Type: "claude"}},
+			wantErr: "route bug activates step fix, which must depend on triage",
+		},
+		{
+			name:    "routes on agent step",
+			step:    FlowStep{Name: "fix", Type: "claude", Routes: router.Routes},
+			wantErr: "when and routes are only supported by router steps",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.step.ValidateRoutes(tt.flow)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateRoutes() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateRoutes() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlowStep_ValidateLoop(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    FlowStep
+		wantErr string
+	}{
+		{name: "plain step", step: FlowStep{Name: "fix", Type: "claude"}},
+		{name: "valid loop", step: FlowStep{Name: "fix", Type: "claude", Until: "{{ .stdout }}", MaxIterations: 3}},
+		{
+			name:    "negative max_iterations",
+			step:    FlowStep{Name: "fix", Type: "claude", Until: "{{ .stdout }}", MaxIterations: -1},
+			wantErr: "max_iterations must not be negative",
+		},
+		{
+			name:    "max_iterations without until",
+			step:    FlowStep{Name: "fix", Type: "claude", MaxIterations: 3},
+			wantErr: "max_iterations requires until",
+		},
+		{
+			name:    "until with for_each",
+			step:    FlowStep{Name: "fix", Type: "claude", Until: "{{ .stdout }}", ForEach: "[]"},
+			wantErr: "until cannot be combined with for_each or router steps",
+		},
+		{
+			name: "valid loop step",
+			step: FlowStep{Name: "green", Type: StepTypeLoop, Until: "{{ eq .exit_code 0 }}", Steps: []FlowStep{
+				{Name: "implement", Type: "claude"},
+				{Name: "test", Type: "shell", Args: []string{"go", "test", "./..."}},
+			}},
+		},
+		{
+			name:    "loop step without until",
+			step:    FlowStep{Name: "green", Type: StepTypeLoop, Steps: []FlowStep{{Name: "test", Type: "shell"}}},
+			wantErr: "loop step requires until",
+		},
+		{
+			name:    "loop step without steps",
+			step:    FlowStep{Name: "green", Type: StepTypeLoop, Until: "true"},
+			wantErr: "loop step requires at least one step",
+		},
+		{
+			name:    "steps without loop type",
+			step:    FlowStep{Name: "green", Type: "claude", Until: "true", Steps: []FlowStep{{Name: "test", Type: "shell"}}},
+			wantErr: "steps are only supported by loop steps",
+		},
+		{
+			name: "duplicate step within loop",
+			step: FlowStep{Name: "green", Type: StepTypeLoop, Until: "true", Steps: []FlowStep{
+				{Name: "test", Type: "shell"}, {Name: "test", Type: "shell"},
+			}},
+			wantErr: "duplicate step name: test",
+		},
+		{
+			name: "dependency within loop",
+			step: FlowStep{Name: "green", Type: StepTypeLoop, Until: "true", Steps: []FlowStep{
+				{Name: "implement", Type: "claude"}, {Name: "test", Type: "shell", DependsOn: []string{"implement"}},
+			}},
+			wantErr: "step test: depends_on and dependency_policy are not supported within a loop; steps run in order",
+		},
+		{
+			name: "nested loop",
+			step: FlowStep{Name: "green", Type: StepTypeLoop, Until: "true", Steps: []FlowStep{
+				{Name: "inner", Type: StepTypeLoop, Until: "true"},
+			}},
+			wantErr: "step inner: loop steps cannot run within a loop",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.step.ValidateLoop(nil)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateLoop() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateLoop() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlowStep_ValidateHTTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    FlowStep
+		wantErr string
+	}{
+		{name: "plain step", step: FlowStep{Name: "fix", Type: "claude"}},
+		{name: "valid request", step: FlowStep{Name: "notify", Type: StepTypeHTTP, HTTP: &HTTPRequest{URL: "https://example.com", SuccessStatus: []int{200, 204}}}},
+		{
+			name:    "missing request",
+			step:    FlowStep{Name: "notify", Type: StepTypeHTTP},
+			wantErr: "http step requires http.url",
+		},
+		{
+			name:    "invalid success status",
+			step:    FlowStep{Name: "notify", Type: StepTypeHTTP, HTTP: &HTTPRequest{URL: "https://example.com", SuccessStatus: []int{42}}},
+			wantErr: "invalid success status 42",
+		},
+		{
+			name:    "request on agent step",
+			step:    FlowStep{Name: "fix", Type: "claude", HTTP: &HTTPRequest{URL: "https://example.com"}},
+			wantErr: "http is only supported by http steps",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.step.ValidateHTTP()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateHTTP() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateHTTP() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlowStep_ValidateMCPCall(t *testing.T) {
+	servers := map[string]MCPServer{"github": {Command: "github-mcp-server", Args: []string{"stdio"}}}
+
+	tests := []struct {
+		name    string
+		step    FlowStep
+		wantErr string
+	}{
+		{name: "plain step", step: FlowStep{Name: "fix", Type: "claude"}},
+		{name: "valid call", step: FlowStep{Name: "mark", Type: StepTypeMCPCall, MCP: &MCPCall{Server: "github", Tool: "mark_read"}}},
+		{
+			name:    "missing tool",
+			step:    FlowStep{Name: "mark", Type: StepTypeMCPCall, MCP: &MCPCall{Server: "github"}},
+			wantErr: "mcp_call step requires mcp.server and mcp.tool",
+		},
+		{
+			name:    "unknown server",
+			step:    FlowStep{Name: "mark", Type: StepTypeMCPCall, MCP: &MCPCall{Server: "slack", Tool: "post"}},
+			wantErr: "mcp server slack is not configured in mcp_servers",
+		},
+		{
+			name:    "call on agent step",
+			step:    FlowStep{Name: "fix", Type: "claude", MCP: &MCPCall{Server: "github", Tool: "mark_read"}},
+			wantErr: "mcp is only supported by mcp_call steps",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.step.ValidateMCPCall(servers)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateMCPCall() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateMCPCall() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlowStep_CompileOutputSchema(t *testing.T) {
+	step := FlowStep{Name: "collect", Type: "claude"}
+	if schema, err := step.CompileOutputSchema(); schema != nil || err != nil {
+		t.Errorf("Expected no schema for a step without output_schema, got %v, %v", schema, err)
+	}
+
+	step.OutputSchema = map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"items": map[string]interface{}{"type": "array", "items": map[string]interface{}{}}},
+	}
+	schema, err := step.CompileOutputSchema()
+	if err != nil || schema == nil {
+		t.Fatalf("CompileOutputSchema() = %v, %v", schema, err)
+	}
+	if err := schema.Validate(map[string]interface{}{"items": "not an array"}); err == nil {
+		t.Error("Expected value with wrong type to be rejected")
+	}
+
+	// Keywords beyond the OpenAPI subset, such as const and $defs, are supported
+	step.OutputSchema = map[string]interface{}{
+		"$defs": map[string]interface{}{"kind": map[string]interface{}{"const": "bug"}},
+		"type":  "object",
+		"properties": map[string]interface{}{
+			"kind":  map[string]interface{}{"$ref": "#/$defs/kind"},
+			"count": map[string]interface{}{"type": "integer", "minimum": 1},
+		},
+	}
+	schema, err = step.CompileOutputSchema()
+	if err != nil {
+		t.Fatalf("CompileOutputSchema() error = %v", err)
+	}
+	if err := schema.Validate(map[string]interface{}{"kind": "bug", "count": float64(2)}); err != nil {
+		t.Errorf("Expected matching value to be accepted, got %v", err)
+	}
+	if err := schema.Validate(map[string]interface{}{"kind": "feature", "count": float64(2)}); err == nil {
+		t.Error("Expected value not matching the referenced const to be rejected")
+	}
+
+	step.OutputSchema = map[string]interface{}{"$schema": "http://json-schema.org/draft-07/schema#", "type": "string"}
+	if _, err := step.CompileOutputSchema(); err != nil {
+		t.Errorf("Expected a schema declaring draft-07 to compile, got %v", err)
+	}
+
+	step.OutputSchema = map[string]interface{}{"type": "banana"}
+	if _, err := step.CompileOutputSchema(); err == nil {
+		t.Error("Expected invalid schema type to be rejected")
+	}
+
+	step.OutputSchema = map[string]interface{}{"$ref": "file:///etc/passwd"}
+	if _, err := step.CompileOutputSchema(); err == nil {
+		t.Error("Expected a reference to another document to be rejected")
+	}
+}
+
+func TestFlowStep_ValidateTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    FlowStep
+		wantErr string
+	}{
+		{name: "no templates", step: FlowStep{Name: "fix", Type: "claude"}},
+		{
+			name: "valid templates",
+			step: FlowStep{
+				Name:     "fix",
+				Type:     "claude",
+				Input:    "{{ index .inputs 0 | trim }}",
+				Output:   "{{ .stdout | upper }}",
+				SkipWhen: `{{ contains "nothing" (index .inputs 0) }}`,
+			},
+		},
+		{
+			name: "shellquote in input",
+			step: FlowStep{Name: "lint", Type: "shell", Input: "golangci-lint run {{ index .inputs 0 | shellquote }}"},
+		},
+		{
+			name:    "invalid input",
+			step:    FlowStep{Name: "fix", Type: "claude", Input: "{{ index .inputs 0 }"},
+			wantErr: `invalid input template: template: input:1: unexpected "}" in operand`,
+		},
+		{
+			name:    "unknown function in output",
+			step:    FlowStep{Name: "fix", Type: "claude", Output: "{{ .stdout | shout }}"},
+			wantErr: `invalid output template: template: output:1: function "shout" not defined`,
+		},
+		{
+			name:    "unclosed action in skip_when",
+			step:    FlowStep{Name: "fix", Type: "claude", SkipWhen: "{{ if .inputs }}true"},
+			wantErr: "invalid skip_when template: template: skip_when:1: unexpected EOF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.step.ValidateTemplates()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateTemplates() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateTemplates() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFlowStep_ValidateDependencyPolicy(t *testing.T) {
+	tests := []struct {
+		policy  string
+		wantErr bool
+	}{
+		{policy: ""},
+		{policy: DependencyPolicyFailFast},
+		{policy: DependencyPolicyAllSuccess},
+		{policy: DependencyPolicyAllComplete},
+		{policy: DependencyPolicyAnySuccess},
+		{policy: "all_good", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		step := FlowStep{Name: "fix", Type: "claude", DependencyPolicy: tt.policy}
+		if err := step.ValidateDependencyPolicy(); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateDependencyPolicy(%q) error = %v, wantErr %v", tt.policy, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRetryConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		retry   RetryConfig
+		wantErr string
+	}{
+		{name: "defaults", retry: RetryConfig{}},
+		{name: "exponential", retry: RetryConfig{MaxAttempts: 3, Delay: 5, Backoff: BackoffExponential, MaxDelay: 60}},
+		{
+			name:    "unknown backoff",
+			retry:   RetryConfig{Backoff: "quadratic"},
+			wantErr: `invalid retry backoff "quadratic" (expected fixed, exponential or linear)`,
+		},
+		{
+			name:    "negative delay",
+			retry:   RetryConfig{Delay: -1},
+			wantErr: "retry max_attempts, delay and max_delay must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.retry.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHookCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hook    HookCommand
+		wantErr string
+	}{
+		{name: "default continue_on", hook: HookCommand{Command: "echo"}},
+		{name: "always", hook: HookCommand{Command: "echo", ContinueOn: util.StringPtr(ContinueOnAlways)}},
+		{name: "missing command", hook: HookCommand{}, wantErr: "hook command is required"},
+		{
+			name:    "unknown continue_on",
+			hook:    HookCommand{Command: "echo", ContinueOn: util.StringPtr("sometimes")},
+			wantErr: `invalid continue_on "sometimes" (expected success, error or always)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.hook.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	tests := []struct {
+		name string
+		flow []FlowStep
+		want []string
+	}{
+		{
+			name: "acyclic",
+			flow: []FlowStep{
+				{Name: "a"},
+				{Name: "b", DependsOn: []string{"a"}},
+				{Name: "c", DependsOn: []string{"a", "b", "missing"}},
+			},
+		},
+		{
+			name: "self dependency",
+			flow: []FlowStep{{Name: "a", DependsOn: []string{"a"}}},
+			want: []string{"a", "a"},
+		},
+		{
+			name: "indirect cycle",
+			flow: []FlowStep{
+				{Name: "start"},
+				{Name: "a", DependsOn: []string{"start", "c"}},
+				{Name: "b", DependsOn: []string{"a"}},
+				{Name: "c", DependsOn: []string{"b"}},
+			},
+			want: []string{"a", "c", "b", "a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindDependencyCycle(tt.flow)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FindDependencyCycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{"", "''"},
+		{"./...", "'./...'"},
+		{"it's $(rm -rf /)", `'it'\''s $(rm -rf /)'`},
+		{42, "'42'"},
+	}
+
+	for _, tt := range tests {
+		if got := ShellQuote(tt.value); got != tt.want {
+			t.Errorf("ShellQuote(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}